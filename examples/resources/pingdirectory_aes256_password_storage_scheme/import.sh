@@ -0,0 +1,2 @@
+# "aes256PasswordStorageSchemeId" should be the id of the Aes256 Password Storage Scheme to be imported
+terraform import pingdirectory_aes256_password_storage_scheme.myAes256PasswordStorageScheme aes256PasswordStorageSchemeId
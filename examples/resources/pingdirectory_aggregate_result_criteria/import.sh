@@ -0,0 +1,2 @@
+# "aggregateResultCriteriaId" should be the id of the Aggregate Result Criteria to be imported
+terraform import pingdirectory_aggregate_result_criteria.myAggregateResultCriteria aggregateResultCriteriaId
@@ -0,0 +1,2 @@
+# "amazonSecretsManagerPassphraseProviderId" should be the id of the Amazon Secrets Manager Passphrase Provider to be imported
+terraform import pingdirectory_amazon_secrets_manager_passphrase_provider.myAmazonSecretsManagerPassphraseProvider amazonSecretsManagerPassphraseProviderId
@@ -0,0 +1,2 @@
+# "amazonSecretsManagerPasswordStorageSchemeId" should be the id of the Amazon Secrets Manager Password Storage Scheme to be imported
+terraform import pingdirectory_amazon_secrets_manager_password_storage_scheme.myAmazonSecretsManagerPasswordStorageScheme amazonSecretsManagerPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "argon2PasswordStorageSchemeId" should be the id of the Argon2 Password Storage Scheme to be imported
+terraform import pingdirectory_argon2_password_storage_scheme.myArgon2PasswordStorageScheme argon2PasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "argon2dPasswordStorageSchemeId" should be the id of the Argon2d Password Storage Scheme to be imported
+terraform import pingdirectory_argon2d_password_storage_scheme.myArgon2dPasswordStorageScheme argon2dPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "argon2iPasswordStorageSchemeId" should be the id of the Argon2i Password Storage Scheme to be imported
+terraform import pingdirectory_argon2i_password_storage_scheme.myArgon2iPasswordStorageScheme argon2iPasswordStorageSchemeId
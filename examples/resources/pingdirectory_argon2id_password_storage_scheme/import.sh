@@ -0,0 +1,2 @@
+# "argon2idPasswordStorageSchemeId" should be the id of the Argon2id Password Storage Scheme to be imported
+terraform import pingdirectory_argon2id_password_storage_scheme.myArgon2idPasswordStorageScheme argon2idPasswordStorageSchemeId
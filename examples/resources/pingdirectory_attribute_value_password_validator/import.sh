@@ -0,0 +1,2 @@
+# "attributeValuePasswordValidatorId" should be the id of the Attribute Value Password Validator to be imported
+terraform import pingdirectory_attribute_value_password_validator.myAttributeValuePasswordValidator attributeValuePasswordValidatorId
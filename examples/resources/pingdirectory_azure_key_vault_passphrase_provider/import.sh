@@ -0,0 +1,2 @@
+# "azureKeyVaultPassphraseProviderId" should be the id of the Azure Key Vault Passphrase Provider to be imported
+terraform import pingdirectory_azure_key_vault_passphrase_provider.myAzureKeyVaultPassphraseProvider azureKeyVaultPassphraseProviderId
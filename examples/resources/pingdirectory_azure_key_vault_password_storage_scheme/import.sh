@@ -0,0 +1,2 @@
+# "azureKeyVaultPasswordStorageSchemeId" should be the id of the Azure Key Vault Password Storage Scheme to be imported
+terraform import pingdirectory_azure_key_vault_password_storage_scheme.myAzureKeyVaultPasswordStorageScheme azureKeyVaultPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "bcryptPasswordStorageSchemeId" should be the id of the Bcrypt Password Storage Scheme to be imported
+terraform import pingdirectory_bcrypt_password_storage_scheme.myBcryptPasswordStorageScheme bcryptPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "characterSetPasswordValidatorId" should be the id of the Character Set Password Validator to be imported
+terraform import pingdirectory_character_set_password_validator.myCharacterSetPasswordValidator characterSetPasswordValidatorId
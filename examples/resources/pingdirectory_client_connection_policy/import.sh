@@ -0,0 +1,2 @@
+# "clientConnectionPolicyId" should be the id of the Client Connection Policy to be imported
+terraform import pingdirectory_client_connection_policy.myClientConnectionPolicy clientConnectionPolicyId
@@ -0,0 +1,36 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingdirectory = {
+      source = "pingidentity/pingdirectory"
+    }
+  }
+}
+
+provider "pingdirectory" {
+  username   = "cn=administrator"
+  password   = "2FederateM0re"
+  https_host = "https://localhost:1443"
+  # Warning: The insecure_trust_all_tls attribute configures the provider to trust any certificate presented by the PingDirectory server.
+  # It should not be used in production. If you need to specify trusted CA certificates, use the
+  # ca_certificate_pem_files attribute to point to any number of trusted CA certificate files
+  # in PEM format. If you do not specify certificates, the host's default root CA set will be used.
+  # Example:
+  # ca_certificate_pem_files = ["/example/path/to/cacert1.pem", "/example/path/to/cacert2.pem"]
+  insecure_trust_all_tls = true
+  product_version        = "9.2.0.0"
+}
+
+# Use "pingdirectory_default_client_connection_policy" if you are adopting existing configuration from the PingDirectory server into Terraform
+resource "pingdirectory_client_connection_policy" "myClientConnectionPolicy" {
+  id                             = "MyClientConnectionPolicy"
+  policy_id                      = "application-policy"
+  description                    = "Policy for application service accounts"
+  enabled                        = true
+  evaluation_order_index         = 100
+  connection_criteria            = "Application Connections"
+  allowed_operation              = ["bind", "compare", "extended", "search"]
+  maximum_concurrent_connections = 100
+  maximum_search_size_limit      = 1000
+  maximum_search_time_limit      = "30 s"
+}
@@ -0,0 +1,2 @@
+# "conjurPassphraseProviderId" should be the id of the Conjur Passphrase Provider to be imported
+terraform import pingdirectory_conjur_passphrase_provider.myConjurPassphraseProvider conjurPassphraseProviderId
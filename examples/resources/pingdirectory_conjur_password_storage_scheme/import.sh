@@ -0,0 +1,2 @@
+# "conjurPasswordStorageSchemeId" should be the id of the Conjur Password Storage Scheme to be imported
+terraform import pingdirectory_conjur_password_storage_scheme.myConjurPasswordStorageScheme conjurPasswordStorageSchemeId
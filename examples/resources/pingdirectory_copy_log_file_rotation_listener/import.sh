@@ -0,0 +1,2 @@
+# "copyLogFileRotationListenerId" should be the id of the Copy Log File Rotation Listener to be imported
+terraform import pingdirectory_copy_log_file_rotation_listener.myCopyLogFileRotationListener copyLogFileRotationListenerId
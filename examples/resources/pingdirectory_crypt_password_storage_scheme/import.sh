@@ -0,0 +1,2 @@
+# "cryptPasswordStorageSchemeId" should be the id of the Crypt Password Storage Scheme to be imported
+terraform import pingdirectory_crypt_password_storage_scheme.myCryptPasswordStorageScheme cryptPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "aesPasswordStorageSchemeId" should be the id of the Aes Password Storage Scheme to be imported
+terraform import pingdirectory_default_aes_password_storage_scheme.myAesPasswordStorageScheme aesPasswordStorageSchemeId
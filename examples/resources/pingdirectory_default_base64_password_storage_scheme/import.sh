@@ -0,0 +1,2 @@
+# "base64PasswordStorageSchemeId" should be the id of the Base64 Password Storage Scheme to be imported
+terraform import pingdirectory_default_base64_password_storage_scheme.myBase64PasswordStorageScheme base64PasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "blowfishPasswordStorageSchemeId" should be the id of the Blowfish Password Storage Scheme to be imported
+terraform import pingdirectory_default_blowfish_password_storage_scheme.myBlowfishPasswordStorageScheme blowfishPasswordStorageSchemeId
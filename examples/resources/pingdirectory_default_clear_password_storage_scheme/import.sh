@@ -0,0 +1,2 @@
+# "clearPasswordStorageSchemeId" should be the id of the Clear Password Storage Scheme to be imported
+terraform import pingdirectory_default_clear_password_storage_scheme.myClearPasswordStorageScheme clearPasswordStorageSchemeId
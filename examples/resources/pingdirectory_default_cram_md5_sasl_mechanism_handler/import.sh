@@ -0,0 +1,2 @@
+# "cramMd5SaslMechanismHandlerId" should be the id of the Cram Md5 Sasl Mechanism Handler to be imported
+terraform import pingdirectory_default_cram_md5_sasl_mechanism_handler.myCramMd5SaslMechanismHandler cramMd5SaslMechanismHandlerId
@@ -0,0 +1,2 @@
+# "customAlertHandlerId" should be the id of the Custom Alert Handler to be imported
+terraform import pingdirectory_default_custom_alert_handler.myCustomAlertHandler customAlertHandlerId
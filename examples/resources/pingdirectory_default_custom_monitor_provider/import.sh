@@ -0,0 +1,2 @@
+# "customMonitorProviderId" should be the id of the Custom Monitor Provider to be imported
+terraform import pingdirectory_default_custom_monitor_provider.myCustomMonitorProvider customMonitorProviderId
@@ -0,0 +1,2 @@
+# "customPasswordValidatorId" should be the id of the Custom Password Validator to be imported
+terraform import pingdirectory_default_custom_password_validator.myCustomPasswordValidator customPasswordValidatorId
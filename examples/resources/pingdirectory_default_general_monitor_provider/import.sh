@@ -0,0 +1,2 @@
+# "generalMonitorProviderId" should be the id of the General Monitor Provider to be imported
+terraform import pingdirectory_default_general_monitor_provider.myGeneralMonitorProvider generalMonitorProviderId
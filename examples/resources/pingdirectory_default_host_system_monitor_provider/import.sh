@@ -0,0 +1,2 @@
+# "hostSystemMonitorProviderId" should be the id of the Host System Monitor Provider to be imported
+terraform import pingdirectory_default_host_system_monitor_provider.myHostSystemMonitorProvider hostSystemMonitorProviderId
@@ -0,0 +1,2 @@
+# "md5PasswordStorageSchemeId" should be the id of the Md5 Password Storage Scheme to be imported
+terraform import pingdirectory_default_md5_password_storage_scheme.myMd5PasswordStorageScheme md5PasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "outputAlertHandlerId" should be the id of the Output Alert Handler to be imported
+terraform import pingdirectory_default_output_alert_handler.myOutputAlertHandler outputAlertHandlerId
@@ -0,0 +1,2 @@
+# "plainSaslMechanismHandlerId" should be the id of the Plain Sasl Mechanism Handler to be imported
+terraform import pingdirectory_default_plain_sasl_mechanism_handler.myPlainSaslMechanismHandler plainSaslMechanismHandlerId
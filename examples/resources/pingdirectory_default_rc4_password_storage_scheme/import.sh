@@ -0,0 +1,2 @@
+# "rc4PasswordStorageSchemeId" should be the id of the Rc4 Password Storage Scheme to be imported
+terraform import pingdirectory_default_rc4_password_storage_scheme.myRc4PasswordStorageScheme rc4PasswordStorageSchemeId
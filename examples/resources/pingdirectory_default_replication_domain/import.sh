@@ -0,0 +1,2 @@
+# Importing a Replication Domain requires providing the name of all parent resources in the following format
+terraform import pingdirectory_default_replication_domain.myReplicationDomain "[synchronization-provider-name]/[replication-domain-name]"
@@ -0,0 +1,2 @@
+# "saltedMd5PasswordStorageSchemeId" should be the id of the Salted Md5 Password Storage Scheme to be imported
+terraform import pingdirectory_default_salted_md5_password_storage_scheme.mySaltedMd5PasswordStorageScheme saltedMd5PasswordStorageSchemeId
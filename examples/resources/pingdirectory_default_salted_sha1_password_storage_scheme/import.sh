@@ -0,0 +1,2 @@
+# "saltedSha1PasswordStorageSchemeId" should be the id of the Salted Sha1 Password Storage Scheme to be imported
+terraform import pingdirectory_default_salted_sha1_password_storage_scheme.mySaltedSha1PasswordStorageScheme saltedSha1PasswordStorageSchemeId
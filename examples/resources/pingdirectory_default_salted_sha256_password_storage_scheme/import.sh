@@ -0,0 +1,2 @@
+# "saltedSha256PasswordStorageSchemeId" should be the id of the Salted Sha256 Password Storage Scheme to be imported
+terraform import pingdirectory_default_salted_sha256_password_storage_scheme.mySaltedSha256PasswordStorageScheme saltedSha256PasswordStorageSchemeId
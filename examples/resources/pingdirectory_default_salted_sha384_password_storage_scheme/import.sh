@@ -0,0 +1,2 @@
+# "saltedSha384PasswordStorageSchemeId" should be the id of the Salted Sha384 Password Storage Scheme to be imported
+terraform import pingdirectory_default_salted_sha384_password_storage_scheme.mySaltedSha384PasswordStorageScheme saltedSha384PasswordStorageSchemeId
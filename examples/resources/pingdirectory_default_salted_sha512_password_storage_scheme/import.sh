@@ -0,0 +1,2 @@
+# "saltedSha512PasswordStorageSchemeId" should be the id of the Salted Sha512 Password Storage Scheme to be imported
+terraform import pingdirectory_default_salted_sha512_password_storage_scheme.mySaltedSha512PasswordStorageScheme saltedSha512PasswordStorageSchemeId
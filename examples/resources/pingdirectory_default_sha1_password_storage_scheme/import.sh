@@ -0,0 +1,2 @@
+# "sha1PasswordStorageSchemeId" should be the id of the Sha1 Password Storage Scheme to be imported
+terraform import pingdirectory_default_sha1_password_storage_scheme.mySha1PasswordStorageScheme sha1PasswordStorageSchemeId
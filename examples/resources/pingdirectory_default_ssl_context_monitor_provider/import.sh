@@ -0,0 +1,2 @@
+# "sslContextMonitorProviderId" should be the id of the Ssl Context Monitor Provider to be imported
+terraform import pingdirectory_default_ssl_context_monitor_provider.mySslContextMonitorProvider sslContextMonitorProviderId
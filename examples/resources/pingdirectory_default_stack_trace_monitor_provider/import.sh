@@ -0,0 +1,2 @@
+# "stackTraceMonitorProviderId" should be the id of the Stack Trace Monitor Provider to be imported
+terraform import pingdirectory_default_stack_trace_monitor_provider.myStackTraceMonitorProvider stackTraceMonitorProviderId
@@ -0,0 +1,2 @@
+# "systemInfoMonitorProviderId" should be the id of the System Info Monitor Provider to be imported
+terraform import pingdirectory_default_system_info_monitor_provider.mySystemInfoMonitorProvider systemInfoMonitorProviderId
@@ -0,0 +1,2 @@
+# "tripleDesPasswordStorageSchemeId" should be the id of the Triple Des Password Storage Scheme to be imported
+terraform import pingdirectory_default_triple_des_password_storage_scheme.myTripleDesPasswordStorageScheme tripleDesPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "versionMonitorProviderId" should be the id of the Version Monitor Provider to be imported
+terraform import pingdirectory_default_version_monitor_provider.myVersionMonitorProvider versionMonitorProviderId
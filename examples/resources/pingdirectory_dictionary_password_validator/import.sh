@@ -0,0 +1,2 @@
+# "dictionaryPasswordValidatorId" should be the id of the Dictionary Password Validator to be imported
+terraform import pingdirectory_dictionary_password_validator.myDictionaryPasswordValidator dictionaryPasswordValidatorId
@@ -0,0 +1,2 @@
+# "environmentVariablePassphraseProviderId" should be the id of the Environment Variable Passphrase Provider to be imported
+terraform import pingdirectory_environment_variable_passphrase_provider.myEnvironmentVariablePassphraseProvider environmentVariablePassphraseProviderId
@@ -0,0 +1,2 @@
+# "errorLogAlertHandlerId" should be the id of the Error Log Alert Handler to be imported
+terraform import pingdirectory_error_log_alert_handler.myErrorLogAlertHandler errorLogAlertHandlerId
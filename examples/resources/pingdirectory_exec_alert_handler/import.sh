@@ -0,0 +1,2 @@
+# "execAlertHandlerId" should be the id of the Exec Alert Handler to be imported
+terraform import pingdirectory_exec_alert_handler.myExecAlertHandler execAlertHandlerId
@@ -0,0 +1,2 @@
+# "fileBasedKeyManagerProviderId" should be the id of the File Based Key Manager Provider to be imported
+terraform import pingdirectory_file_based_key_manager_provider.myFileBasedKeyManagerProvider fileBasedKeyManagerProviderId
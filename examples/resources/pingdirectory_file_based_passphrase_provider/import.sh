@@ -0,0 +1,2 @@
+# "fileBasedPassphraseProviderId" should be the id of the File Based Passphrase Provider to be imported
+terraform import pingdirectory_file_based_passphrase_provider.myFileBasedPassphraseProvider fileBasedPassphraseProviderId
@@ -0,0 +1,2 @@
+# "fileCountLogRetentionPolicyId" should be the id of the File Count Log Retention Policy to be imported
+terraform import pingdirectory_file_count_log_retention_policy.myFileCountLogRetentionPolicy fileCountLogRetentionPolicyId
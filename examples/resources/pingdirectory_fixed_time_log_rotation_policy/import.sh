@@ -0,0 +1,2 @@
+# "fixedTimeLogRotationPolicyId" should be the id of the Fixed Time Log Rotation Policy to be imported
+terraform import pingdirectory_fixed_time_log_rotation_policy.myFixedTimeLogRotationPolicy fixedTimeLogRotationPolicyId
@@ -0,0 +1,2 @@
+# "groovyScriptedAlertHandlerId" should be the id of the Groovy Scripted Alert Handler to be imported
+terraform import pingdirectory_groovy_scripted_alert_handler.myGroovyScriptedAlertHandler groovyScriptedAlertHandlerId
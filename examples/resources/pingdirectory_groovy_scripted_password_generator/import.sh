@@ -0,0 +1,2 @@
+# "groovyScriptedPasswordGeneratorId" should be the id of the Groovy Scripted Password Generator to be imported
+terraform import pingdirectory_groovy_scripted_password_generator.myGroovyScriptedPasswordGenerator groovyScriptedPasswordGeneratorId
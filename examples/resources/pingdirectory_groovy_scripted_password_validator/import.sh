@@ -0,0 +1,2 @@
+# "groovyScriptedPasswordValidatorId" should be the id of the Groovy Scripted Password Validator to be imported
+terraform import pingdirectory_groovy_scripted_password_validator.myGroovyScriptedPasswordValidator groovyScriptedPasswordValidatorId
@@ -0,0 +1,2 @@
+# "haystackPasswordValidatorId" should be the id of the Haystack Password Validator to be imported
+terraform import pingdirectory_haystack_password_validator.myHaystackPasswordValidator haystackPasswordValidatorId
@@ -0,0 +1,2 @@
+# "jmxAlertHandlerId" should be the id of the Jmx Alert Handler to be imported
+terraform import pingdirectory_jmx_alert_handler.myJmxAlertHandler jmxAlertHandlerId
@@ -0,0 +1,2 @@
+# "lengthBasedPasswordValidatorId" should be the id of the Length Based Password Validator to be imported
+terraform import pingdirectory_length_based_password_validator.myLengthBasedPasswordValidator lengthBasedPasswordValidatorId
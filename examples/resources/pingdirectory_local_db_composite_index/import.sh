@@ -0,0 +1,2 @@
+# Importing a Local Db Composite Index requires providing the name of all parent resources in the following format
+terraform import pingdirectory_local_db_composite_index.myLocalDbCompositeIndex "[backend-name]/[local-db-composite-index-name]"
@@ -0,0 +1,2 @@
+# Importing a Local Db Vlv Index requires providing the name of all parent resources in the following format
+terraform import pingdirectory_local_db_vlv_index.myLocalDbVlvIndex "[backend-name]/[local-db-vlv-index-name]"
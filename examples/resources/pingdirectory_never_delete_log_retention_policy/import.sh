@@ -0,0 +1,2 @@
+# "neverDeleteLogRetentionPolicyId" should be the id of the Never Delete Log Retention Policy to be imported
+terraform import pingdirectory_never_delete_log_retention_policy.myNeverDeleteLogRetentionPolicy neverDeleteLogRetentionPolicyId
@@ -0,0 +1,2 @@
+# "neverRotateLogRotationPolicyId" should be the id of the Never Rotate Log Rotation Policy to be imported
+terraform import pingdirectory_never_rotate_log_rotation_policy.myNeverRotateLogRotationPolicy neverRotateLogRotationPolicyId
@@ -0,0 +1,2 @@
+# "obscuredValuePassphraseProviderId" should be the id of the Obscured Value Passphrase Provider to be imported
+terraform import pingdirectory_obscured_value_passphrase_provider.myObscuredValuePassphraseProvider obscuredValuePassphraseProviderId
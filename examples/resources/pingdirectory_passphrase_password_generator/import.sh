@@ -0,0 +1,2 @@
+# "passphrasePasswordGeneratorId" should be the id of the Passphrase Password Generator to be imported
+terraform import pingdirectory_passphrase_password_generator.myPassphrasePasswordGenerator passphrasePasswordGeneratorId
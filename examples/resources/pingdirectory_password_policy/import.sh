@@ -0,0 +1,2 @@
+# "passwordPolicyId" should be the id of the Password Policy to be imported
+terraform import pingdirectory_password_policy.myPasswordPolicy passwordPolicyId
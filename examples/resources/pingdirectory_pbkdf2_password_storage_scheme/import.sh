@@ -0,0 +1,2 @@
+# "pbkdf2PasswordStorageSchemeId" should be the id of the Pbkdf2 Password Storage Scheme to be imported
+terraform import pingdirectory_pbkdf2_password_storage_scheme.myPbkdf2PasswordStorageScheme pbkdf2PasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "pkcs11KeyManagerProviderId" should be the id of the Pkcs11 Key Manager Provider to be imported
+terraform import pingdirectory_pkcs11_key_manager_provider.myPkcs11KeyManagerProvider pkcs11KeyManagerProviderId
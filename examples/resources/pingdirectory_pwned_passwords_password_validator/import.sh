@@ -0,0 +1,2 @@
+# "pwnedPasswordsPasswordValidatorId" should be the id of the Pwned Passwords Password Validator to be imported
+terraform import pingdirectory_pwned_passwords_password_validator.myPwnedPasswordsPasswordValidator pwnedPasswordsPasswordValidatorId
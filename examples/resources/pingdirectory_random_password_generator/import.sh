@@ -0,0 +1,2 @@
+# "randomPasswordGeneratorId" should be the id of the Random Password Generator to be imported
+terraform import pingdirectory_random_password_generator.myRandomPasswordGenerator randomPasswordGeneratorId
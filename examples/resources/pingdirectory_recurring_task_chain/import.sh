@@ -0,0 +1,2 @@
+# "recurringTaskChainId" should be the id of the Recurring Task Chain to be imported
+terraform import pingdirectory_recurring_task_chain.myRecurringTaskChain recurringTaskChainId
@@ -0,0 +1,2 @@
+# "regularExpressionPasswordValidatorId" should be the id of the Regular Expression Password Validator to be imported
+terraform import pingdirectory_regular_expression_password_validator.myRegularExpressionPasswordValidator regularExpressionPasswordValidatorId
@@ -0,0 +1,2 @@
+# "repeatedCharactersPasswordValidatorId" should be the id of the Repeated Characters Password Validator to be imported
+terraform import pingdirectory_repeated_characters_password_validator.myRepeatedCharactersPasswordValidator repeatedCharactersPasswordValidatorId
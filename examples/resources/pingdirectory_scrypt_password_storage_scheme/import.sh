@@ -0,0 +1,2 @@
+# "scryptPasswordStorageSchemeId" should be the id of the Scrypt Password Storage Scheme to be imported
+terraform import pingdirectory_scrypt_password_storage_scheme.myScryptPasswordStorageScheme scryptPasswordStorageSchemeId
@@ -0,0 +1,2 @@
+# "similarityBasedPasswordValidatorId" should be the id of the Similarity Based Password Validator to be imported
+terraform import pingdirectory_similarity_based_password_validator.mySimilarityBasedPasswordValidator similarityBasedPasswordValidatorId
@@ -0,0 +1,2 @@
+# "simpleResultCriteriaId" should be the id of the Simple Result Criteria to be imported
+terraform import pingdirectory_simple_result_criteria.mySimpleResultCriteria simpleResultCriteriaId
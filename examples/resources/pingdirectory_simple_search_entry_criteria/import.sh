@@ -0,0 +1,2 @@
+# "simpleSearchEntryCriteriaId" should be the id of the Simple Search Entry Criteria to be imported
+terraform import pingdirectory_simple_search_entry_criteria.mySimpleSearchEntryCriteria simpleSearchEntryCriteriaId
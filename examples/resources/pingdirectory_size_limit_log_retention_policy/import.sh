@@ -0,0 +1,2 @@
+# "sizeLimitLogRetentionPolicyId" should be the id of the Size Limit Log Retention Policy to be imported
+terraform import pingdirectory_size_limit_log_retention_policy.mySizeLimitLogRetentionPolicy sizeLimitLogRetentionPolicyId
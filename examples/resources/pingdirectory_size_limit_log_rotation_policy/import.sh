@@ -0,0 +1,2 @@
+# "sizeLimitLogRotationPolicyId" should be the id of the Size Limit Log Rotation Policy to be imported
+terraform import pingdirectory_size_limit_log_rotation_policy.mySizeLimitLogRotationPolicy sizeLimitLogRotationPolicyId
@@ -0,0 +1,2 @@
+# "smtpAlertHandlerId" should be the id of the Smtp Alert Handler to be imported
+terraform import pingdirectory_smtp_alert_handler.mySmtpAlertHandler smtpAlertHandlerId
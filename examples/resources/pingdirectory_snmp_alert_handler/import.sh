@@ -0,0 +1,2 @@
+# "snmpAlertHandlerId" should be the id of the Snmp Alert Handler to be imported
+terraform import pingdirectory_snmp_alert_handler.mySnmpAlertHandler snmpAlertHandlerId
@@ -0,0 +1,2 @@
+# "snmpSubAgentAlertHandlerId" should be the id of the Snmp Sub Agent Alert Handler to be imported
+terraform import pingdirectory_snmp_sub_agent_alert_handler.mySnmpSubAgentAlertHandler snmpSubAgentAlertHandlerId
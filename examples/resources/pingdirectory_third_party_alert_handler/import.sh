@@ -0,0 +1,2 @@
+# "thirdPartyAlertHandlerId" should be the id of the Third Party Alert Handler to be imported
+terraform import pingdirectory_third_party_alert_handler.myThirdPartyAlertHandler thirdPartyAlertHandlerId
@@ -0,0 +1,2 @@
+# "thirdPartyCertificateMapperId" should be the id of the Third Party Certificate Mapper to be imported
+terraform import pingdirectory_third_party_certificate_mapper.myThirdPartyCertificateMapper thirdPartyCertificateMapperId
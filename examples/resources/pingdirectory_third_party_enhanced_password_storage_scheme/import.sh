@@ -0,0 +1,2 @@
+# "thirdPartyEnhancedPasswordStorageSchemeId" should be the id of the Third Party Enhanced Password Storage Scheme to be imported
+terraform import pingdirectory_third_party_enhanced_password_storage_scheme.myThirdPartyEnhancedPasswordStorageScheme thirdPartyEnhancedPasswordStorageSchemeId
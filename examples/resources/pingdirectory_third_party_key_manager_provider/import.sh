@@ -0,0 +1,2 @@
+# "thirdPartyKeyManagerProviderId" should be the id of the Third Party Key Manager Provider to be imported
+terraform import pingdirectory_third_party_key_manager_provider.myThirdPartyKeyManagerProvider thirdPartyKeyManagerProviderId
@@ -0,0 +1,2 @@
+# "thirdPartyMonitorProviderId" should be the id of the Third Party Monitor Provider to be imported
+terraform import pingdirectory_third_party_monitor_provider.myThirdPartyMonitorProvider thirdPartyMonitorProviderId
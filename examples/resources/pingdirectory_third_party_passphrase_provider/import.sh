@@ -0,0 +1,2 @@
+# "thirdPartyPassphraseProviderId" should be the id of the Third Party Passphrase Provider to be imported
+terraform import pingdirectory_third_party_passphrase_provider.myThirdPartyPassphraseProvider thirdPartyPassphraseProviderId
@@ -0,0 +1,2 @@
+# "thirdPartyPasswordGeneratorId" should be the id of the Third Party Password Generator to be imported
+terraform import pingdirectory_third_party_password_generator.myThirdPartyPasswordGenerator thirdPartyPasswordGeneratorId
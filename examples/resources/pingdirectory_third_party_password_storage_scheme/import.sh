@@ -0,0 +1,2 @@
+# "thirdPartyPasswordStorageSchemeId" should be the id of the Third Party Password Storage Scheme to be imported
+terraform import pingdirectory_third_party_password_storage_scheme.myThirdPartyPasswordStorageScheme thirdPartyPasswordStorageSchemeId
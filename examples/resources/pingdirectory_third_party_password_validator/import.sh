@@ -0,0 +1,2 @@
+# "thirdPartyPasswordValidatorId" should be the id of the Third Party Password Validator to be imported
+terraform import pingdirectory_third_party_password_validator.myThirdPartyPasswordValidator thirdPartyPasswordValidatorId
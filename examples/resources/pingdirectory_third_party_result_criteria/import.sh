@@ -0,0 +1,2 @@
+# "thirdPartyResultCriteriaId" should be the id of the Third Party Result Criteria to be imported
+terraform import pingdirectory_third_party_result_criteria.myThirdPartyResultCriteria thirdPartyResultCriteriaId
@@ -0,0 +1,2 @@
+# "timeLimitLogRetentionPolicyId" should be the id of the Time Limit Log Retention Policy to be imported
+terraform import pingdirectory_time_limit_log_retention_policy.myTimeLimitLogRetentionPolicy timeLimitLogRetentionPolicyId
@@ -0,0 +1,2 @@
+# "timeLimitLogRotationPolicyId" should be the id of the Time Limit Log Rotation Policy to be imported
+terraform import pingdirectory_time_limit_log_rotation_policy.myTimeLimitLogRotationPolicy timeLimitLogRotationPolicyId
@@ -0,0 +1,2 @@
+# "twilioAlertHandlerId" should be the id of the Twilio Alert Handler to be imported
+terraform import pingdirectory_twilio_alert_handler.myTwilioAlertHandler twilioAlertHandlerId
@@ -0,0 +1,2 @@
+# "uniqueCharactersPasswordValidatorId" should be the id of the Unique Characters Password Validator to be imported
+terraform import pingdirectory_unique_characters_password_validator.myUniqueCharactersPasswordValidator uniqueCharactersPasswordValidatorId
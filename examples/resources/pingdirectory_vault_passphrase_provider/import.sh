@@ -0,0 +1,2 @@
+# "vaultPassphraseProviderId" should be the id of the Vault Passphrase Provider to be imported
+terraform import pingdirectory_vault_passphrase_provider.myVaultPassphraseProvider vaultPassphraseProviderId
@@ -0,0 +1,2 @@
+# "vaultPasswordStorageSchemeId" should be the id of the Vault Password Storage Scheme to be imported
+terraform import pingdirectory_vault_password_storage_scheme.myVaultPasswordStorageScheme vaultPasswordStorageSchemeId
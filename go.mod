@@ -14,6 +14,7 @@ require (
 	github.com/pavius/impi v0.0.3
 	github.com/pingidentity/pingdirectory-go-client/v9200 v9200.0.0
 	github.com/terraform-linters/tflint v0.45.0
+	golang.org/x/oauth2 v0.4.0
 )
 
 require (
@@ -249,7 +250,6 @@ require (
 	golang.org/x/lint v0.0.0-20210508222113-6edffad5e616 // indirect
 	golang.org/x/mod v0.9.0 // indirect
 	golang.org/x/net v0.8.0 // indirect
-	golang.org/x/oauth2 v0.1.0 // indirect
 	golang.org/x/sync v0.1.0 // indirect
 	golang.org/x/sys v0.6.0 // indirect
 	golang.org/x/text v0.8.0 // indirect
@@ -22,7 +22,6 @@ func ConfigurationPreCheck(t *testing.T) {
 		"PINGDIRECTORY_PROVIDER_USERNAME",
 		"PINGDIRECTORY_PROVIDER_PASSWORD",
 		"PINGDIRECTORY_PROVIDER_INSECURE_TRUST_ALL_TLS",
-		"PINGDIRECTORY_PROVIDER_PRODUCT_VERSION",
 	}
 
 	errorFound := false
@@ -0,0 +1,139 @@
+package alerthandler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdExecAlertHandler = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type execAlertHandlerTestModel struct {
+	id           string
+	command      string
+	enabled      bool
+	asynchronous bool
+}
+
+func TestAccExecAlertHandler(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := execAlertHandlerTestModel{
+		id:           testIdExecAlertHandler,
+		command:      "/opt/scripts/notify-operations.sh",
+		enabled:      false,
+		asynchronous: true,
+	}
+	updatedResourceModel := execAlertHandlerTestModel{
+		id:           testIdExecAlertHandler,
+		command:      "/opt/scripts/notify-on-call.sh",
+		enabled:      true,
+		asynchronous: false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckExecAlertHandlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccExecAlertHandlerResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedExecAlertHandlerAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccExecAlertHandlerResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedExecAlertHandlerAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccExecAlertHandlerResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_exec_alert_handler." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccExecAlertHandlerResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.AlertHandlerApi.DeleteAlertHandler(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Exec Alert Handler outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedExecAlertHandlerAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccExecAlertHandlerResource(resourceName string, resourceModel execAlertHandlerTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_exec_alert_handler" "%[1]s" {
+  id           = "%[2]s"
+  command      = "%[3]s"
+  enabled      = %[4]t
+  asynchronous = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.command,
+		resourceModel.enabled,
+		resourceModel.asynchronous)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedExecAlertHandlerAttributes(config execAlertHandlerTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.AlertHandlerApi.GetAlertHandler(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Exec Alert Handler"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "command",
+			config.command, response.ExecAlertHandlerResponse.Command)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.ExecAlertHandlerResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "asynchronous",
+			config.asynchronous, *response.ExecAlertHandlerResponse.Asynchronous)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckExecAlertHandlerDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.AlertHandlerApi.GetAlertHandler(ctx, testIdExecAlertHandler).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Exec Alert Handler", testIdExecAlertHandler)
+	}
+	return nil
+}
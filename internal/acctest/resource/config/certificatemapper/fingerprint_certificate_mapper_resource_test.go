@@ -0,0 +1,139 @@
+package certificatemapper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdFingerprintCertificateMapper = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type fingerprintCertificateMapperTestModel struct {
+	id                   string
+	fingerprintAttribute string
+	userBaseDn           []string
+	enabled              bool
+}
+
+func TestAccFingerprintCertificateMapper(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := fingerprintCertificateMapperTestModel{
+		id:                   testIdFingerprintCertificateMapper,
+		fingerprintAttribute: "ds-certificate-fingerprint",
+		userBaseDn:           []string{"ou=people,dc=example,dc=com"},
+		enabled:              true,
+	}
+	updatedResourceModel := fingerprintCertificateMapperTestModel{
+		id:                   testIdFingerprintCertificateMapper,
+		fingerprintAttribute: "ds-certificate-fingerprint",
+		userBaseDn:           []string{"ou=people,dc=example,dc=com", "ou=admins,dc=example,dc=com"},
+		enabled:              false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckFingerprintCertificateMapperDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccFingerprintCertificateMapperResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedFingerprintCertificateMapperAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccFingerprintCertificateMapperResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedFingerprintCertificateMapperAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccFingerprintCertificateMapperResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_fingerprint_certificate_mapper." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccFingerprintCertificateMapperResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.CertificateMapperApi.DeleteCertificateMapper(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Fingerprint Certificate Mapper outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedFingerprintCertificateMapperAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccFingerprintCertificateMapperResource(resourceName string, resourceModel fingerprintCertificateMapperTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_fingerprint_certificate_mapper" "%[1]s" {
+  id                    = "%[2]s"
+  fingerprint_attribute = "%[3]s"
+  user_base_dn          = %[4]s
+  enabled               = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.fingerprintAttribute,
+		acctest.StringSliceToTerraformString(resourceModel.userBaseDn),
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedFingerprintCertificateMapperAttributes(config fingerprintCertificateMapperTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.CertificateMapperApi.GetCertificateMapper(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Fingerprint Certificate Mapper"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "fingerprint-attribute",
+			config.fingerprintAttribute, response.FingerprintCertificateMapperResponse.FingerprintAttribute)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "user-base-dn",
+			config.userBaseDn, response.FingerprintCertificateMapperResponse.UserBaseDN)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.FingerprintCertificateMapperResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckFingerprintCertificateMapperDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.CertificateMapperApi.GetCertificateMapper(ctx, testIdFingerprintCertificateMapper).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Fingerprint Certificate Mapper", testIdFingerprintCertificateMapper)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package config_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdClientConnectionPolicy = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type clientConnectionPolicyTestModel struct {
+	id                           string
+	policyId                     string
+	enabled                      bool
+	evaluationOrderIndex         int64
+	maximumConcurrentConnections int64
+}
+
+func TestAccClientConnectionPolicy(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := clientConnectionPolicyTestModel{
+		id:                           testIdClientConnectionPolicy,
+		policyId:                     "my-policy",
+		enabled:                      false,
+		evaluationOrderIndex:         1000,
+		maximumConcurrentConnections: 0,
+	}
+	updatedResourceModel := clientConnectionPolicyTestModel{
+		id:                           testIdClientConnectionPolicy,
+		policyId:                     "my-policy",
+		enabled:                      true,
+		evaluationOrderIndex:         1100,
+		maximumConcurrentConnections: 100,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckClientConnectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccClientConnectionPolicyResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedClientConnectionPolicyAttributes(initialResourceModel),
+			},
+			{
+				// Test adding a policy that uses the evaluation order index of an existing policy
+				Config:      testAccClientConnectionPolicyDuplicateIndexResource(resourceName, initialResourceModel),
+				ExpectError: regexp.MustCompile("Duplicate evaluation order index"),
+			},
+			{
+				// Test updating some fields
+				Config: testAccClientConnectionPolicyResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedClientConnectionPolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccClientConnectionPolicyResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_client_connection_policy." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccClientConnectionPolicyResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.ClientConnectionPolicyApi.DeleteClientConnectionPolicy(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Client Connection Policy outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedClientConnectionPolicyAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccClientConnectionPolicyResource(resourceName string, resourceModel clientConnectionPolicyTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_client_connection_policy" "%[1]s" {
+  id                             = "%[2]s"
+  policy_id                      = "%[3]s"
+  enabled                        = %[4]t
+  evaluation_order_index         = %[5]d
+  maximum_concurrent_connections = %[6]d
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.policyId,
+		resourceModel.enabled,
+		resourceModel.evaluationOrderIndex,
+		resourceModel.maximumConcurrentConnections)
+}
+
+// A new policy can't use the evaluation order index of a policy that already exists on the server
+func testAccClientConnectionPolicyDuplicateIndexResource(resourceName string, resourceModel clientConnectionPolicyTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_client_connection_policy" "%[1]s" {
+  id                     = "%[2]s"
+  policy_id              = "%[3]s"
+  enabled                = %[4]t
+  evaluation_order_index = %[5]d
+}
+
+resource "pingdirectory_client_connection_policy" "%[1]sDuplicate" {
+  id                     = "%[2]sDuplicate"
+  policy_id              = "%[3]s-duplicate"
+  enabled                = %[4]t
+  evaluation_order_index = %[5]d
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.policyId,
+		resourceModel.enabled,
+		resourceModel.evaluationOrderIndex)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedClientConnectionPolicyAttributes(config clientConnectionPolicyTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ClientConnectionPolicyApi.GetClientConnectionPolicy(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Client Connection Policy"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "policy-id",
+			config.policyId, response.PolicyID)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.Enabled)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "evaluation-order-index",
+			config.evaluationOrderIndex, int64(response.EvaluationOrderIndex))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "maximum-concurrent-connections",
+			config.maximumConcurrentConnections, int64(*response.MaximumConcurrentConnections))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckClientConnectionPolicyDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.ClientConnectionPolicyApi.GetClientConnectionPolicy(ctx, testIdClientConnectionPolicy).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Client Connection Policy", testIdClientConnectionPolicy)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Attributes to test with. Add optional properties to test here if desired.
+type alarmManagerTestModel struct {
+	defaultGaugeAlertLevel string
+	generatedAlertTypes    []string
+}
+
+func TestAccAlarmManager(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := alarmManagerTestModel{
+		defaultGaugeAlertLevel: "critical-only",
+		generatedAlertTypes:    []string{"standard"},
+	}
+	updatedResourceModel := alarmManagerTestModel{
+		defaultGaugeAlertLevel: "major-and-above",
+		generatedAlertTypes:    []string{"alarm", "standard"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccAlarmManagerResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedAlarmManagerAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccAlarmManagerResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedAlarmManagerAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:       testAccAlarmManagerResource(resourceName, updatedResourceModel),
+				ResourceName: "pingdirectory_default_alarm_manager." + resourceName,
+				// The id doesn't matter for singleton config objects
+				ImportStateId:           resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccAlarmManagerResource(resourceName string, resourceModel alarmManagerTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_default_alarm_manager" "%[1]s" {
+  default_gauge_alert_level = "%[2]s"
+  generated_alert_types     = %[3]s
+}`, resourceName,
+		resourceModel.defaultGaugeAlertLevel,
+		acctest.StringSliceToTerraformString(resourceModel.generatedAlertTypes))
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedAlarmManagerAttributes(config alarmManagerTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.AlarmManagerApi.GetAlarmManager(ctx).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Alarm Manager"
+		err = acctest.TestAttributesMatchString(resourceType, nil, "default-gauge-alert-level",
+			config.defaultGaugeAlertLevel, string(response.DefaultGaugeAlertLevel))
+		if err != nil {
+			return err
+		}
+		var generatedAlertTypes []string
+		for _, alertType := range response.GeneratedAlertTypes {
+			generatedAlertTypes = append(generatedAlertTypes, string(alertType))
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, nil, "generated-alert-types",
+			config.generatedAlertTypes, generatedAlertTypes)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package extendedoperationhandler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdValidateTotpPasswordExtendedOperationHandler = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type validateTotpPasswordExtendedOperationHandlerTestModel struct {
+	id                       string
+	timeIntervalDuration     string
+	adjacentIntervalsToCheck int64
+	enabled                  bool
+}
+
+func TestAccValidateTotpPasswordExtendedOperationHandler(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := validateTotpPasswordExtendedOperationHandlerTestModel{
+		id:                       testIdValidateTotpPasswordExtendedOperationHandler,
+		timeIntervalDuration:     "30 s",
+		adjacentIntervalsToCheck: 2,
+		enabled:                  true,
+	}
+	updatedResourceModel := validateTotpPasswordExtendedOperationHandlerTestModel{
+		id:                       testIdValidateTotpPasswordExtendedOperationHandler,
+		timeIntervalDuration:     "1 m",
+		adjacentIntervalsToCheck: 3,
+		enabled:                  false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckValidateTotpPasswordExtendedOperationHandlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccValidateTotpPasswordExtendedOperationHandlerResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedValidateTotpPasswordExtendedOperationHandlerAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccValidateTotpPasswordExtendedOperationHandlerResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedValidateTotpPasswordExtendedOperationHandlerAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccValidateTotpPasswordExtendedOperationHandlerResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_validate_totp_password_extended_operation_handler." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccValidateTotpPasswordExtendedOperationHandlerResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.ExtendedOperationHandlerApi.DeleteExtendedOperationHandler(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Validate Totp Password Extended Operation Handler outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedValidateTotpPasswordExtendedOperationHandlerAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccValidateTotpPasswordExtendedOperationHandlerResource(resourceName string, resourceModel validateTotpPasswordExtendedOperationHandlerTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_validate_totp_password_extended_operation_handler" "%[1]s" {
+  id                          = "%[2]s"
+  time_interval_duration      = "%[3]s"
+  adjacent_intervals_to_check = %[4]d
+  enabled                     = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.timeIntervalDuration,
+		resourceModel.adjacentIntervalsToCheck,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedValidateTotpPasswordExtendedOperationHandlerAttributes(config validateTotpPasswordExtendedOperationHandlerTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ExtendedOperationHandlerApi.GetExtendedOperationHandler(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Validate Totp Password Extended Operation Handler"
+		err = acctest.TestAttributesMatchStringPointer(resourceType, &config.id, "time-interval-duration",
+			config.timeIntervalDuration, response.ValidateTotpPasswordExtendedOperationHandlerResponse.TimeIntervalDuration)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "adjacent-intervals-to-check",
+			config.adjacentIntervalsToCheck, int64(*response.ValidateTotpPasswordExtendedOperationHandlerResponse.AdjacentIntervalsToCheck))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.ValidateTotpPasswordExtendedOperationHandlerResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckValidateTotpPasswordExtendedOperationHandlerDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.ExtendedOperationHandlerApi.GetExtendedOperationHandler(ctx, testIdValidateTotpPasswordExtendedOperationHandler).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Validate Totp Password Extended Operation Handler", testIdValidateTotpPasswordExtendedOperationHandler)
+	}
+	return nil
+}
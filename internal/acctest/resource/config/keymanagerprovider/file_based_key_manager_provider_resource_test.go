@@ -0,0 +1,160 @@
+package keymanagerprovider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdFileBasedKeyManagerProvider = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type fileBasedKeyManagerProviderTestModel struct {
+	id              string
+	keyStoreFile    string
+	keyStorePinFile string
+	enabled         bool
+}
+
+func TestAccFileBasedKeyManagerProvider(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := fileBasedKeyManagerProviderTestModel{
+		id:              testIdFileBasedKeyManagerProvider,
+		keyStoreFile:    "config/keystore",
+		keyStorePinFile: "config/keystore.pin",
+		enabled:         true,
+	}
+	updatedResourceModel := fileBasedKeyManagerProviderTestModel{
+		id:              testIdFileBasedKeyManagerProvider,
+		keyStoreFile:    "config/keystore",
+		keyStorePinFile: "config/keystore.pin",
+		enabled:         false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckFileBasedKeyManagerProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test validation of conflicting PIN attributes
+				Config:      testAccFileBasedKeyManagerProviderConflictingPinResource(resourceName, initialResourceModel),
+				ExpectError: regexp.MustCompile("Conflicting attributes"),
+			},
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccFileBasedKeyManagerProviderResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedFileBasedKeyManagerProviderAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccFileBasedKeyManagerProviderResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedFileBasedKeyManagerProviderAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccFileBasedKeyManagerProviderResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_file_based_key_manager_provider." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccFileBasedKeyManagerProviderResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.KeyManagerProviderApi.DeleteKeyManagerProvider(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete File Based Key Manager Provider outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedFileBasedKeyManagerProviderAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccFileBasedKeyManagerProviderResource(resourceName string, resourceModel fileBasedKeyManagerProviderTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_file_based_key_manager_provider" "%[1]s" {
+  id                 = "%[2]s"
+  key_store_file     = "%[3]s"
+  key_store_pin_file = "%[4]s"
+  enabled            = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.keyStoreFile,
+		resourceModel.keyStorePinFile,
+		resourceModel.enabled)
+}
+
+func testAccFileBasedKeyManagerProviderConflictingPinResource(resourceName string, resourceModel fileBasedKeyManagerProviderTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_file_based_key_manager_provider" "%[1]s" {
+  id                 = "%[2]s"
+  key_store_file     = "%[3]s"
+  key_store_pin      = "password"
+  key_store_pin_file = "%[4]s"
+  enabled            = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.keyStoreFile,
+		resourceModel.keyStorePinFile,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedFileBasedKeyManagerProviderAttributes(config fileBasedKeyManagerProviderTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.KeyManagerProviderApi.GetKeyManagerProvider(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "File Based Key Manager Provider"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "key-store-file",
+			config.keyStoreFile, response.FileBasedKeyManagerProviderResponse.KeyStoreFile)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringPointer(resourceType, &config.id, "key-store-pin-file",
+			config.keyStorePinFile, response.FileBasedKeyManagerProviderResponse.KeyStorePinFile)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.FileBasedKeyManagerProviderResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckFileBasedKeyManagerProviderDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.KeyManagerProviderApi.GetKeyManagerProvider(ctx, testIdFileBasedKeyManagerProvider).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("File Based Key Manager Provider", testIdFileBasedKeyManagerProvider)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdLocalDbCompositeIndex = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type localDbCompositeIndexTestModel struct {
+	id                 string
+	backendName        string
+	indexFilterPattern string
+	indexEntryLimit    int64
+}
+
+func TestAccLocalDbCompositeIndex(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := localDbCompositeIndexTestModel{
+		id:                 testIdLocalDbCompositeIndex,
+		backendName:        testBackendName,
+		indexFilterPattern: "(departmentNumber=?)",
+		indexEntryLimit:    4000,
+	}
+	updatedResourceModel := localDbCompositeIndexTestModel{
+		id:                 testIdLocalDbCompositeIndex,
+		backendName:        testBackendName,
+		indexFilterPattern: "(departmentNumber=?)",
+		indexEntryLimit:    5000,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckLocalDbCompositeIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccLocalDbCompositeIndexResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedLocalDbCompositeIndexAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccLocalDbCompositeIndexResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedLocalDbCompositeIndexAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccLocalDbCompositeIndexResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_local_db_composite_index." + resourceName,
+				ImportStateId:     updatedResourceModel.backendName + "/" + updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccLocalDbCompositeIndexResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LocalDbCompositeIndexApi.DeleteLocalDbCompositeIndex(ctx, updatedResourceModel.id, updatedResourceModel.backendName).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Local Db Composite Index outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedLocalDbCompositeIndexAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccLocalDbCompositeIndexResource(resourceName string, resourceModel localDbCompositeIndexTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_local_db_composite_index" "%[1]s" {
+  id                   = "%[2]s"
+  backend_name         = "%[3]s"
+  index_filter_pattern = "%[4]s"
+  index_entry_limit    = %[5]d
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.backendName,
+		resourceModel.indexFilterPattern,
+		resourceModel.indexEntryLimit)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedLocalDbCompositeIndexAttributes(config localDbCompositeIndexTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.LocalDbCompositeIndexApi.GetLocalDbCompositeIndex(ctx, config.id, config.backendName).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Local Db Composite Index"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "index-filter-pattern",
+			config.indexFilterPattern, response.IndexFilterPattern)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "index-entry-limit",
+			config.indexEntryLimit, int64(*response.IndexEntryLimit))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckLocalDbCompositeIndexDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LocalDbCompositeIndexApi.GetLocalDbCompositeIndex(ctx, testIdLocalDbCompositeIndex, testBackendName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Local Db Composite Index", testIdLocalDbCompositeIndex)
+	}
+	return nil
+}
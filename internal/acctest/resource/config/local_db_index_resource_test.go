@@ -65,6 +65,19 @@ func TestAccLocalDbIndex(t *testing.T) {
 					"last_updated",
 				},
 			},
+			{
+				// Test plan after removing config on server
+				Config: testAccLocalDbIndexResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LocalDbIndexApi.DeleteLocalDbIndex(ctx, updatedResourceModel.attribute, updatedResourceModel.backendName).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Local Db Index outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedLocalDbIndexAttributes(updatedResourceModel),
+			},
 		},
 	})
 }
@@ -0,0 +1,164 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdLocalDbVlvIndex = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type localDbVlvIndexTestModel struct {
+	id          string
+	backendName string
+	baseDn      string
+	scope       string
+	filter      string
+	sortOrder   string
+	name        string
+}
+
+func TestAccLocalDbVlvIndex(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := localDbVlvIndexTestModel{
+		id:          testIdLocalDbVlvIndex,
+		backendName: testBackendName,
+		baseDn:      "dc=example,dc=com",
+		scope:       "whole-subtree",
+		filter:      "(objectClass=person)",
+		sortOrder:   "sn",
+		name:        testIdLocalDbVlvIndex,
+	}
+	updatedResourceModel := localDbVlvIndexTestModel{
+		id:          testIdLocalDbVlvIndex,
+		backendName: testBackendName,
+		baseDn:      "dc=example,dc=com",
+		scope:       "single-level",
+		filter:      "(objectClass=inetOrgPerson)",
+		sortOrder:   "sn givenName",
+		name:        testIdLocalDbVlvIndex,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckLocalDbVlvIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccLocalDbVlvIndexResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedLocalDbVlvIndexAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccLocalDbVlvIndexResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedLocalDbVlvIndexAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccLocalDbVlvIndexResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_local_db_vlv_index." + resourceName,
+				ImportStateId:     updatedResourceModel.backendName + "/" + updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccLocalDbVlvIndexResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LocalDbVlvIndexApi.DeleteLocalDbVlvIndex(ctx, updatedResourceModel.id, updatedResourceModel.backendName).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Local Db Vlv Index outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedLocalDbVlvIndexAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccLocalDbVlvIndexResource(resourceName string, resourceModel localDbVlvIndexTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_local_db_vlv_index" "%[1]s" {
+  id           = "%[2]s"
+  backend_name = "%[3]s"
+  base_dn      = "%[4]s"
+  scope        = "%[5]s"
+  filter       = "%[6]s"
+  sort_order   = "%[7]s"
+  name         = "%[8]s"
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.backendName,
+		resourceModel.baseDn,
+		resourceModel.scope,
+		resourceModel.filter,
+		resourceModel.sortOrder,
+		resourceModel.name)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedLocalDbVlvIndexAttributes(config localDbVlvIndexTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.LocalDbVlvIndexApi.GetLocalDbVlvIndex(ctx, config.id, config.backendName).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Local Db Vlv Index"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "base-dn",
+			config.baseDn, response.BaseDN)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "scope",
+			config.scope, string(response.Scope))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "filter",
+			config.filter, response.Filter)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "sort-order",
+			config.sortOrder, response.SortOrder)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckLocalDbVlvIndexDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LocalDbVlvIndexApi.GetLocalDbVlvIndex(ctx, testIdLocalDbVlvIndex, testBackendName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Local Db Vlv Index", testIdLocalDbVlvIndex)
+	}
+	return nil
+}
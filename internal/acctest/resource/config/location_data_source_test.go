@@ -0,0 +1,49 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const dataSourceLocationName = "Johto"
+
+func TestAccLocationDataSource(t *testing.T) {
+	resourceName := "TestLocation"
+	dataSourceName := "TestLocationDataSource"
+	locationDescription := "Home of Lugia"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test reading the location with a data source
+				Config: testAccLocationDataSource(resourceName, dataSourceName, dataSourceLocationName, locationDescription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_location.%s", dataSourceName), "id", dataSourceLocationName),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_location.%s", dataSourceName), "description", locationDescription),
+				),
+			},
+		},
+	})
+}
+
+func testAccLocationDataSource(resourceName, dataSourceName, locationName, description string) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_location" "%[1]s" {
+  id          = "%[3]s"
+  description = "%[4]s"
+}
+
+data "pingdirectory_location" "%[2]s" {
+  id = pingdirectory_location.%[1]s.id
+}`, resourceName, dataSourceName, locationName, description)
+}
@@ -64,6 +64,21 @@ func TestAccLocation(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"last_updated"},
 			},
+			{
+				// Test plan after removing config on server
+				Config: testAccLocationResource(resourceName, updatedLocationName, locationDescription),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LocationApi.DeleteLocation(ctx, updatedLocationName).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete location outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedLocationAttributes(updatedLocationName, locationDescription),
+				),
+			},
 		},
 	})
 }
@@ -88,7 +103,7 @@ func testAccCheckLocationDestroy(s *terraform.State) error {
 	testClient := acctest.TestClient()
 	ctx := acctest.TestBasicAuthContext()
 	// Check for location names used in this test
-	names := []string{locationName, updatedLocationName}
+	names := []string{locationName, updatedLocationName, dataSourceLocationName, listDataSourceLocationName}
 	for _, name := range names {
 		_, _, err := testClient.LocationApi.GetLocation(ctx, name).Execute()
 		if err == nil {
@@ -0,0 +1,49 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const listDataSourceLocationName = "Sinnoh"
+
+func TestAccLocationsDataSource(t *testing.T) {
+	resourceName := "TestLocation"
+	dataSourceName := "TestLocationsDataSource"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test listing locations filtered by name
+				Config: testAccLocationsDataSource(resourceName, dataSourceName, listDataSourceLocationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_locations.%s", dataSourceName), "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fmt.Sprintf("data.pingdirectory_locations.%s", dataSourceName), "ids.*", listDataSourceLocationName),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.pingdirectory_locations.%s", dataSourceName), "objects.0.type", "location"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLocationsDataSource(resourceName, dataSourceName, locationName string) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_location" "%[1]s" {
+  id = "%[3]s"
+}
+
+data "pingdirectory_locations" "%[2]s" {
+  name_regex = "^%[3]s$"
+  depends_on = [pingdirectory_location.%[1]s]
+}`, resourceName, dataSourceName, locationName)
+}
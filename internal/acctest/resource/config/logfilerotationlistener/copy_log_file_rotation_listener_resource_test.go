@@ -0,0 +1,139 @@
+package logfilerotationlistener_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdCopyLogFileRotationListener = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type copyLogFileRotationListenerTestModel struct {
+	id              string
+	copyToDirectory string
+	compressOnCopy  bool
+	enabled         bool
+}
+
+func TestAccCopyLogFileRotationListener(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := copyLogFileRotationListenerTestModel{
+		id:              testIdCopyLogFileRotationListener,
+		copyToDirectory: "/tmp",
+		compressOnCopy:  false,
+		enabled:         true,
+	}
+	updatedResourceModel := copyLogFileRotationListenerTestModel{
+		id:              testIdCopyLogFileRotationListener,
+		copyToDirectory: "/tmp/archive",
+		compressOnCopy:  true,
+		enabled:         false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckCopyLogFileRotationListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccCopyLogFileRotationListenerResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedCopyLogFileRotationListenerAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccCopyLogFileRotationListenerResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedCopyLogFileRotationListenerAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccCopyLogFileRotationListenerResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_copy_log_file_rotation_listener." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccCopyLogFileRotationListenerResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LogFileRotationListenerApi.DeleteLogFileRotationListener(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Copy Log File Rotation Listener outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedCopyLogFileRotationListenerAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccCopyLogFileRotationListenerResource(resourceName string, resourceModel copyLogFileRotationListenerTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_copy_log_file_rotation_listener" "%[1]s" {
+  id                = "%[2]s"
+  copy_to_directory = "%[3]s"
+  compress_on_copy  = %[4]t
+  enabled           = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.copyToDirectory,
+		resourceModel.compressOnCopy,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedCopyLogFileRotationListenerAttributes(config copyLogFileRotationListenerTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.LogFileRotationListenerApi.GetLogFileRotationListener(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Copy Log File Rotation Listener"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "copy-to-directory",
+			config.copyToDirectory, response.CopyLogFileRotationListenerResponse.CopyToDirectory)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "compress-on-copy",
+			config.compressOnCopy, *response.CopyLogFileRotationListenerResponse.CompressOnCopy)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.CopyLogFileRotationListenerResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckCopyLogFileRotationListenerDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LogFileRotationListenerApi.GetLogFileRotationListener(ctx, testIdCopyLogFileRotationListener).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Copy Log File Rotation Listener", testIdCopyLogFileRotationListener)
+	}
+	return nil
+}
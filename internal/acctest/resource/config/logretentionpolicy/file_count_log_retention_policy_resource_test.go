@@ -0,0 +1,133 @@
+package logretentionpolicy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdFileCountLogRetentionPolicy = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type fileCountLogRetentionPolicyTestModel struct {
+	id            string
+	numberOfFiles int64
+}
+
+func TestAccFileCountLogRetentionPolicy(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := fileCountLogRetentionPolicyTestModel{
+		id:            testIdFileCountLogRetentionPolicy,
+		numberOfFiles: 10,
+	}
+	updatedResourceModel := fileCountLogRetentionPolicyTestModel{
+		id:            testIdFileCountLogRetentionPolicy,
+		numberOfFiles: 20,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckFileCountLogRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccFileCountLogRetentionPolicyResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedFileCountLogRetentionPolicyAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccFileCountLogRetentionPolicyResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedFileCountLogRetentionPolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccFileCountLogRetentionPolicyResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_file_count_log_retention_policy." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccFileCountLogRetentionPolicyResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LogRetentionPolicyApi.DeleteLogRetentionPolicy(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete File Count Log Retention Policy outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedFileCountLogRetentionPolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test reading a config object of a different type
+				Config:      testAccFileCountLogRetentionPolicyMismatchedTypeDataSource(),
+				ExpectError: regexp.MustCompile("is a size-limit log retention policy"),
+			},
+		},
+	})
+}
+
+func testAccFileCountLogRetentionPolicyResource(resourceName string, resourceModel fileCountLogRetentionPolicyTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_file_count_log_retention_policy" "%[1]s" {
+  id              = "%[2]s"
+  number_of_files = %[3]d
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.numberOfFiles)
+}
+
+// The built-in Size Limit Retention Policy is a Size Limit Log Retention Policy
+func testAccFileCountLogRetentionPolicyMismatchedTypeDataSource() string {
+	return `
+data "pingdirectory_file_count_log_retention_policy" "mismatched" {
+  id = "Size Limit Retention Policy"
+}`
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedFileCountLogRetentionPolicyAttributes(config fileCountLogRetentionPolicyTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.LogRetentionPolicyApi.GetLogRetentionPolicy(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "File Count Log Retention Policy"
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "number-of-files",
+			config.numberOfFiles, int64(response.FileCountLogRetentionPolicyResponse.NumberOfFiles))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckFileCountLogRetentionPolicyDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LogRetentionPolicyApi.GetLogRetentionPolicy(ctx, testIdFileCountLogRetentionPolicy).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("File Count Log Retention Policy", testIdFileCountLogRetentionPolicy)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package logrotationpolicy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdSizeLimitLogRotationPolicy = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type sizeLimitLogRotationPolicyTestModel struct {
+	id            string
+	fileSizeLimit string
+}
+
+func TestAccSizeLimitLogRotationPolicy(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := sizeLimitLogRotationPolicyTestModel{
+		id:            testIdSizeLimitLogRotationPolicy,
+		fileSizeLimit: "100 mb",
+	}
+	updatedResourceModel := sizeLimitLogRotationPolicyTestModel{
+		id:            testIdSizeLimitLogRotationPolicy,
+		fileSizeLimit: "200 mb",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckSizeLimitLogRotationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccSizeLimitLogRotationPolicyResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedSizeLimitLogRotationPolicyAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccSizeLimitLogRotationPolicyResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedSizeLimitLogRotationPolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccSizeLimitLogRotationPolicyResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_size_limit_log_rotation_policy." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccSizeLimitLogRotationPolicyResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.LogRotationPolicyApi.DeleteLogRotationPolicy(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Size Limit Log Rotation Policy outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedSizeLimitLogRotationPolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test reading a config object of a different type
+				Config:      testAccSizeLimitLogRotationPolicyMismatchedTypeDataSource(),
+				ExpectError: regexp.MustCompile("is a time-limit log rotation policy"),
+			},
+		},
+	})
+}
+
+func testAccSizeLimitLogRotationPolicyResource(resourceName string, resourceModel sizeLimitLogRotationPolicyTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_size_limit_log_rotation_policy" "%[1]s" {
+  id              = "%[2]s"
+  file_size_limit = "%[3]s"
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.fileSizeLimit)
+}
+
+// The built-in 24 Hours Time Limit Rotation Policy is a Time Limit Log Rotation Policy
+func testAccSizeLimitLogRotationPolicyMismatchedTypeDataSource() string {
+	return `
+data "pingdirectory_size_limit_log_rotation_policy" "mismatched" {
+  id = "24 Hours Time Limit Rotation Policy"
+}`
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedSizeLimitLogRotationPolicyAttributes(config sizeLimitLogRotationPolicyTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.LogRotationPolicyApi.GetLogRotationPolicy(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Size Limit Log Rotation Policy"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "file-size-limit",
+			config.fileSizeLimit, response.SizeLimitLogRotationPolicyResponse.FileSizeLimit)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckSizeLimitLogRotationPolicyDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LogRotationPolicyApi.GetLogRotationPolicy(ctx, testIdSizeLimitLogRotationPolicy).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Size Limit Log Rotation Policy", testIdSizeLimitLogRotationPolicy)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package monitorprovider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const defaultHostSystemMonitorProviderId = "Host System"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type hostSystemMonitorProviderTestModel struct {
+	id             string
+	enabled        bool
+	diskDevices    []string
+	networkDevices []string
+}
+
+func TestAccHostSystemMonitorProvider(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := hostSystemMonitorProviderTestModel{
+		id:             defaultHostSystemMonitorProviderId,
+		enabled:        true,
+		diskDevices:    []string{"sda"},
+		networkDevices: []string{"eth0"},
+	}
+	updatedResourceModel := hostSystemMonitorProviderTestModel{
+		id:             defaultHostSystemMonitorProviderId,
+		enabled:        false,
+		diskDevices:    []string{"sda", "sdb"},
+		networkDevices: []string{"eth0", "lo"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckHostSystemMonitorProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccHostSystemMonitorProviderResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedHostSystemMonitorProviderAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccHostSystemMonitorProviderResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedHostSystemMonitorProviderAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:                  testAccHostSystemMonitorProviderResource(resourceName, updatedResourceModel),
+				ResourceName:            "pingdirectory_default_host_system_monitor_provider." + resourceName,
+				ImportStateId:           updatedResourceModel.id,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccHostSystemMonitorProviderResource(resourceName string, resourceModel hostSystemMonitorProviderTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_default_host_system_monitor_provider" "%[1]s" {
+  id              = "%[2]s"
+  enabled         = %[3]t
+  disk_devices    = %[4]s
+  network_devices = %[5]s
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.enabled,
+		acctest.StringSliceToTerraformString(resourceModel.diskDevices),
+		acctest.StringSliceToTerraformString(resourceModel.networkDevices))
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedHostSystemMonitorProviderAttributes(config hostSystemMonitorProviderTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.MonitorProviderApi.GetMonitorProvider(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Host System Monitor Provider"
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.HostSystemMonitorProviderResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "disk-devices",
+			config.diskDevices, response.HostSystemMonitorProviderResponse.DiskDevices)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "network-devices",
+			config.networkDevices, response.HostSystemMonitorProviderResponse.NetworkDevices)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Verify that the default monitor provider is NOT destroyed
+func testAccCheckHostSystemMonitorProviderDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.MonitorProviderApi.GetMonitorProvider(ctx, defaultHostSystemMonitorProviderId).Execute()
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package passphraseprovider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdObscuredValuePassphraseProvider = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type obscuredValuePassphraseProviderTestModel struct {
+	id            string
+	obscuredValue string
+	enabled       bool
+}
+
+func TestAccObscuredValuePassphraseProvider(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := obscuredValuePassphraseProviderTestModel{
+		id:            testIdObscuredValuePassphraseProvider,
+		obscuredValue: "myobscuredvalue",
+		enabled:       true,
+	}
+	updatedResourceModel := obscuredValuePassphraseProviderTestModel{
+		id:            testIdObscuredValuePassphraseProvider,
+		obscuredValue: "myupdatedobscuredvalue",
+		enabled:       false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckObscuredValuePassphraseProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccObscuredValuePassphraseProviderResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedObscuredValuePassphraseProviderAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccObscuredValuePassphraseProviderResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedObscuredValuePassphraseProviderAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccObscuredValuePassphraseProviderResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_obscured_value_passphrase_provider." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+					"obscured_value",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccObscuredValuePassphraseProviderResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.PassphraseProviderApi.DeletePassphraseProvider(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Obscured Value Passphrase Provider outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedObscuredValuePassphraseProviderAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccObscuredValuePassphraseProviderResource(resourceName string, resourceModel obscuredValuePassphraseProviderTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_obscured_value_passphrase_provider" "%[1]s" {
+  id             = "%[2]s"
+  obscured_value = "%[3]s"
+  enabled        = %[4]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.obscuredValue,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedObscuredValuePassphraseProviderAttributes(config obscuredValuePassphraseProviderTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.PassphraseProviderApi.GetPassphraseProvider(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Obscured Value Passphrase Provider"
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.ObscuredValuePassphraseProviderResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckObscuredValuePassphraseProviderDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.PassphraseProviderApi.GetPassphraseProvider(ctx, testIdObscuredValuePassphraseProvider).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Obscured Value Passphrase Provider", testIdObscuredValuePassphraseProvider)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdPasswordPolicy = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type passwordPolicyTestModel struct {
+	id                           string
+	passwordAttribute            string
+	defaultPasswordStorageScheme []string
+	passwordHistoryCount         int64
+	lockoutFailureCount          int64
+}
+
+func TestAccPasswordPolicy(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := passwordPolicyTestModel{
+		id:                           testIdPasswordPolicy,
+		passwordAttribute:            "userPassword",
+		defaultPasswordStorageScheme: []string{"Salted SHA-256"},
+		passwordHistoryCount:         0,
+		lockoutFailureCount:          0,
+	}
+	updatedResourceModel := passwordPolicyTestModel{
+		id:                           testIdPasswordPolicy,
+		passwordAttribute:            "userPassword",
+		defaultPasswordStorageScheme: []string{"Salted SHA-256", "Salted SHA-512"},
+		passwordHistoryCount:         4,
+		lockoutFailureCount:          5,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckPasswordPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccPasswordPolicyResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedPasswordPolicyAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccPasswordPolicyResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedPasswordPolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccPasswordPolicyResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_password_policy." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccPasswordPolicyResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.PasswordPolicyApi.DeletePasswordPolicy(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Password Policy outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedPasswordPolicyAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccPasswordPolicyResource(resourceName string, resourceModel passwordPolicyTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_password_policy" "%[1]s" {
+  id                              = "%[2]s"
+  password_attribute              = "%[3]s"
+  default_password_storage_scheme = %[4]s
+  password_history_count          = %[5]d
+  lockout_failure_count           = %[6]d
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.passwordAttribute,
+		acctest.StringSliceToTerraformString(resourceModel.defaultPasswordStorageScheme),
+		resourceModel.passwordHistoryCount,
+		resourceModel.lockoutFailureCount)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedPasswordPolicyAttributes(config passwordPolicyTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.PasswordPolicyApi.GetPasswordPolicy(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Password Policy"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "password-attribute",
+			config.passwordAttribute, response.PasswordAttribute)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "default-password-storage-scheme",
+			config.defaultPasswordStorageScheme, response.DefaultPasswordStorageScheme)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "password-history-count",
+			config.passwordHistoryCount, int64(*response.PasswordHistoryCount))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "lockout-failure-count",
+			config.lockoutFailureCount, int64(*response.LockoutFailureCount))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckPasswordPolicyDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.PasswordPolicyApi.GetPasswordPolicy(ctx, testIdPasswordPolicy).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Password Policy", testIdPasswordPolicy)
+	}
+	return nil
+}
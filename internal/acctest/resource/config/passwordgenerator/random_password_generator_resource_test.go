@@ -0,0 +1,139 @@
+package passwordgenerator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdRandomPasswordGenerator = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type randomPasswordGeneratorTestModel struct {
+	id                   string
+	passwordCharacterSet []string
+	passwordFormat       string
+	enabled              bool
+}
+
+func TestAccRandomPasswordGenerator(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := randomPasswordGeneratorTestModel{
+		id:                   testIdRandomPasswordGenerator,
+		passwordCharacterSet: []string{"alpha:abcdefghijklmnopqrstuvwxyz"},
+		passwordFormat:       "alpha:8",
+		enabled:              true,
+	}
+	updatedResourceModel := randomPasswordGeneratorTestModel{
+		id:                   testIdRandomPasswordGenerator,
+		passwordCharacterSet: []string{"alpha:abcdefghijklmnopqrstuvwxyz", "numeric:0123456789"},
+		passwordFormat:       "alpha:6,numeric:2",
+		enabled:              false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckRandomPasswordGeneratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccRandomPasswordGeneratorResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedRandomPasswordGeneratorAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccRandomPasswordGeneratorResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedRandomPasswordGeneratorAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccRandomPasswordGeneratorResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_random_password_generator." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccRandomPasswordGeneratorResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.PasswordGeneratorApi.DeletePasswordGenerator(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Random Password Generator outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedRandomPasswordGeneratorAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccRandomPasswordGeneratorResource(resourceName string, resourceModel randomPasswordGeneratorTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_random_password_generator" "%[1]s" {
+  id                     = "%[2]s"
+  password_character_set = %[3]s
+  password_format        = "%[4]s"
+  enabled                = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		acctest.StringSliceToTerraformString(resourceModel.passwordCharacterSet),
+		resourceModel.passwordFormat,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedRandomPasswordGeneratorAttributes(config randomPasswordGeneratorTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.PasswordGeneratorApi.GetPasswordGenerator(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Random Password Generator"
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "password-character-set",
+			config.passwordCharacterSet, response.RandomPasswordGeneratorResponse.PasswordCharacterSet)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "password-format",
+			config.passwordFormat, response.RandomPasswordGeneratorResponse.PasswordFormat)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.RandomPasswordGeneratorResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckRandomPasswordGeneratorDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.PasswordGeneratorApi.GetPasswordGenerator(ctx, testIdRandomPasswordGenerator).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Random Password Generator", testIdRandomPasswordGenerator)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package passwordstoragescheme_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdPbkdf2PasswordStorageScheme = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type pbkdf2PasswordStorageSchemeTestModel struct {
+	id             string
+	iterationCount int64
+	enabled        bool
+}
+
+func TestAccPbkdf2PasswordStorageScheme(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := pbkdf2PasswordStorageSchemeTestModel{
+		id:             testIdPbkdf2PasswordStorageScheme,
+		iterationCount: 10000,
+		enabled:        true,
+	}
+	updatedResourceModel := pbkdf2PasswordStorageSchemeTestModel{
+		id:             testIdPbkdf2PasswordStorageScheme,
+		iterationCount: 20000,
+		enabled:        false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckPbkdf2PasswordStorageSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccPbkdf2PasswordStorageSchemeResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedPbkdf2PasswordStorageSchemeAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccPbkdf2PasswordStorageSchemeResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedPbkdf2PasswordStorageSchemeAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccPbkdf2PasswordStorageSchemeResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_pbkdf2_password_storage_scheme." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccPbkdf2PasswordStorageSchemeResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.PasswordStorageSchemeApi.DeletePasswordStorageScheme(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete PBKDF2 Password Storage Scheme outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedPbkdf2PasswordStorageSchemeAttributes(updatedResourceModel),
+			},
+			{
+				// Test reading a config object of a different type
+				Config:      testAccPbkdf2PasswordStorageSchemeMismatchedTypeDataSource(),
+				ExpectError: regexp.MustCompile("is a salted-sha256 password storage scheme"),
+			},
+		},
+	})
+}
+
+func testAccPbkdf2PasswordStorageSchemeResource(resourceName string, resourceModel pbkdf2PasswordStorageSchemeTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_pbkdf2_password_storage_scheme" "%[1]s" {
+  id              = "%[2]s"
+  iteration_count = %[3]d
+  enabled         = %[4]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.iterationCount,
+		resourceModel.enabled)
+}
+
+// The built-in Salted SHA-256 storage scheme is a Salted SHA256 Password Storage Scheme
+func testAccPbkdf2PasswordStorageSchemeMismatchedTypeDataSource() string {
+	return `
+data "pingdirectory_pbkdf2_password_storage_scheme" "mismatched" {
+  id = "Salted SHA-256"
+}`
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedPbkdf2PasswordStorageSchemeAttributes(config pbkdf2PasswordStorageSchemeTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.PasswordStorageSchemeApi.GetPasswordStorageScheme(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "PBKDF2 Password Storage Scheme"
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "iteration-count",
+			config.iterationCount, int64(response.Pbkdf2PasswordStorageSchemeResponse.IterationCount))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.Pbkdf2PasswordStorageSchemeResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckPbkdf2PasswordStorageSchemeDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.PasswordStorageSchemeApi.GetPasswordStorageScheme(ctx, testIdPbkdf2PasswordStorageScheme).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("PBKDF2 Password Storage Scheme", testIdPbkdf2PasswordStorageScheme)
+	}
+	return nil
+}
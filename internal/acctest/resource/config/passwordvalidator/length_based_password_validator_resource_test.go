@@ -0,0 +1,153 @@
+package passwordvalidator_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdLengthBasedPasswordValidator = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type lengthBasedPasswordValidatorTestModel struct {
+	id                string
+	minPasswordLength int64
+	maxPasswordLength int64
+	enabled           bool
+}
+
+func TestAccLengthBasedPasswordValidator(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := lengthBasedPasswordValidatorTestModel{
+		id:                testIdLengthBasedPasswordValidator,
+		minPasswordLength: 8,
+		maxPasswordLength: 256,
+		enabled:           true,
+	}
+	updatedResourceModel := lengthBasedPasswordValidatorTestModel{
+		id:                testIdLengthBasedPasswordValidator,
+		minPasswordLength: 12,
+		maxPasswordLength: 128,
+		enabled:           false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckLengthBasedPasswordValidatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccLengthBasedPasswordValidatorResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedLengthBasedPasswordValidatorAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccLengthBasedPasswordValidatorResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedLengthBasedPasswordValidatorAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccLengthBasedPasswordValidatorResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_length_based_password_validator." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccLengthBasedPasswordValidatorResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.PasswordValidatorApi.DeletePasswordValidator(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Length Based Password Validator outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedLengthBasedPasswordValidatorAttributes(updatedResourceModel),
+			},
+			{
+				// Test reading a config object of a different type
+				Config:      testAccLengthBasedPasswordValidatorMismatchedTypeDataSource(),
+				ExpectError: regexp.MustCompile("is a character-set password validator"),
+			},
+		},
+	})
+}
+
+func testAccLengthBasedPasswordValidatorResource(resourceName string, resourceModel lengthBasedPasswordValidatorTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_length_based_password_validator" "%[1]s" {
+  id                  = "%[2]s"
+  min_password_length = %[3]d
+  max_password_length = %[4]d
+  enabled             = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.minPasswordLength,
+		resourceModel.maxPasswordLength,
+		resourceModel.enabled)
+}
+
+// The built-in Character Set password validator is a Character Set Password Validator
+func testAccLengthBasedPasswordValidatorMismatchedTypeDataSource() string {
+	return `
+data "pingdirectory_length_based_password_validator" "mismatched" {
+  id = "Character Set"
+}`
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedLengthBasedPasswordValidatorAttributes(config lengthBasedPasswordValidatorTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.PasswordValidatorApi.GetPasswordValidator(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Length Based Password Validator"
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "min-password-length",
+			config.minPasswordLength, int64(*response.LengthBasedPasswordValidatorResponse.MinPasswordLength))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "max-password-length",
+			config.maxPasswordLength, int64(*response.LengthBasedPasswordValidatorResponse.MaxPasswordLength))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.LengthBasedPasswordValidatorResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckLengthBasedPasswordValidatorDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.PasswordValidatorApi.GetPasswordValidator(ctx, testIdLengthBasedPasswordValidator).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Length Based Password Validator", testIdLengthBasedPasswordValidator)
+	}
+	return nil
+}
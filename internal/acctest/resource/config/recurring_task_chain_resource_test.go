@@ -0,0 +1,202 @@
+package config_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdRecurringTaskChain = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type recurringTaskChainTestModel struct {
+	id                         string
+	scheduledDateSelectionType string
+	scheduledDayOfTheWeek      []string
+	scheduledTimeOfDay         []string
+}
+
+func TestAccRecurringTaskChain(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := recurringTaskChainTestModel{
+		id:                         testIdRecurringTaskChain,
+		scheduledDateSelectionType: "every-day",
+		scheduledTimeOfDay:         []string{"01:30"},
+	}
+	updatedResourceModel := recurringTaskChainTestModel{
+		id:                         testIdRecurringTaskChain,
+		scheduledDateSelectionType: "selected-days-of-the-week",
+		scheduledDayOfTheWeek:      []string{"every-sunday", "every-wednesday"},
+		scheduledTimeOfDay:         []string{"*:15"},
+	}
+	invalidTimeResourceModel := recurringTaskChainTestModel{
+		id:                         testIdRecurringTaskChain,
+		scheduledDateSelectionType: "every-day",
+		scheduledTimeOfDay:         []string{"25:00"},
+	}
+	missingDaysResourceModel := recurringTaskChainTestModel{
+		id:                         testIdRecurringTaskChain,
+		scheduledDateSelectionType: "selected-days-of-the-week",
+		scheduledTimeOfDay:         []string{"01:30"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckRecurringTaskChainDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test validation of the scheduled time of day
+				Config:      testAccRecurringTaskChainResource(resourceName, invalidTimeResourceModel),
+				ExpectError: regexp.MustCompile("Invalid scheduled time of day"),
+			},
+			{
+				// Test validation of the days used by the date selection type
+				Config:      testAccRecurringTaskChainResource(resourceName, missingDaysResourceModel),
+				ExpectError: regexp.MustCompile("Missing scheduled days"),
+			},
+			{
+				// A recurring task that doesn't exist is only a warning at plan time, so the server rejects the chain
+				Config:      testAccRecurringTaskChainMissingTaskResource(resourceName, initialResourceModel),
+				ExpectError: regexp.MustCompile("An error occurred while creating the Recurring Task Chain"),
+			},
+			{
+				// Test referencing a recurring task in the same configuration by its literal id. The task doesn't
+				// exist on the server when the chain is planned, but depends_on makes Terraform plan it first.
+				Config: testAccRecurringTaskChainLiteralTaskIdResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedRecurringTaskChainAttributes(initialResourceModel),
+			},
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccRecurringTaskChainResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedRecurringTaskChainAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccRecurringTaskChainResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedRecurringTaskChainAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:                  testAccRecurringTaskChainResource(resourceName, updatedResourceModel),
+				ResourceName:            "pingdirectory_recurring_task_chain." + resourceName,
+				ImportStateId:           updatedResourceModel.id,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func testAccRecurringTaskChainResource(resourceName string, resourceModel recurringTaskChainTestModel) string {
+	scheduledDayOfTheWeek := ""
+	if len(resourceModel.scheduledDayOfTheWeek) > 0 {
+		scheduledDayOfTheWeek = "scheduled_day_of_the_week = " + acctest.StringSliceToTerraformString(resourceModel.scheduledDayOfTheWeek)
+	}
+	return fmt.Sprintf(`
+resource "pingdirectory_delay_recurring_task" "%[1]s" {
+  id             = "%[2]s-delay"
+  sleep_duration = "1 s"
+}
+
+resource "pingdirectory_recurring_task_chain" "%[1]s" {
+  id                            = "%[2]s"
+  recurring_task                = [pingdirectory_delay_recurring_task.%[1]s.id]
+  scheduled_date_selection_type = "%[3]s"
+  scheduled_time_of_day         = %[4]s
+  %[5]s
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.scheduledDateSelectionType,
+		acctest.StringSliceToTerraformString(resourceModel.scheduledTimeOfDay),
+		scheduledDayOfTheWeek)
+}
+
+func testAccRecurringTaskChainMissingTaskResource(resourceName string, resourceModel recurringTaskChainTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_recurring_task_chain" "%[1]s" {
+  id                            = "%[2]s"
+  recurring_task                = ["Nonexistent Recurring Task"]
+  scheduled_date_selection_type = "%[3]s"
+  scheduled_time_of_day         = %[4]s
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.scheduledDateSelectionType,
+		acctest.StringSliceToTerraformString(resourceModel.scheduledTimeOfDay))
+}
+
+// Reference the recurring task by its literal id, using depends_on so that the task is created first
+func testAccRecurringTaskChainLiteralTaskIdResource(resourceName string, resourceModel recurringTaskChainTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_delay_recurring_task" "%[1]s" {
+  id             = "%[2]s-delay"
+  sleep_duration = "1 s"
+}
+
+resource "pingdirectory_recurring_task_chain" "%[1]s" {
+  id                            = "%[2]s"
+  recurring_task                = ["%[2]s-delay"]
+  scheduled_date_selection_type = "%[3]s"
+  scheduled_time_of_day         = %[4]s
+  depends_on                    = [pingdirectory_delay_recurring_task.%[1]s]
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.scheduledDateSelectionType,
+		acctest.StringSliceToTerraformString(resourceModel.scheduledTimeOfDay))
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedRecurringTaskChainAttributes(config recurringTaskChainTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.RecurringTaskChainApi.GetRecurringTaskChain(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Recurring Task Chain"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "scheduled-date-selection-type",
+			config.scheduledDateSelectionType, string(response.ScheduledDateSelectionType))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "scheduled-time-of-day",
+			config.scheduledTimeOfDay, response.ScheduledTimeOfDay)
+		if err != nil {
+			return err
+		}
+		var scheduledDayOfTheWeek []string
+		for _, day := range response.ScheduledDayOfTheWeek {
+			scheduledDayOfTheWeek = append(scheduledDayOfTheWeek, string(day))
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "scheduled-day-of-the-week",
+			config.scheduledDayOfTheWeek, scheduledDayOfTheWeek)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckRecurringTaskChainDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.RecurringTaskChainApi.GetRecurringTaskChain(ctx, testIdRecurringTaskChain).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Recurring Task Chain", testIdRecurringTaskChain)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdReplicationAssurancePolicy = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type replicationAssurancePolicyTestModel struct {
+	id                   string
+	evaluationOrderIndex int64
+	timeout              string
+	enabled              bool
+}
+
+func TestAccReplicationAssurancePolicy(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := replicationAssurancePolicyTestModel{
+		id:                   testIdReplicationAssurancePolicy,
+		evaluationOrderIndex: 1000,
+		timeout:              "2 s",
+		enabled:              true,
+	}
+	updatedResourceModel := replicationAssurancePolicyTestModel{
+		id:                   testIdReplicationAssurancePolicy,
+		evaluationOrderIndex: 1100,
+		timeout:              "5 s",
+		enabled:              false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckReplicationAssurancePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccReplicationAssurancePolicyResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedReplicationAssurancePolicyAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccReplicationAssurancePolicyResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedReplicationAssurancePolicyAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccReplicationAssurancePolicyResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_replication_assurance_policy." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccReplicationAssurancePolicyResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.ReplicationAssurancePolicyApi.DeleteReplicationAssurancePolicy(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Replication Assurance Policy outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedReplicationAssurancePolicyAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccReplicationAssurancePolicyResource(resourceName string, resourceModel replicationAssurancePolicyTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_replication_assurance_policy" "%[1]s" {
+  id                     = "%[2]s"
+  evaluation_order_index = %[3]d
+  timeout                = "%[4]s"
+  enabled                = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.evaluationOrderIndex,
+		resourceModel.timeout,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedReplicationAssurancePolicyAttributes(config replicationAssurancePolicyTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ReplicationAssurancePolicyApi.GetReplicationAssurancePolicy(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Replication Assurance Policy"
+		err = acctest.TestAttributesMatchInt(resourceType, &config.id, "evaluation-order-index",
+			config.evaluationOrderIndex, int64(response.EvaluationOrderIndex))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "timeout",
+			config.timeout, response.Timeout)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckReplicationAssurancePolicyDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.ReplicationAssurancePolicyApi.GetReplicationAssurancePolicy(ctx, testIdReplicationAssurancePolicy).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Replication Assurance Policy", testIdReplicationAssurancePolicy)
+	}
+	return nil
+}
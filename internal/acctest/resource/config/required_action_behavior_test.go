@@ -0,0 +1,77 @@
+package config_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdRequiredActionLocalDbIndex = "street"
+
+// Changing the index type of a Local DB Index returns a required action to rebuild the index
+func TestAccRequiredActionBehaviorFail(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := localDbIndexTestModel{
+		backendName: testBackendName,
+		attribute:   testIdRequiredActionLocalDbIndex,
+		indexType:   []string{"equality"},
+	}
+	updatedResourceModel := localDbIndexTestModel{
+		backendName: testBackendName,
+		attribute:   testIdRequiredActionLocalDbIndex,
+		indexType:   []string{"substring"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckRequiredActionLocalDbIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Required actions returned on create are reported as warnings, so the resource is not tainted
+				// and the plan after the apply is empty
+				Config: testAccRequiredActionBehaviorFailResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedLocalDbIndexAttributes(initialResourceModel),
+			},
+			{
+				// Required actions returned on update fail the apply
+				Config:      testAccRequiredActionBehaviorFailResource(resourceName, updatedResourceModel),
+				ExpectError: regexp.MustCompile("Configuration API RequiredAction"),
+			},
+			{
+				// The update was still applied, so the plan is empty
+				Config:   testAccRequiredActionBehaviorFailResource(resourceName, updatedResourceModel),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccRequiredActionBehaviorFailResource(resourceName string, resourceModel localDbIndexTestModel) string {
+	return fmt.Sprintf(`
+provider "pingdirectory" {
+  required_action_behavior = "fail"
+}
+
+%s`, testAccLocalDbIndexResource(resourceName, resourceModel))
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckRequiredActionLocalDbIndexDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.LocalDbIndexApi.GetLocalDbIndex(ctx, testIdRequiredActionLocalDbIndex, testBackendName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Local Db Index", testIdRequiredActionLocalDbIndex)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package resultcriteria_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdSimpleResultCriteria = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type simpleResultCriteriaTestModel struct {
+	id                 string
+	resultCodeCriteria string
+	description        string
+}
+
+func TestAccSimpleResultCriteria(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := simpleResultCriteriaTestModel{
+		id:                 testIdSimpleResultCriteria,
+		resultCodeCriteria: "failure-result-codes",
+		description:        "Failed operations",
+	}
+	updatedResourceModel := simpleResultCriteriaTestModel{
+		id:                 testIdSimpleResultCriteria,
+		resultCodeCriteria: "success-result-codes",
+		description:        "Successful operations",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckSimpleResultCriteriaDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccSimpleResultCriteriaResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedSimpleResultCriteriaAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccSimpleResultCriteriaResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedSimpleResultCriteriaAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccSimpleResultCriteriaResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_simple_result_criteria." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccSimpleResultCriteriaResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.ResultCriteriaApi.DeleteResultCriteria(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Simple Result Criteria outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedSimpleResultCriteriaAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccSimpleResultCriteriaResource(resourceName string, resourceModel simpleResultCriteriaTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_simple_result_criteria" "%[1]s" {
+  id                   = "%[2]s"
+  result_code_criteria = "%[3]s"
+  description          = "%[4]s"
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.resultCodeCriteria,
+		resourceModel.description)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedSimpleResultCriteriaAttributes(config simpleResultCriteriaTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ResultCriteriaApi.GetResultCriteria(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Simple Result Criteria"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "result-code-criteria",
+			config.resultCodeCriteria, string(*response.SimpleResultCriteriaResponse.ResultCodeCriteria))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringPointer(resourceType, &config.id, "description",
+			config.description, response.SimpleResultCriteriaResponse.Description)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckSimpleResultCriteriaDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.ResultCriteriaApi.GetResultCriteria(ctx, testIdSimpleResultCriteria).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Simple Result Criteria", testIdSimpleResultCriteria)
+	}
+	return nil
+}
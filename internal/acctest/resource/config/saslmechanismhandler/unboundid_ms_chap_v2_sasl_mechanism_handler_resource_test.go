@@ -0,0 +1,139 @@
+package saslmechanismhandler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdUnboundidMsChapV2SaslMechanismHandler = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type unboundidMsChapV2SaslMechanismHandlerTestModel struct {
+	id             string
+	identityMapper string
+	description    string
+	enabled        bool
+}
+
+func TestAccUnboundidMsChapV2SaslMechanismHandler(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := unboundidMsChapV2SaslMechanismHandlerTestModel{
+		id:             testIdUnboundidMsChapV2SaslMechanismHandler,
+		identityMapper: "Exact Match",
+		description:    "MS-CHAPv2 binds",
+		enabled:        false,
+	}
+	updatedResourceModel := unboundidMsChapV2SaslMechanismHandlerTestModel{
+		id:             testIdUnboundidMsChapV2SaslMechanismHandler,
+		identityMapper: "Exact Match",
+		description:    "Updated MS-CHAPv2 binds",
+		enabled:        true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckUnboundidMsChapV2SaslMechanismHandlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccUnboundidMsChapV2SaslMechanismHandlerResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedUnboundidMsChapV2SaslMechanismHandlerAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccUnboundidMsChapV2SaslMechanismHandlerResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedUnboundidMsChapV2SaslMechanismHandlerAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccUnboundidMsChapV2SaslMechanismHandlerResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_unboundid_ms_chap_v2_sasl_mechanism_handler." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccUnboundidMsChapV2SaslMechanismHandlerResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.SaslMechanismHandlerApi.DeleteSaslMechanismHandler(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Unboundid Ms Chap V2 Sasl Mechanism Handler outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedUnboundidMsChapV2SaslMechanismHandlerAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccUnboundidMsChapV2SaslMechanismHandlerResource(resourceName string, resourceModel unboundidMsChapV2SaslMechanismHandlerTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_unboundid_ms_chap_v2_sasl_mechanism_handler" "%[1]s" {
+  id              = "%[2]s"
+  identity_mapper = "%[3]s"
+  description     = "%[4]s"
+  enabled         = %[5]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.identityMapper,
+		resourceModel.description,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedUnboundidMsChapV2SaslMechanismHandlerAttributes(config unboundidMsChapV2SaslMechanismHandlerTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.SaslMechanismHandlerApi.GetSaslMechanismHandler(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Unboundid Ms Chap V2 Sasl Mechanism Handler"
+		err = acctest.TestAttributesMatchString(resourceType, &config.id, "identity-mapper",
+			config.identityMapper, response.UnboundidMsChapV2SaslMechanismHandlerResponse.IdentityMapper)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringPointer(resourceType, &config.id, "description",
+			config.description, response.UnboundidMsChapV2SaslMechanismHandlerResponse.Description)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.id, "enabled",
+			config.enabled, response.UnboundidMsChapV2SaslMechanismHandlerResponse.Enabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckUnboundidMsChapV2SaslMechanismHandlerDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.SaslMechanismHandlerApi.GetSaslMechanismHandler(ctx, testIdUnboundidMsChapV2SaslMechanismHandler).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Unboundid Ms Chap V2 Sasl Mechanism Handler", testIdUnboundidMsChapV2SaslMechanismHandler)
+	}
+	return nil
+}
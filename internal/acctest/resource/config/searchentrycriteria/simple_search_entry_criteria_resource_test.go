@@ -0,0 +1,119 @@
+package searchentrycriteria_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdSimpleSearchEntryCriteria = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type simpleSearchEntryCriteriaTestModel struct {
+	id                  string
+	includedEntryBaseDN []string
+}
+
+func TestAccSimpleSearchEntryCriteria(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := simpleSearchEntryCriteriaTestModel{
+		id:                  testIdSimpleSearchEntryCriteria,
+		includedEntryBaseDN: []string{"ou=people,dc=example,dc=com"},
+	}
+	updatedResourceModel := simpleSearchEntryCriteriaTestModel{
+		id:                  testIdSimpleSearchEntryCriteria,
+		includedEntryBaseDN: []string{"ou=people,dc=example,dc=com", "ou=groups,dc=example,dc=com"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckSimpleSearchEntryCriteriaDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccSimpleSearchEntryCriteriaResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedSimpleSearchEntryCriteriaAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccSimpleSearchEntryCriteriaResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedSimpleSearchEntryCriteriaAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccSimpleSearchEntryCriteriaResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_simple_search_entry_criteria." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccSimpleSearchEntryCriteriaResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.SearchEntryCriteriaApi.DeleteSearchEntryCriteria(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Simple Search Entry Criteria outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedSimpleSearchEntryCriteriaAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccSimpleSearchEntryCriteriaResource(resourceName string, resourceModel simpleSearchEntryCriteriaTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_simple_search_entry_criteria" "%[1]s" {
+  id                     = "%[2]s"
+  included_entry_base_dn = %[3]s
+}`, resourceName,
+		resourceModel.id,
+		acctest.StringSliceToTerraformString(resourceModel.includedEntryBaseDN))
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedSimpleSearchEntryCriteriaAttributes(config simpleSearchEntryCriteriaTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.SearchEntryCriteriaApi.GetSearchEntryCriteria(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Simple Search Entry Criteria"
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "included-entry-base-dn",
+			config.includedEntryBaseDN, response.SimpleSearchEntryCriteriaResponse.IncludedEntryBaseDN)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckSimpleSearchEntryCriteriaDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.SearchEntryCriteriaApi.GetSearchEntryCriteria(ctx, testIdSimpleSearchEntryCriteria).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Simple Search Entry Criteria", testIdSimpleSearchEntryCriteria)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package searchreferencecriteria_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+const testIdSimpleSearchReferenceCriteria = "MyId"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type simpleSearchReferenceCriteriaTestModel struct {
+	id                          string
+	anyIncludedReferenceControl []string
+}
+
+func TestAccSimpleSearchReferenceCriteria(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := simpleSearchReferenceCriteriaTestModel{
+		id:                          testIdSimpleSearchReferenceCriteria,
+		anyIncludedReferenceControl: []string{"2.16.840.1.113730.3.4.2"},
+	}
+	updatedResourceModel := simpleSearchReferenceCriteriaTestModel{
+		id:                          testIdSimpleSearchReferenceCriteria,
+		anyIncludedReferenceControl: []string{"2.16.840.1.113730.3.4.2", "1.2.840.113556.1.4.319"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckSimpleSearchReferenceCriteriaDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test basic resource.
+				// Add checks for computed properties here if desired.
+				Config: testAccSimpleSearchReferenceCriteriaResource(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedSimpleSearchReferenceCriteriaAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccSimpleSearchReferenceCriteriaResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedSimpleSearchReferenceCriteriaAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccSimpleSearchReferenceCriteriaResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingdirectory_simple_search_reference_criteria." + resourceName,
+				ImportStateId:     updatedResourceModel.id,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+				},
+			},
+			{
+				// Test plan after removing config on server
+				Config: testAccSimpleSearchReferenceCriteriaResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.SearchReferenceCriteriaApi.DeleteSearchReferenceCriteria(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Simple Search Reference Criteria outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedSimpleSearchReferenceCriteriaAttributes(updatedResourceModel),
+			},
+		},
+	})
+}
+
+func testAccSimpleSearchReferenceCriteriaResource(resourceName string, resourceModel simpleSearchReferenceCriteriaTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_simple_search_reference_criteria" "%[1]s" {
+  id                             = "%[2]s"
+  any_included_reference_control = %[3]s
+}`, resourceName,
+		resourceModel.id,
+		acctest.StringSliceToTerraformString(resourceModel.anyIncludedReferenceControl))
+}
+
+// Test that the expected attributes are set on the PingDirectory server
+func testAccCheckExpectedSimpleSearchReferenceCriteriaAttributes(config simpleSearchReferenceCriteriaTestModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.SearchReferenceCriteriaApi.GetSearchReferenceCriteria(ctx, config.id).Execute()
+		if err != nil {
+			return err
+		}
+		// Verify that attributes have expected values
+		resourceType := "Simple Search Reference Criteria"
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &config.id, "any-included-reference-control",
+			config.anyIncludedReferenceControl, response.SimpleSearchReferenceCriteriaResponse.AnyIncludedReferenceControl)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckSimpleSearchReferenceCriteriaDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.SearchReferenceCriteriaApi.GetSearchReferenceCriteria(ctx, testIdSimpleSearchReferenceCriteria).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Simple Search Reference Criteria", testIdSimpleSearchReferenceCriteria)
+	}
+	return nil
+}
@@ -2,12 +2,14 @@ package trustmanagerprovider_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
 )
@@ -60,6 +62,62 @@ func TestAccBlindTrustManagerProvider(t *testing.T) {
 					"last_updated",
 				},
 			},
+			{
+				// Test plan after removing config on server
+				Config: testAccBlindTrustManagerProviderResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.TrustManagerProviderApi.DeleteTrustManagerProvider(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Blind Trust Manager Provider outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedBlindTrustManagerProviderAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing a config object of a different type
+				Config:        testAccBlindTrustManagerProviderResource(resourceName, updatedResourceModel),
+				ResourceName:  "pingdirectory_blind_trust_manager_provider." + resourceName,
+				ImportStateId: "JVM-Default",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("is a jvm-default trust manager provider"),
+			},
+			{
+				// Test that a config object replaced with one of a different type is replaced again when applying
+				Config: testAccBlindTrustManagerProviderResource(resourceName, updatedResourceModel),
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.TrustManagerProviderApi.DeleteTrustManagerProvider(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Blind Trust Manager Provider outside of Terraform: %s", err.Error())
+					}
+					addRequest := client.NewAddFileBasedTrustManagerProviderRequest(updatedResourceModel.id,
+						[]client.EnumfileBasedTrustManagerProviderSchemaUrn{client.ENUMFILEBASEDTRUSTMANAGERPROVIDERSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0TRUST_MANAGER_PROVIDERFILE_BASED},
+						"config/truststore", false)
+					_, _, err = testClient.TrustManagerProviderApi.AddTrustManagerProvider(ctx).AddTrustManagerProviderRequest(
+						client.AddFileBasedTrustManagerProviderRequestAsAddTrustManagerProviderRequest(addRequest)).Execute()
+					if err != nil {
+						t.Fatalf("Failed to add File Based Trust Manager Provider outside of Terraform: %s", err.Error())
+					}
+				},
+				Check: testAccCheckExpectedBlindTrustManagerProviderAttributes(updatedResourceModel),
+			},
+			{
+				// Test reading a config object of a different type
+				Config: testAccBlindTrustManagerProviderMismatchedTypeDataSource(),
+				PreConfig: func() {
+					// Delete the config object, so the resource is removed from state when refreshing
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.TrustManagerProviderApi.DeleteTrustManagerProvider(ctx, updatedResourceModel.id).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Blind Trust Manager Provider outside of Terraform: %s", err.Error())
+					}
+				},
+				ExpectError: regexp.MustCompile("is a jvm-default trust manager provider"),
+			},
 		},
 	})
 }
@@ -74,6 +132,14 @@ resource "pingdirectory_blind_trust_manager_provider" "%[1]s" {
 		resourceModel.enabled)
 }
 
+// The built-in JVM-Default trust manager provider is a JVM-Default Trust Manager Provider
+func testAccBlindTrustManagerProviderMismatchedTypeDataSource() string {
+	return `
+data "pingdirectory_blind_trust_manager_provider" "mismatched" {
+  id = "JVM-Default"
+}`
+}
+
 // Test that the expected attributes are set on the PingDirectory server
 func testAccCheckExpectedBlindTrustManagerProviderAttributes(config blindTrustManagerProviderTestModel) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -0,0 +1,70 @@
+package task_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Attributes to test with. Add optional properties to test here if desired.
+type ldifExportTaskTestModel struct {
+	backendId string
+	ldifFile  string
+	compress  bool
+}
+
+func TestAccLdifExportTask(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := ldifExportTaskTestModel{
+		backendId: "userRoot",
+		ldifFile:  "ldif/terraform-acctest-export.ldif",
+		compress:  false,
+	}
+	updatedResourceModel := ldifExportTaskTestModel{
+		backendId: "userRoot",
+		ldifFile:  "ldif/terraform-acctest-export.ldif.gz",
+		compress:  true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Test running the task
+				Config: testAccLdifExportTaskResource(resourceName, initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_ldif_export_task."+resourceName, "task_state", "completed-successfully"),
+					resource.TestCheckResourceAttrSet("pingdirectory_ldif_export_task."+resourceName, "log_messages.#"),
+				),
+			},
+			{
+				// Test that changing the task attributes runs the task again
+				Config: testAccLdifExportTaskResource(resourceName, updatedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_ldif_export_task."+resourceName, "task_state", "completed-successfully"),
+					resource.TestCheckResourceAttr("pingdirectory_ldif_export_task."+resourceName, "ldif_file", updatedResourceModel.ldifFile),
+				),
+			},
+		},
+	})
+}
+
+func testAccLdifExportTaskResource(resourceName string, resourceModel ldifExportTaskTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_ldif_export_task" "%[1]s" {
+  backend_id = "%[2]s"
+  ldif_file  = "%[3]s"
+  compress   = %[4]t
+}`, resourceName,
+		resourceModel.backendId,
+		resourceModel.ldifFile,
+		resourceModel.compress)
+}
@@ -0,0 +1,115 @@
+package task_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/provider"
+)
+
+// Attributes to test with. Add optional properties to test here if desired.
+type rebuildIndexTaskTestModel struct {
+	baseDn      string
+	index       []string
+	trigger     string
+	waitTimeout string
+}
+
+func TestAccRebuildIndexTask(t *testing.T) {
+	resourceName := "myresource"
+	initialResourceModel := rebuildIndexTaskTestModel{
+		baseDn:      "dc=example,dc=com",
+		index:       []string{"uid"},
+		trigger:     "initial",
+		waitTimeout: "10m",
+	}
+	updatedResourceModel := rebuildIndexTaskTestModel{
+		baseDn:      "dc=example,dc=com",
+		index:       []string{"uid"},
+		trigger:     "updated",
+		waitTimeout: "15m",
+	}
+	waitTimeoutResourceModel := rebuildIndexTaskTestModel{
+		baseDn:      "dc=example,dc=com",
+		index:       []string{"uid"},
+		trigger:     "initial",
+		waitTimeout: "20m",
+	}
+	invalidTimeoutResourceModel := rebuildIndexTaskTestModel{
+		baseDn:      "dc=example,dc=com",
+		index:       []string{"uid"},
+		trigger:     "initial",
+		waitTimeout: "ten minutes",
+	}
+
+	// The id of the task entry created when the task first runs
+	var taskId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingdirectory": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Test that an invalid wait timeout is reported before the task is scheduled
+				Config:      testAccRebuildIndexTaskResource(resourceName, invalidTimeoutResourceModel),
+				ExpectError: regexp.MustCompile("Failed to parse wait_timeout"),
+			},
+			{
+				// Test running the task
+				Config: testAccRebuildIndexTaskResource(resourceName, initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_rebuild_index_task."+resourceName, "task_state", "completed-successfully"),
+					resource.TestCheckResourceAttrWith("pingdirectory_rebuild_index_task."+resourceName, "id", func(value string) error {
+						taskId = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Test that changing only the wait timeout updates the resource without running the task again
+				Config: testAccRebuildIndexTaskResource(resourceName, waitTimeoutResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_rebuild_index_task."+resourceName, "task_state", "completed-successfully"),
+					resource.TestCheckResourceAttr("pingdirectory_rebuild_index_task."+resourceName, "wait_timeout", waitTimeoutResourceModel.waitTimeout),
+					resource.TestCheckResourceAttrWith("pingdirectory_rebuild_index_task."+resourceName, "id", func(value string) error {
+						if value != taskId {
+							return fmt.Errorf("expected the task id to stay %s, found %s", taskId, value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// Test that changing the triggers runs the task again
+				Config: testAccRebuildIndexTaskResource(resourceName, updatedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingdirectory_rebuild_index_task."+resourceName, "task_state", "completed-successfully"),
+					resource.TestCheckResourceAttr("pingdirectory_rebuild_index_task."+resourceName, "triggers.run", updatedResourceModel.trigger),
+				),
+			},
+		},
+	})
+}
+
+func testAccRebuildIndexTaskResource(resourceName string, resourceModel rebuildIndexTaskTestModel) string {
+	return fmt.Sprintf(`
+resource "pingdirectory_rebuild_index_task" "%[1]s" {
+  base_dn      = "%[2]s"
+  index        = %[3]s
+  wait_timeout = "%[5]s"
+  triggers = {
+    run = "%[4]s"
+  }
+}`, resourceName,
+		resourceModel.baseDn,
+		acctest.StringSliceToTerraformString(resourceModel.index),
+		resourceModel.trigger,
+		resourceModel.waitTimeout)
+}
@@ -156,6 +156,25 @@ func AddStringSetOperationsIfNecessary(ops *[]client.Operation, plan types.Set,
 	}
 }
 
+// Add list operations if the plan doesn't match the state. Used for multi-valued attributes where the
+// order of the values matters, so all values are removed and then added back in the planned order.
+func AddStringListOperationsIfNecessary(ops *[]client.Operation, plan types.List, state types.List, path string) {
+	// If plan is unknown, then just take whatever's in the state - no operation needed
+	if plan.IsUnknown() {
+		return
+	}
+	validateOperationPath(path)
+
+	if !plan.Equal(state) {
+		*ops = append(*ops, *client.NewOperation(client.ENUMOPERATION_REMOVE, path))
+		for _, planEl := range plan.Elements() {
+			op := client.NewOperation(client.ENUMOPERATION_ADD, path)
+			op.SetValue(planEl.(types.String).ValueString())
+			*ops = append(*ops, *op)
+		}
+	}
+}
+
 // Add int64 set operation if the plan doesn't match the state
 func AddInt64SetOperationsIfNecessary(ops *[]client.Operation, plan types.Set, state types.Set, path string) {
 	// If plan is unknown, then just take whatever's in the state - no operation needed
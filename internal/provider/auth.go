@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Credentials used to authenticate to the PingDirectory server
+type authConfig struct {
+	username          string
+	password          string
+	accessToken       string
+	oauthClientId     string
+	oauthClientSecret string
+	oauthTokenUrl     string
+	oauthScopes       []string
+	clientCertPemFile string
+	clientKeyPemFile  string
+}
+
+func (a authConfig) useBasicAuth() bool {
+	return a.username != "" || a.password != ""
+}
+
+func (a authConfig) useAccessToken() bool {
+	return a.accessToken != ""
+}
+
+func (a authConfig) useClientCredentials() bool {
+	return a.oauthClientId != "" || a.oauthClientSecret != "" || a.oauthTokenUrl != ""
+}
+
+func (a authConfig) useClientCert() bool {
+	return a.clientCertPemFile != "" || a.clientKeyPemFile != ""
+}
+
+// Validate that exactly one authentication method is fully configured. A client certificate can be used
+// on its own or along with one of the other methods.
+func validateAuthConfig(auth authConfig, diagnostics *diag.Diagnostics) {
+	if auth.useClientCredentials() && (auth.oauthClientId == "" || auth.oauthClientSecret == "" || auth.oauthTokenUrl == "") {
+		diagnostics.AddError("Incomplete OAuth client credentials",
+			"oauth_client_id, oauth_client_secret, and oauth_token_url must all be set to request an access token with the client credentials grant.")
+	}
+	if auth.useClientCert() && (auth.clientCertPemFile == "" || auth.clientKeyPemFile == "") {
+		diagnostics.AddError("Incomplete client certificate configuration",
+			"client_certificate_pem_file and client_key_pem_file must both be set to authenticate with a client certificate.")
+	}
+	authMethods := 0
+	for _, used := range []bool{auth.useBasicAuth(), auth.useAccessToken(), auth.useClientCredentials()} {
+		if used {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		diagnostics.AddError("Multiple authentication methods configured",
+			"Only one of username and password, access_token, or OAuth client credentials can be used to authenticate to the PingDirectory server.")
+	}
+	if authMethods == 0 && !auth.useClientCert() {
+		diagnostics.AddError("Unable to find credentials",
+			"No credentials were provided for the PingDirectory server. Either set username and password, access_token, OAuth client credentials, or a client certificate in the configuration, or use the corresponding PINGDIRECTORY_PROVIDER_* environment variables.")
+	}
+	if auth.useBasicAuth() {
+		if auth.username == "" {
+			diagnostics.AddError(
+				"Unable to find username",
+				"username cannot be an empty string. Either set it in the configuration or use the PINGDIRECTORY_PROVIDER_USERNAME environment variable.",
+			)
+		}
+		if auth.password == "" {
+			diagnostics.AddError(
+				"Unable to find password",
+				"password cannot be an empty string. Either set it in the configuration or use the PINGDIRECTORY_PROVIDER_PASSWORD environment variable.",
+			)
+		}
+	}
+}
+
+// Load the client certificate used for mutual TLS, if one is configured
+func loadClientCertificates(ctx context.Context, auth authConfig, diagnostics *diag.Diagnostics) []tls.Certificate {
+	if auth.clientCertPemFile == "" || auth.clientKeyPemFile == "" {
+		return nil
+	}
+	clientCert, err := tls.LoadX509KeyPair(auth.clientCertPemFile, auth.clientKeyPemFile)
+	if err != nil {
+		diagnostics.AddError("Failed to load client certificate from file: "+auth.clientCertPemFile, err.Error())
+		return nil
+	}
+	tflog.Info(ctx, "Using client certificate from file: "+auth.clientCertPemFile)
+	return []tls.Certificate{clientCert}
+}
+
+// Build the HTTP client used for requests to the PingDirectory server. When using an OAuth2 access token,
+// it is added as a bearer token to every request. Requests for new tokens from the token endpoint use the
+// same base transport. Basic auth credentials are added to each request's context instead.
+func newHttpClient(ctx context.Context, auth authConfig, base http.RoundTripper) *http.Client {
+	httpClient := &http.Client{Transport: base}
+	if auth.useAccessToken() {
+		tflog.Info(ctx, "Authenticating to the PingDirectory server with an access token")
+		httpClient.Transport = &oauth2.Transport{
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: auth.accessToken}),
+			Base:   base,
+		}
+	} else if auth.useClientCredentials() {
+		tflog.Info(ctx, "Authenticating to the PingDirectory server with an access token from "+auth.oauthTokenUrl)
+		clientCredentialsConfig := clientcredentials.Config{
+			ClientID:     auth.oauthClientId,
+			ClientSecret: auth.oauthClientSecret,
+			TokenURL:     auth.oauthTokenUrl,
+			Scopes:       auth.oauthScopes,
+		}
+		// The token source outlives the provider Configure call, so it can't use the request context
+		tokenContext := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: base})
+		httpClient.Transport = &oauth2.Transport{
+			Source: clientCredentialsConfig.TokenSource(tokenContext),
+			Base:   base,
+		}
+	}
+	return httpClient
+}
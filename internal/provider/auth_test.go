@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"golang.org/x/oauth2"
+)
+
+func TestValidateAuthConfig(t *testing.T) {
+	testCases := map[string]struct {
+		auth          authConfig
+		expectedError string
+	}{
+		"basic auth": {
+			auth: authConfig{username: "cn=administrator", password: "password"},
+		},
+		"access token": {
+			auth: authConfig{accessToken: "token"},
+		},
+		"client credentials": {
+			auth: authConfig{oauthClientId: "client", oauthClientSecret: "secret", oauthTokenUrl: "https://localhost/token"},
+		},
+		"client certificate": {
+			auth: authConfig{clientCertPemFile: "cert.pem", clientKeyPemFile: "key.pem"},
+		},
+		"client certificate with basic auth": {
+			auth: authConfig{username: "cn=administrator", password: "password", clientCertPemFile: "cert.pem", clientKeyPemFile: "key.pem"},
+		},
+		"basic auth and access token": {
+			auth:          authConfig{username: "cn=administrator", password: "password", accessToken: "token"},
+			expectedError: "Multiple authentication methods configured",
+		},
+		"basic auth and client credentials": {
+			auth:          authConfig{username: "cn=administrator", password: "password", oauthClientId: "client", oauthClientSecret: "secret", oauthTokenUrl: "https://localhost/token"},
+			expectedError: "Multiple authentication methods configured",
+		},
+		"access token and client credentials": {
+			auth:          authConfig{accessToken: "token", oauthClientId: "client", oauthClientSecret: "secret", oauthTokenUrl: "https://localhost/token"},
+			expectedError: "Multiple authentication methods configured",
+		},
+		"no credentials": {
+			auth:          authConfig{},
+			expectedError: "Unable to find credentials",
+		},
+		"username without password": {
+			auth:          authConfig{username: "cn=administrator"},
+			expectedError: "Unable to find password",
+		},
+		"incomplete client credentials": {
+			auth:          authConfig{oauthClientId: "client", oauthTokenUrl: "https://localhost/token"},
+			expectedError: "Incomplete OAuth client credentials",
+		},
+		"client certificate without key": {
+			auth:          authConfig{clientCertPemFile: "cert.pem"},
+			expectedError: "Incomplete client certificate configuration",
+		},
+	}
+	for name, testCase := range testCases {
+		var diagnostics diag.Diagnostics
+		validateAuthConfig(testCase.auth, &diagnostics)
+		if testCase.expectedError == "" {
+			if diagnostics.HasError() {
+				t.Errorf("%s: unexpected errors: %v", name, diagnostics.Errors())
+			}
+			continue
+		}
+		found := false
+		for _, diagnostic := range diagnostics.Errors() {
+			if diagnostic.Summary() == testCase.expectedError {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected error '%s', found %v", name, testCase.expectedError, diagnostics.Errors())
+		}
+	}
+}
+
+// Server that records the Authorization header of the most recent request
+type authorizationRecorder struct {
+	mutex         sync.Mutex
+	authorization string
+}
+
+func (a *authorizationRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	a.authorization = r.Header.Get("Authorization")
+	a.mutex.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (a *authorizationRecorder) lastAuthorization() string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.authorization
+}
+
+func sendTestRequest(t *testing.T, httpClient *http.Client, url string) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	resp.Body.Close()
+}
+
+func TestNewHttpClientBasicAuth(t *testing.T) {
+	base := testRetryTransport(0)
+	httpClient := newHttpClient(context.Background(), authConfig{username: "cn=administrator", password: "password"}, base)
+	// Basic auth credentials are added to the context of each request, so the base transport is used directly
+	if httpClient.Transport != base {
+		t.Errorf("Expected the retry transport to be used for basic auth, found %T", httpClient.Transport)
+	}
+}
+
+func TestNewHttpClientAccessToken(t *testing.T) {
+	recorder := &authorizationRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	base := testRetryTransport(0)
+	httpClient := newHttpClient(context.Background(), authConfig{accessToken: "mytoken"}, base)
+	transport, ok := httpClient.Transport.(*oauth2.Transport)
+	if !ok {
+		t.Fatalf("Expected an OAuth2 transport, found %T", httpClient.Transport)
+	}
+	if transport.Base != base {
+		t.Errorf("Expected the OAuth2 transport to wrap the retry transport, found %T", transport.Base)
+	}
+	sendTestRequest(t, httpClient, server.URL)
+	if recorder.lastAuthorization() != "Bearer mytoken" {
+		t.Errorf("Expected bearer token authorization, found '%s'", recorder.lastAuthorization())
+	}
+}
+
+func TestNewHttpClientClientCredentials(t *testing.T) {
+	var mutex sync.Mutex
+	var tokenRequests int
+	var scope string
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientId, clientSecret, _ := r.BasicAuth()
+		if clientId != "client" || clientSecret != "secret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mutex.Lock()
+		tokenRequests++
+		scope = r.FormValue("scope")
+		mutex.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"issuedtoken","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+	recorder := &authorizationRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	base := testRetryTransport(0)
+	httpClient := newHttpClient(context.Background(), authConfig{
+		oauthClientId:     "client",
+		oauthClientSecret: "secret",
+		oauthTokenUrl:     tokenServer.URL,
+		oauthScopes:       []string{"config.read", "config.write"},
+	}, base)
+	transport, ok := httpClient.Transport.(*oauth2.Transport)
+	if !ok {
+		t.Fatalf("Expected an OAuth2 transport, found %T", httpClient.Transport)
+	}
+	if transport.Base != base {
+		t.Errorf("Expected the OAuth2 transport to wrap the retry transport, found %T", transport.Base)
+	}
+	sendTestRequest(t, httpClient, server.URL)
+	sendTestRequest(t, httpClient, server.URL)
+	if recorder.lastAuthorization() != "Bearer issuedtoken" {
+		t.Errorf("Expected bearer token authorization, found '%s'", recorder.lastAuthorization())
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	// The token is reused until it expires
+	if tokenRequests != 1 {
+		t.Errorf("Expected 1 token request, found %d", tokenRequests)
+	}
+	if scope != "config.read config.write" {
+		t.Errorf("Unexpected scope in token request: '%s'", scope)
+	}
+}
+
+// Write a self-signed certificate and its private key to PEM files in a temporary directory
+func writeTestClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err.Error())
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %s", err.Error())
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %s", err.Error())
+	}
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600); err != nil {
+		t.Fatalf("Failed to write certificate: %s", err.Error())
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600); err != nil {
+		t.Fatalf("Failed to write key: %s", err.Error())
+	}
+	return certFile, keyFile
+}
+
+func TestClientCertificateAuth(t *testing.T) {
+	var mutex sync.Mutex
+	var peerName string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		if len(r.TLS.PeerCertificates) > 0 {
+			peerName = r.TLS.PeerCertificates[0].Subject.CommonName
+		}
+		mutex.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	certFile, keyFile := writeTestClientCertificate(t)
+	auth := authConfig{clientCertPemFile: certFile, clientKeyPemFile: keyFile}
+	var diagnostics diag.Diagnostics
+	clientCerts := loadClientCertificates(context.Background(), auth, &diagnostics)
+	if diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", diagnostics.Errors())
+	}
+	if len(clientCerts) != 1 {
+		t.Fatalf("Expected 1 client certificate, found %d", len(clientCerts))
+	}
+
+	base := testRetryTransport(0)
+	//#nosec G402
+	base.base = &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       clientCerts,
+		},
+	}
+	httpClient := newHttpClient(context.Background(), auth, base)
+	// The certificate is presented by the TLS transport, so no OAuth2 transport is added
+	if httpClient.Transport != base {
+		t.Errorf("Expected the retry transport to be used for client certificate auth, found %T", httpClient.Transport)
+	}
+	sendTestRequest(t, httpClient, server.URL)
+	mutex.Lock()
+	defer mutex.Unlock()
+	if peerName != "terraform-client" {
+		t.Errorf("Expected the server to receive the client certificate, found '%s'", peerName)
+	}
+}
+
+func TestLoadClientCertificatesMissingFile(t *testing.T) {
+	var diagnostics diag.Diagnostics
+	dir := t.TempDir()
+	clientCerts := loadClientCertificates(context.Background(), authConfig{
+		clientCertPemFile: filepath.Join(dir, "missing-cert.pem"),
+		clientKeyPemFile:  filepath.Join(dir, "missing-key.pem"),
+	}, &diagnostics)
+	if clientCerts != nil {
+		t.Errorf("Expected no client certificates, found %d", len(clientCerts))
+	}
+	if !diagnostics.HasError() || !strings.HasPrefix(diagnostics.Errors()[0].Summary(), "Failed to load client certificate") {
+		t.Errorf("Expected an error loading the client certificate, found %v", diagnostics.Errors())
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+)
+
+const clientConnectionPolicyListJson = `{"schemas":["urn:pingidentity:schemas:configuration:messages:2.0:ListResponse"],"totalResults":2,"Resources":[` +
+	`{"schemas":["urn:pingidentity:schemas:configuration:2.0:client-connection-policy"],"id":"default","policyID":"default","enabled":true,"evaluationOrderIndex":9999},` +
+	`{"schemas":["urn:pingidentity:schemas:configuration:2.0:client-connection-policy"],"id":"Existing","policyID":"existing","enabled":true,"evaluationOrderIndex":1000}]}`
+
+// Test that a planned evaluation order index is compared with the client connection policies on the server
+func TestClientConnectionPolicyDuplicateEvaluationOrderIndex(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/client-connection-policies") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(clientConnectionPolicyListJson))
+	}))
+	defer server.Close()
+	providerServer := configureTestProviderServer(ctx, t, server.URL)
+
+	var resourceSchemaResp resource.SchemaResponse
+	config.NewClientConnectionPolicyResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resourceType := resourceSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	policyValues := func(id string, index int64) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"id":                     tftypes.NewValue(tftypes.String, id),
+			"policy_id":              tftypes.NewValue(tftypes.String, strings.ToLower(id)),
+			"enabled":                tftypes.NewValue(tftypes.Bool, true),
+			"evaluation_order_index": tftypes.NewValue(tftypes.Number, index),
+		}
+	}
+	nullState, err := tfprotov6.NewDynamicValue(resourceType, tftypes.NewValue(resourceType, nil))
+	if err != nil {
+		t.Fatalf("Failed to build dynamic value: %s", err.Error())
+	}
+
+	testCases := map[string]struct {
+		priorState    *tfprotov6.DynamicValue
+		planValues    map[string]tftypes.Value
+		expectedError string
+	}{
+		"new policy using an existing index": {
+			priorState:    &nullState,
+			planValues:    policyValues("MyId", 1000),
+			expectedError: "is already used by client connection policy \"Existing\"",
+		},
+		"new policy using an unused index": {
+			priorState: &nullState,
+			planValues: policyValues("MyId", 1100),
+		},
+		"existing policy keeping its index": {
+			priorState: testDynamicValue(t, resourceType, policyValues("Existing", 1000)),
+			planValues: policyValues("Existing", 1000),
+		},
+		"existing policy replaced with a new id": {
+			priorState: testDynamicValue(t, resourceType, policyValues("Existing", 1000)),
+			planValues: policyValues("Renamed", 1000),
+		},
+	}
+	for name, testCase := range testCases {
+		planValues := testCase.planValues
+		planValues["description"] = tftypes.NewValue(tftypes.String, "Planned change")
+		plan := testDynamicValue(t, resourceType, planValues)
+		planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "pingdirectory_client_connection_policy",
+			PriorState:       testCase.priorState,
+			ProposedNewState: plan,
+			Config:           plan,
+		})
+		if err != nil {
+			t.Fatalf("%s: failed to plan resource change: %s", name, err.Error())
+		}
+		var planErrors []string
+		for _, diagnostic := range planResp.Diagnostics {
+			if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+				planErrors = append(planErrors, diagnostic.Summary+": "+diagnostic.Detail)
+			}
+		}
+		if testCase.expectedError == "" {
+			if len(planErrors) > 0 {
+				t.Errorf("%s: unexpected errors: %v", name, planErrors)
+			}
+			continue
+		}
+		if len(planErrors) != 1 || !strings.HasPrefix(planErrors[0], "Duplicate evaluation order index") ||
+			!strings.Contains(planErrors[0], testCase.expectedError) {
+			t.Errorf("%s: expected a duplicate evaluation order index error, found %v", name, planErrors)
+		}
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,36 +23,73 @@ import (
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/accesscontrolhandler"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/accesstokenvalidator"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/accountstatusnotificationhandler"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/alerthandler"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/backend"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/certificatemapper"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/connectioncriteria"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/connectionhandler"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/delegatedadminattribute"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/extendedoperationhandler"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/externalserver"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/gauge"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/httpservletextension"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/identitymapper"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/keymanagerprovider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/logfilerotationlistener"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/logpublisher"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/logretentionpolicy"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/logrotationpolicy"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/monitorprovider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/passphraseprovider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/passwordgenerator"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/passwordstoragescheme"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/passwordvalidator"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/plugin"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/recurringtask"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/requestcriteria"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/restresourcetype"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/resultcriteria"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/saslmechanismhandler"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/searchentrycriteria"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/searchreferencecriteria"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/serverinstance"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/trustmanagerprovider"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/virtualattribute"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/task"
 	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
 	"github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
 )
 
 // pingdirectoryProviderModel maps provider schema data to a Go type.
 type pingdirectoryProviderModel struct {
-	HttpsHost             types.String `tfsdk:"https_host"`
-	Username              types.String `tfsdk:"username"`
-	Password              types.String `tfsdk:"password"`
-	InsecureTrustAllTls   types.Bool   `tfsdk:"insecure_trust_all_tls"`
-	CACertificatePEMFiles types.Set    `tfsdk:"ca_certificate_pem_files"`
-	ProductVersion        types.String `tfsdk:"product_version"`
+	HttpsHost              types.String `tfsdk:"https_host"`
+	Username               types.String `tfsdk:"username"`
+	Password               types.String `tfsdk:"password"`
+	InsecureTrustAllTls    types.Bool   `tfsdk:"insecure_trust_all_tls"`
+	CACertificatePEMFiles  types.Set    `tfsdk:"ca_certificate_pem_files"`
+	ProductVersion         types.String `tfsdk:"product_version"`
+	AccessToken            types.String `tfsdk:"access_token"`
+	OAuthClientId          types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret      types.String `tfsdk:"oauth_client_secret"`
+	OAuthTokenUrl          types.String `tfsdk:"oauth_token_url"`
+	OAuthScopes            types.Set    `tfsdk:"oauth_scopes"`
+	ClientCertPEMFile      types.String `tfsdk:"client_certificate_pem_file"`
+	ClientKeyPEMFile       types.String `tfsdk:"client_key_pem_file"`
+	MaxRetries             types.Int64  `tfsdk:"max_retries"`
+	RetryBackoff           types.String `tfsdk:"retry_backoff"`
+	RequestTimeout         types.String `tfsdk:"request_timeout"`
+	WaitForReadyTimeout    types.String `tfsdk:"wait_for_ready_timeout"`
+	AvailabilityStatePath  types.String `tfsdk:"availability_state_path"`
+	RequiredActionBehavior types.String `tfsdk:"required_action_behavior"`
 }
 
+// Default and maximum retry settings
+const (
+	defaultMaxRetries   = 3
+	maximumMaxRetries   = 10
+	defaultRetryBackoff = "1s"
+)
+
 // Ensure the implementation satisfies the expected interfaces
 var (
 	_ provider.Provider = &pingdirectoryProvider{}
@@ -78,14 +118,69 @@ func (p *pingdirectoryProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:    true,
 			},
 			"username": schema.StringAttribute{
-				Description: "Username for PingDirectory admin user. Default value can be set with the `PINGDIRECTORY_PROVIDER_USERNAME` environment variable.",
+				Description: "Username for PingDirectory admin user. Required unless an access token, OAuth client credentials, or a client certificate is used to authenticate. Default value can be set with the `PINGDIRECTORY_PROVIDER_USERNAME` environment variable.",
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for PingDirectory admin user. Default value can be set with the `PINGDIRECTORY_PROVIDER_PASSWORD` environment variable.",
+				Description: "Password for PingDirectory admin user. Required unless an access token, OAuth client credentials, or a client certificate is used to authenticate. Default value can be set with the `PINGDIRECTORY_PROVIDER_PASSWORD` environment variable.",
 				Sensitive:   true,
 				Optional:    true,
 			},
+			"access_token": schema.StringAttribute{
+				Description: "OAuth2 access token to send as a bearer token to the PingDirectory Configuration API, instead of a username and password. Default value can be set with the `PINGDIRECTORY_PROVIDER_ACCESS_TOKEN` environment variable.",
+				Sensitive:   true,
+				Optional:    true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Description: "Client ID used to request an OAuth2 access token with the client credentials grant from `oauth_token_url`. The access token is sent as a bearer token to the PingDirectory Configuration API, instead of a username and password. Default value can be set with the `PINGDIRECTORY_PROVIDER_OAUTH_CLIENT_ID` environment variable.",
+				Optional:    true,
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Description: "Client secret used to request an OAuth2 access token with the client credentials grant from `oauth_token_url`. Default value can be set with the `PINGDIRECTORY_PROVIDER_OAUTH_CLIENT_SECRET` environment variable.",
+				Sensitive:   true,
+				Optional:    true,
+			},
+			"oauth_token_url": schema.StringAttribute{
+				Description: "URL of the token endpoint used to request an OAuth2 access token with the client credentials grant. Default value can be set with the `PINGDIRECTORY_PROVIDER_OAUTH_TOKEN_URL` environment variable.",
+				Optional:    true,
+			},
+			"oauth_scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Scopes to request when requesting an OAuth2 access token with the client credentials grant. Default value can be set with the `PINGDIRECTORY_PROVIDER_OAUTH_SCOPES` environment variable, using commas to delimit multiple scopes if necessary.",
+				Optional:    true,
+			},
+			"client_certificate_pem_file": schema.StringAttribute{
+				Description: "Path to a file containing a PEM-encoded client certificate to present when connecting to the PingDirectory server over HTTPS. Must be set along with `client_key_pem_file`. Default value can be set with the `PINGDIRECTORY_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE` environment variable.",
+				Optional:    true,
+			},
+			"client_key_pem_file": schema.StringAttribute{
+				Description: "Path to a file containing the PEM-encoded private key for `client_certificate_pem_file`. Default value can be set with the `PINGDIRECTORY_PROVIDER_CLIENT_KEY_PEM_FILE` environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times to retry a request to the PingDirectory server that fails with a transient error, such as a 503 or 429 response or a refused connection. Requests other than GET are only retried when the server did not process them. Defaults to `" + strconv.Itoa(defaultMaxRetries) + "`. Set to `0` to disable retries. Cannot be greater than `" + strconv.Itoa(maximumMaxRetries) + "`. Default value can be set with the `PINGDIRECTORY_PROVIDER_MAX_RETRIES` environment variable.",
+				Optional:    true,
+			},
+			"retry_backoff": schema.StringAttribute{
+				Description: "Duration to wait before the first retry of a failed request, such as `500ms` or `2s`. The wait doubles with each further retry, up to a maximum of 30 seconds, unless the server returns a `Retry-After` header. Defaults to `" + defaultRetryBackoff + "`. Default value can be set with the `PINGDIRECTORY_PROVIDER_RETRY_BACKOFF` environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum duration to wait for each attempt of a request to the PingDirectory server, such as `30s` or `2m`. By default there is no timeout. Default value can be set with the `PINGDIRECTORY_PROVIDER_REQUEST_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+			"wait_for_ready_timeout": schema.StringAttribute{
+				Description: "Maximum duration to wait for the PingDirectory server to become available before managing any resources, such as `5m`. The provider polls the availability state endpoint given by `availability_state_path` until it returns a 200 response. By default the provider does not wait. Default value can be set with the `PINGDIRECTORY_PROVIDER_WAIT_FOR_READY_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+			"required_action_behavior": schema.StringAttribute{
+				Description: "How to report required actions, such as restarting the server or a component, that are returned by the PingDirectory Configuration API when a resource is created or updated. Options are `ignore`, `warn`, and `fail`. With `fail`, an update is still applied to the server, but the apply reports an error. Required actions returned when a resource is created are always reported as warnings, since an error would cause Terraform to delete and re-create the config object on the next apply. Defaults to `warn`. Default value can be set with the `PINGDIRECTORY_PROVIDER_REQUIRED_ACTION_BEHAVIOR` environment variable.",
+				Optional:    true,
+			},
+			"availability_state_path": schema.StringAttribute{
+				Description: "Path of the Availability State HTTP Servlet Extension endpoint that is polled when `wait_for_ready_timeout` is set. Defaults to `" + defaultAvailabilityStatePath + "`. Default value can be set with the `PINGDIRECTORY_PROVIDER_AVAILABILITY_STATE_PATH` environment variable.",
+				Optional:    true,
+			},
 			"insecure_trust_all_tls": schema.BoolAttribute{
 				Description: "Set to true to trust any certificate when connecting to the PingDirectory server. This is insecure and should not be enabled outside of testing. Default value can be set with the `PINGDIRECTORY_PROVIDER_INSECURE_TRUST_ALL_TLS` environment variable.",
 				Optional:    true,
@@ -96,7 +191,7 @@ func (p *pingdirectoryProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:    true,
 			},
 			"product_version": schema.StringAttribute{
-				Description: "Version of the PingDirectory server being configured. Default value can be set with the `PINGDIRECTORY_PROVIDER_PRODUCT_VERSION` environment variable.",
+				Description: "Version of the PingDirectory server being configured. Patch versions such as `9.2.0.1` are treated as the matching supported version, such as `9.2.0.0`. If not set, the version will be read from the PingDirectory server. Default value can be set with the `PINGDIRECTORY_PROVIDER_PRODUCT_VERSION` environment variable.",
 				Optional:    true,
 			},
 		},
@@ -137,49 +232,36 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 		}
 	}
 
-	// User must provide a username to the provider
-	var username string
-	if config.Username.IsUnknown() {
-		// Cannot connect to PingDirectory with an unknown value
-		resp.Diagnostics.AddError(
-			"Unable to connect to the PingDirectory instance",
-			"Cannot use unknown value as username",
-		)
-	} else {
-		if config.Username.IsNull() {
-			username = os.Getenv("PINGDIRECTORY_PROVIDER_USERNAME")
-		} else {
-			username = config.Username.ValueString()
-		}
-		if username == "" {
-			resp.Diagnostics.AddError(
-				"Unable to find username",
-				"username cannot be an empty string. Either set it in the configuration or use the PINGDIRECTORY_PROVIDER_USERNAME environment variable.",
-			)
-		}
+	// Credentials for basic auth. These are required unless another authentication method is used
+	username := getStringAttribute(config.Username, "PINGDIRECTORY_PROVIDER_USERNAME", "username", &resp.Diagnostics)
+	password := getStringAttribute(config.Password, "PINGDIRECTORY_PROVIDER_PASSWORD", "password", &resp.Diagnostics)
+
+	// Alternative authentication methods
+	accessToken := getStringAttribute(config.AccessToken, "PINGDIRECTORY_PROVIDER_ACCESS_TOKEN", "access_token", &resp.Diagnostics)
+	oauthClientId := getStringAttribute(config.OAuthClientId, "PINGDIRECTORY_PROVIDER_OAUTH_CLIENT_ID", "oauth_client_id", &resp.Diagnostics)
+	oauthClientSecret := getStringAttribute(config.OAuthClientSecret, "PINGDIRECTORY_PROVIDER_OAUTH_CLIENT_SECRET", "oauth_client_secret", &resp.Diagnostics)
+	oauthTokenUrl := getStringAttribute(config.OAuthTokenUrl, "PINGDIRECTORY_PROVIDER_OAUTH_TOKEN_URL", "oauth_token_url", &resp.Diagnostics)
+	clientCertPemFile := getStringAttribute(config.ClientCertPEMFile, "PINGDIRECTORY_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE", "client_certificate_pem_file", &resp.Diagnostics)
+	clientKeyPemFile := getStringAttribute(config.ClientKeyPEMFile, "PINGDIRECTORY_PROVIDER_CLIENT_KEY_PEM_FILE", "client_key_pem_file", &resp.Diagnostics)
+	var oauthScopes []string
+	if !config.OAuthScopes.IsUnknown() && !config.OAuthScopes.IsNull() {
+		config.OAuthScopes.ElementsAs(ctx, &oauthScopes, false)
+	} else if scopesEnvVar := os.Getenv("PINGDIRECTORY_PROVIDER_OAUTH_SCOPES"); len(scopesEnvVar) > 0 {
+		oauthScopes = strings.Split(scopesEnvVar, ",")
 	}
 
-	// User must provide a username to the provider
-	var password string
-	if config.Password.IsUnknown() {
-		// Cannot connect to PingDirectory with an unknown value
-		resp.Diagnostics.AddError(
-			"Unable to connect to the PingDirectory instance",
-			"Cannot use unknown value as password",
-		)
-	} else {
-		if config.Password.IsNull() {
-			password = os.Getenv("PINGDIRECTORY_PROVIDER_PASSWORD")
-		} else {
-			password = config.Password.ValueString()
-		}
-		if password == "" {
-			resp.Diagnostics.AddError(
-				"Unable to find password",
-				"password cannot be an empty string. Either set it in the configuration or use the PINGDIRECTORY_PROVIDER_PASSWORD environment variable.",
-			)
-		}
+	auth := authConfig{
+		username:          username,
+		password:          password,
+		accessToken:       accessToken,
+		oauthClientId:     oauthClientId,
+		oauthClientSecret: oauthClientSecret,
+		oauthTokenUrl:     oauthTokenUrl,
+		oauthScopes:       oauthScopes,
+		clientCertPemFile: clientCertPemFile,
+		clientKeyPemFile:  clientKeyPemFile,
 	}
+	validateAuthConfig(auth, &resp.Diagnostics)
 
 	var productVersion string
 	var err error
@@ -189,12 +271,8 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 		productVersion = os.Getenv("PINGDIRECTORY_PROVIDER_PRODUCT_VERSION")
 	}
 
-	if productVersion == "" {
-		resp.Diagnostics.AddError(
-			"Unable to find PingDirectory version",
-			"product_version cannot be an empty string. Either set it in the configuration or use the PINGDIRECTORY_PROVIDER_PRODUCT_VERSION environment variable.",
-		)
-	} else {
+	// If no version is configured, it will be read from the server once the client has been created
+	if productVersion != "" {
 		// Validate the PingDirectory version
 		productVersion, err = version.Parse(productVersion)
 		if err != nil {
@@ -245,6 +323,49 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 		}
 	}
 
+	// Retry settings
+	maxRetries := int64(defaultMaxRetries)
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to connect to the PingDirectory instance",
+			"Cannot use unknown value as max_retries",
+		)
+	} else if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	} else if maxRetriesEnvVar := os.Getenv("PINGDIRECTORY_PROVIDER_MAX_RETRIES"); maxRetriesEnvVar != "" {
+		maxRetries, err = strconv.ParseInt(maxRetriesEnvVar, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to parse integer from 'PINGDIRECTORY_PROVIDER_MAX_RETRIES' environment variable", err.Error())
+		}
+	}
+	if maxRetries < 0 {
+		resp.Diagnostics.AddError("Invalid max_retries", "max_retries cannot be negative")
+	} else if maxRetries > maximumMaxRetries {
+		resp.Diagnostics.AddError("Invalid max_retries", "max_retries cannot be greater than "+strconv.Itoa(maximumMaxRetries))
+	}
+	retryBackoff := parseDurationAttribute(config.RetryBackoff, "PINGDIRECTORY_PROVIDER_RETRY_BACKOFF", "retry_backoff", defaultRetryBackoff, &resp.Diagnostics)
+	requestTimeout := parseDurationAttribute(config.RequestTimeout, "PINGDIRECTORY_PROVIDER_REQUEST_TIMEOUT", "request_timeout", "", &resp.Diagnostics)
+
+	// Settings for waiting for the server to become available
+	waitForReadyTimeout := parseDurationAttribute(config.WaitForReadyTimeout, "PINGDIRECTORY_PROVIDER_WAIT_FOR_READY_TIMEOUT", "wait_for_ready_timeout", "", &resp.Diagnostics)
+	availabilityStatePath := getStringAttribute(config.AvailabilityStatePath, "PINGDIRECTORY_PROVIDER_AVAILABILITY_STATE_PATH", "availability_state_path", &resp.Diagnostics)
+	if availabilityStatePath == "" {
+		availabilityStatePath = defaultAvailabilityStatePath
+	}
+
+	requiredActionBehavior := getStringAttribute(config.RequiredActionBehavior, "PINGDIRECTORY_PROVIDER_REQUIRED_ACTION_BEHAVIOR", "required_action_behavior", &resp.Diagnostics)
+	if requiredActionBehavior == "" {
+		requiredActionBehavior = internaltypes.RequiredActionBehaviorWarn
+	}
+	if requiredActionBehavior != internaltypes.RequiredActionBehaviorIgnore &&
+		requiredActionBehavior != internaltypes.RequiredActionBehaviorWarn &&
+		requiredActionBehavior != internaltypes.RequiredActionBehaviorFail {
+		resp.Diagnostics.AddError("Invalid required_action_behavior",
+			"required_action_behavior must be one of \"ignore\", \"warn\", or \"fail\", but found \""+requiredActionBehavior+"\"")
+	}
+
+	clientCerts := loadClientCertificates(ctx, auth, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -253,10 +374,12 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 	// type Configure methods.
 	var resourceConfig internaltypes.ResourceConfiguration
 	providerConfig := internaltypes.ProviderConfiguration{
-		HttpsHost:      httpsHost,
-		Username:       username,
-		Password:       password,
-		ProductVersion: productVersion,
+		HttpsHost:              httpsHost,
+		Username:               username,
+		Password:               password,
+		ProductVersion:         productVersion,
+		RequiredActionBehavior: requiredActionBehavior,
+		PlannedRecurringTasks:  internaltypes.NewPlannedIds(),
 	}
 	resourceConfig.ProviderConfig = providerConfig
 	//#nosec G402
@@ -264,9 +387,26 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: insecureTrustAllTls,
 			RootCAs:            caCertPool,
+			Certificates:       clientCerts,
 		},
 	}
-	httpClient := &http.Client{Transport: tr}
+	retryTr := &retryTransport{
+		base:           tr,
+		maxRetries:     maxRetries,
+		backoff:        retryBackoff,
+		requestTimeout: requestTimeout,
+	}
+	httpClient := newHttpClient(ctx, auth, retryTr)
+	// Wait for the server to become available before any resource operations run. The availability
+	// state endpoint does not require authentication.
+	if waitForReadyTimeout > 0 {
+		err = waitForReady(ctx, &http.Client{Transport: tr}, httpsHost+availabilityStatePath, waitForReadyTimeout, waitForReadyPollInterval)
+		if err != nil {
+			resp.Diagnostics.AddError("PingDirectory server is not available", err.Error())
+			return
+		}
+	}
+
 	// Always create a client for the most recent version, since it is
 	// the default used by resources that are compatible with multiple versions
 	clientConfig9200 := client9200.NewConfiguration()
@@ -278,13 +418,507 @@ func (p *pingdirectoryProvider) Configure(ctx context.Context, req provider.Conf
 	clientConfig9200.HTTPClient = httpClient
 	resourceConfig.ApiClientV9200 = client9200.NewAPIClient(clientConfig9200)
 
+	// Read the version from the server, to use if none was configured or to verify the configured version
+	serverVersion, err := getServerVersion(ctx, resourceConfig.ApiClientV9200, providerConfig)
+	if productVersion == "" {
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to determine PingDirectory version",
+				"product_version was not set, and the version could not be read from the PingDirectory server: "+err.Error()+
+					". Either set it in the configuration or use the PINGDIRECTORY_PROVIDER_PRODUCT_VERSION environment variable.")
+			return
+		}
+		productVersion, err = version.Parse(serverVersion)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to parse PingDirectory version read from the server", err.Error())
+			return
+		}
+		tflog.Info(ctx, "Using PingDirectory version "+productVersion+" read from the server")
+		resourceConfig.ProviderConfig.ProductVersion = productVersion
+	} else if err != nil {
+		// Requests to read the version are retried like any other request, so report the failure to explain any delay
+		resp.Diagnostics.AddWarning("Unable to verify PingDirectory version",
+			"The version could not be read from the PingDirectory server to verify the configured product_version of "+productVersion+": "+err.Error())
+	} else {
+		parsedServerVersion, err := version.Parse(serverVersion)
+		if err != nil || parsedServerVersion != productVersion {
+			resp.Diagnostics.AddWarning("Mismatched PingDirectory version",
+				"The configured product_version is "+productVersion+", but the PingDirectory server reports version "+serverVersion+".")
+		}
+	}
+
 	resp.ResourceData = resourceConfig
+	resp.DataSourceData = resourceConfig
 	tflog.Info(ctx, "Configured PingDirectory client", map[string]interface{}{"success": true})
 }
 
+// Get the value of an optional string provider attribute, falling back to the given environment variable
+func getStringAttribute(value types.String, envVar, attributeName string, diagnostics *diag.Diagnostics) string {
+	if value.IsUnknown() {
+		// Cannot connect to PingDirectory with an unknown value
+		diagnostics.AddError(
+			"Unable to connect to the PingDirectory instance",
+			"Cannot use unknown value as "+attributeName,
+		)
+		return ""
+	}
+	if value.IsNull() {
+		return os.Getenv(envVar)
+	}
+	return value.ValueString()
+}
+
+// Parse an optional duration provider attribute, falling back to the given environment variable and then to the
+// default value. Returns zero if no value or default is set.
+func parseDurationAttribute(value types.String, envVar, attributeName, defaultValue string, diagnostics *diag.Diagnostics) time.Duration {
+	durationString := getStringAttribute(value, envVar, attributeName, diagnostics)
+	if durationString == "" {
+		durationString = defaultValue
+	}
+	if durationString == "" {
+		return 0
+	}
+	duration, err := time.ParseDuration(durationString)
+	if err != nil {
+		diagnostics.AddError("Failed to parse "+attributeName, "Expected a duration such as '500ms' or '30s': "+err.Error())
+		return 0
+	}
+	if duration < 0 {
+		diagnostics.AddError("Invalid "+attributeName, attributeName+" cannot be negative")
+	}
+	return duration
+}
+
+// Read the version of the PingDirectory server, from the server instance matching the instance name in the global configuration
+func getServerVersion(ctx context.Context, apiClient *client9200.APIClient, providerConfig internaltypes.ProviderConfiguration) (string, error) {
+	authCtx := config.ProviderBasicAuthContext(ctx, providerConfig)
+	globalConfig, _, err := apiClient.GlobalConfigurationApi.GetGlobalConfiguration(authCtx).Execute()
+	if err != nil {
+		return "", err
+	}
+	serverInstance, _, err := apiClient.ServerInstanceApi.GetServerInstance(authCtx, globalConfig.InstanceName).Execute()
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case serverInstance.DirectoryServerInstanceResponse != nil:
+		return serverInstance.DirectoryServerInstanceResponse.ServerVersion, nil
+	case serverInstance.ProxyServerInstanceResponse != nil:
+		return serverInstance.ProxyServerInstanceResponse.ServerVersion, nil
+	case serverInstance.SyncServerInstanceResponse != nil:
+		return serverInstance.SyncServerInstanceResponse.ServerVersion, nil
+	case serverInstance.AuthorizeServerInstanceResponse != nil:
+		return serverInstance.AuthorizeServerInstanceResponse.ServerVersion, nil
+	case serverInstance.MetricsEngineServerInstanceResponse != nil:
+		return serverInstance.MetricsEngineServerInstanceResponse.ServerVersion, nil
+	}
+	return "", errors.New("no server version found for server instance " + globalConfig.InstanceName)
+}
+
 // DataSources defines the data sources implemented in the provider.
+// Maintain alphabetical order for ease of management
 func (p *pingdirectoryProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		accesscontrolhandler.NewDseeCompatAccessControlHandlerDataSource,
+		accesstokenvalidator.NewJwtAccessTokenValidatorDataSource,
+		accesstokenvalidator.NewMockAccessTokenValidatorDataSource,
+		accesstokenvalidator.NewPingFederateAccessTokenValidatorDataSource,
+		accesstokenvalidator.NewThirdPartyAccessTokenValidatorDataSource,
+		accountstatusnotificationhandler.NewAdminAlertAccountStatusNotificationHandlerDataSource,
+		accountstatusnotificationhandler.NewErrorLogAccountStatusNotificationHandlerDataSource,
+		accountstatusnotificationhandler.NewGroovyScriptedAccountStatusNotificationHandlerDataSource,
+		accountstatusnotificationhandler.NewMultiPartEmailAccountStatusNotificationHandlerDataSource,
+		accountstatusnotificationhandler.NewSmtpAccountStatusNotificationHandlerDataSource,
+		accountstatusnotificationhandler.NewThirdPartyAccountStatusNotificationHandlerDataSource,
+		alerthandler.NewCustomAlertHandlerDataSource,
+		alerthandler.NewErrorLogAlertHandlerDataSource,
+		alerthandler.NewExecAlertHandlerDataSource,
+		alerthandler.NewGroovyScriptedAlertHandlerDataSource,
+		alerthandler.NewJmxAlertHandlerDataSource,
+		alerthandler.NewOutputAlertHandlerDataSource,
+		alerthandler.NewSmtpAlertHandlerDataSource,
+		alerthandler.NewSnmpAlertHandlerDataSource,
+		alerthandler.NewSnmpSubAgentAlertHandlerDataSource,
+		alerthandler.NewThirdPartyAlertHandlerDataSource,
+		alerthandler.NewTwilioAlertHandlerDataSource,
+		backend.NewAlarmBackendDataSource,
+		backend.NewAlertBackendDataSource,
+		backend.NewBackupBackendDataSource,
+		backend.NewChangelogBackendDataSource,
+		backend.NewConfigFileHandlerBackendDataSource,
+		backend.NewCustomBackendDataSource,
+		backend.NewEncryptionSettingsBackendDataSource,
+		backend.NewLdifBackendDataSource,
+		backend.NewLocalDbBackendDataSource,
+		backend.NewMetricsBackendDataSource,
+		backend.NewMonitorBackendDataSource,
+		backend.NewSchemaBackendDataSource,
+		backend.NewTaskBackendDataSource,
+		backend.NewTrustStoreBackendDataSource,
+		certificatemapper.NewFingerprintCertificateMapperDataSource,
+		certificatemapper.NewGroovyScriptedCertificateMapperDataSource,
+		certificatemapper.NewSubjectAttributeToUserAttributeCertificateMapperDataSource,
+		certificatemapper.NewSubjectDnToUserAttributeCertificateMapperDataSource,
+		certificatemapper.NewSubjectEqualsDnCertificateMapperDataSource,
+		certificatemapper.NewThirdPartyCertificateMapperDataSource,
+		config.NewAccessTokenValidatorsDataSource,
+		config.NewAccountStatusNotificationHandlersDataSource,
+		config.NewAlarmManagerDataSource,
+		config.NewAlertHandlersDataSource,
+		config.NewBackendsDataSource,
+		config.NewCertificateMappersDataSource,
+		config.NewClientConnectionPoliciesDataSource,
+		config.NewClientConnectionPolicyDataSource,
+		config.NewConnectionHandlersDataSource,
+		config.NewConsentDefinitionDataSource,
+		config.NewConsentDefinitionLocalizationDataSource,
+		config.NewConsentServiceDataSource,
+		config.NewDebugTargetDataSource,
+		config.NewDelegatedAdminAttributesDataSource,
+		config.NewDelegatedAdminResourceRightsDataSource,
+		config.NewDelegatedAdminRightsDataSource,
+		config.NewExtendedOperationHandlersDataSource,
+		config.NewExternalServersDataSource,
+		config.NewGaugesDataSource,
+		config.NewGlobalConfigurationDataSource,
+		config.NewHttpServletCrossOriginPolicyDataSource,
+		config.NewHttpServletExtensionsDataSource,
+		config.NewIdentityMappersDataSource,
+		config.NewKeyManagerProvidersDataSource,
+		config.NewLocalDbCompositeIndexDataSource,
+		config.NewLocalDbCompositeIndexesDataSource,
+		config.NewLocalDbIndexDataSource,
+		config.NewLocalDbIndexesDataSource,
+		config.NewLocalDbVlvIndexDataSource,
+		config.NewLocalDbVlvIndexesDataSource,
+		config.NewLocationDataSource,
+		config.NewLocationsDataSource,
+		config.NewLogFileRotationListenersDataSource,
+		config.NewLogPublishersDataSource,
+		config.NewLogRetentionPoliciesDataSource,
+		config.NewLogRotationPoliciesDataSource,
+		config.NewMonitorProvidersDataSource,
+		config.NewPassphraseProvidersDataSource,
+		config.NewPasswordGeneratorsDataSource,
+		config.NewPasswordPoliciesDataSource,
+		config.NewPasswordPolicyDataSource,
+		config.NewPasswordStorageSchemesDataSource,
+		config.NewPasswordValidatorsDataSource,
+		config.NewPluginsDataSource,
+		config.NewRecurringTaskChainDataSource,
+		config.NewRecurringTaskChainsDataSource,
+		config.NewRecurringTasksDataSource,
+		config.NewReplicationAssurancePoliciesDataSource,
+		config.NewReplicationAssurancePolicyDataSource,
+		config.NewReplicationDomainDataSource,
+		config.NewRestResourceTypesDataSource,
+		config.NewResultCriteriaDataSource,
+		config.NewRootDnDataSource,
+		config.NewRootDnUserDataSource,
+		config.NewSaslMechanismHandlersDataSource,
+		config.NewSearchEntryCriteriaDataSource,
+		config.NewSearchReferenceCriteriaDataSource,
+		config.NewTopologyAdminUserDataSource,
+		config.NewTrustManagerProvidersDataSource,
+		config.NewVirtualAttributesDataSource,
+		connectioncriteria.NewAggregateConnectionCriteriaDataSource,
+		connectioncriteria.NewSimpleConnectionCriteriaDataSource,
+		connectioncriteria.NewThirdPartyConnectionCriteriaDataSource,
+		connectionhandler.NewHttpConnectionHandlerDataSource,
+		connectionhandler.NewJmxConnectionHandlerDataSource,
+		connectionhandler.NewLdapConnectionHandlerDataSource,
+		connectionhandler.NewLdifConnectionHandlerDataSource,
+		delegatedadminattribute.NewCertificateDelegatedAdminAttributeDataSource,
+		delegatedadminattribute.NewGenericDelegatedAdminAttributeDataSource,
+		delegatedadminattribute.NewPhotoDelegatedAdminAttributeDataSource,
+		extendedoperationhandler.NewBatchedTransactionsExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewCancelExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewCollectSupportDataExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewCustomExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewDeliverOtpExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewDeliverPasswordResetTokenExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewExportReversiblePasswordsExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewGeneratePasswordExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewGetChangelogBatchExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewGetConnectionIdExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewGetPasswordQualityRequirementsExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewGetSupportedOtpDeliveryMechanismsExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewMultiUpdateExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewNotificationSubscriptionExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewPasswordModifyExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewPasswordPolicyStateExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewReplaceCertificateExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewSingleUseTokensExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewStartTlsExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewThirdPartyExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewValidateTotpPasswordExtendedOperationHandlerDataSource,
+		extendedoperationhandler.NewWhoAmIExtendedOperationHandlerDataSource,
+		externalserver.NewActiveDirectoryExternalServerDataSource,
+		externalserver.NewAmazonAwsExternalServerDataSource,
+		externalserver.NewConjurExternalServerDataSource,
+		externalserver.NewHttpExternalServerDataSource,
+		externalserver.NewHttpProxyExternalServerDataSource,
+		externalserver.NewJdbcExternalServerDataSource,
+		externalserver.NewLdapExternalServerDataSource,
+		externalserver.NewNokiaDsExternalServerDataSource,
+		externalserver.NewNokiaProxyServerExternalServerDataSource,
+		externalserver.NewOpendjExternalServerDataSource,
+		externalserver.NewOracleUnifiedDirectoryExternalServerDataSource,
+		externalserver.NewPingIdentityDsExternalServerDataSource,
+		externalserver.NewPingIdentityProxyServerExternalServerDataSource,
+		externalserver.NewPingOneHttpExternalServerDataSource,
+		externalserver.NewSmtpExternalServerDataSource,
+		externalserver.NewSyslogExternalServerDataSource,
+		externalserver.NewVaultExternalServerDataSource,
+		gauge.NewIndicatorGaugeDataSource,
+		gauge.NewNumericGaugeDataSource,
+		httpservletextension.NewAvailabilityStateHttpServletExtensionDataSource,
+		httpservletextension.NewConfigHttpServletExtensionDataSource,
+		httpservletextension.NewConsentHttpServletExtensionDataSource,
+		httpservletextension.NewDelegatedAdminHttpServletExtensionDataSource,
+		httpservletextension.NewDirectoryRestApiHttpServletExtensionDataSource,
+		httpservletextension.NewFileServerHttpServletExtensionDataSource,
+		httpservletextension.NewGroovyScriptedHttpServletExtensionDataSource,
+		httpservletextension.NewLdapMappedScimHttpServletExtensionDataSource,
+		httpservletextension.NewPrometheusMonitoringHttpServletExtensionDataSource,
+		httpservletextension.NewQuickstartHttpServletExtensionDataSource,
+		httpservletextension.NewScim2HttpServletExtensionDataSource,
+		httpservletextension.NewThirdPartyHttpServletExtensionDataSource,
+		httpservletextension.NewVelocityHttpServletExtensionDataSource,
+		identitymapper.NewAggregateIdentityMapperDataSource,
+		identitymapper.NewExactMatchIdentityMapperDataSource,
+		identitymapper.NewGroovyScriptedIdentityMapperDataSource,
+		identitymapper.NewRegularExpressionIdentityMapperDataSource,
+		identitymapper.NewThirdPartyIdentityMapperDataSource,
+		keymanagerprovider.NewFileBasedKeyManagerProviderDataSource,
+		keymanagerprovider.NewPkcs11KeyManagerProviderDataSource,
+		keymanagerprovider.NewThirdPartyKeyManagerProviderDataSource,
+		logfilerotationlistener.NewCopyLogFileRotationListenerDataSource,
+		logfilerotationlistener.NewSummarizeLogFileRotationListenerDataSource,
+		logfilerotationlistener.NewThirdPartyLogFileRotationListenerDataSource,
+		logpublisher.NewAdminAlertAccessLogPublisherDataSource,
+		logpublisher.NewCommonLogFileHttpOperationLogPublisherDataSource,
+		logpublisher.NewConsoleJsonAccessLogPublisherDataSource,
+		logpublisher.NewConsoleJsonAuditLogPublisherDataSource,
+		logpublisher.NewConsoleJsonErrorLogPublisherDataSource,
+		logpublisher.NewConsoleJsonHttpOperationLogPublisherDataSource,
+		logpublisher.NewDebugAccessLogPublisherDataSource,
+		logpublisher.NewDetailedHttpOperationLogPublisherDataSource,
+		logpublisher.NewFileBasedAccessLogPublisherDataSource,
+		logpublisher.NewFileBasedAuditLogPublisherDataSource,
+		logpublisher.NewFileBasedDebugLogPublisherDataSource,
+		logpublisher.NewFileBasedErrorLogPublisherDataSource,
+		logpublisher.NewFileBasedJsonAuditLogPublisherDataSource,
+		logpublisher.NewFileBasedJsonHttpOperationLogPublisherDataSource,
+		logpublisher.NewFileBasedTraceLogPublisherDataSource,
+		logpublisher.NewGroovyScriptedAccessLogPublisherDataSource,
+		logpublisher.NewGroovyScriptedErrorLogPublisherDataSource,
+		logpublisher.NewGroovyScriptedFileBasedAccessLogPublisherDataSource,
+		logpublisher.NewGroovyScriptedFileBasedErrorLogPublisherDataSource,
+		logpublisher.NewGroovyScriptedHttpOperationLogPublisherDataSource,
+		logpublisher.NewJdbcBasedAccessLogPublisherDataSource,
+		logpublisher.NewJdbcBasedErrorLogPublisherDataSource,
+		logpublisher.NewJsonAccessLogPublisherDataSource,
+		logpublisher.NewJsonErrorLogPublisherDataSource,
+		logpublisher.NewOperationTimingAccessLogPublisherDataSource,
+		logpublisher.NewSyslogBasedAccessLogPublisherDataSource,
+		logpublisher.NewSyslogBasedErrorLogPublisherDataSource,
+		logpublisher.NewSyslogJsonAccessLogPublisherDataSource,
+		logpublisher.NewSyslogJsonAuditLogPublisherDataSource,
+		logpublisher.NewSyslogJsonErrorLogPublisherDataSource,
+		logpublisher.NewSyslogJsonHttpOperationLogPublisherDataSource,
+		logpublisher.NewSyslogTextAccessLogPublisherDataSource,
+		logpublisher.NewSyslogTextErrorLogPublisherDataSource,
+		logpublisher.NewThirdPartyAccessLogPublisherDataSource,
+		logpublisher.NewThirdPartyErrorLogPublisherDataSource,
+		logpublisher.NewThirdPartyFileBasedAccessLogPublisherDataSource,
+		logpublisher.NewThirdPartyFileBasedErrorLogPublisherDataSource,
+		logpublisher.NewThirdPartyHttpOperationLogPublisherDataSource,
+		logretentionpolicy.NewFileCountLogRetentionPolicyDataSource,
+		logretentionpolicy.NewFreeDiskSpaceLogRetentionPolicyDataSource,
+		logretentionpolicy.NewNeverDeleteLogRetentionPolicyDataSource,
+		logretentionpolicy.NewSizeLimitLogRetentionPolicyDataSource,
+		logretentionpolicy.NewTimeLimitLogRetentionPolicyDataSource,
+		logrotationpolicy.NewFixedTimeLogRotationPolicyDataSource,
+		logrotationpolicy.NewNeverRotateLogRotationPolicyDataSource,
+		logrotationpolicy.NewSizeLimitLogRotationPolicyDataSource,
+		logrotationpolicy.NewTimeLimitLogRotationPolicyDataSource,
+		monitorprovider.NewActiveOperationsMonitorProviderDataSource,
+		monitorprovider.NewClientConnectionMonitorProviderDataSource,
+		monitorprovider.NewCustomMonitorProviderDataSource,
+		monitorprovider.NewDiskSpaceUsageMonitorProviderDataSource,
+		monitorprovider.NewGeneralMonitorProviderDataSource,
+		monitorprovider.NewHostSystemMonitorProviderDataSource,
+		monitorprovider.NewMemoryUsageMonitorProviderDataSource,
+		monitorprovider.NewSslContextMonitorProviderDataSource,
+		monitorprovider.NewStackTraceMonitorProviderDataSource,
+		monitorprovider.NewSystemInfoMonitorProviderDataSource,
+		monitorprovider.NewThirdPartyMonitorProviderDataSource,
+		monitorprovider.NewVersionMonitorProviderDataSource,
+		passphraseprovider.NewAmazonSecretsManagerPassphraseProviderDataSource,
+		passphraseprovider.NewAzureKeyVaultPassphraseProviderDataSource,
+		passphraseprovider.NewConjurPassphraseProviderDataSource,
+		passphraseprovider.NewEnvironmentVariablePassphraseProviderDataSource,
+		passphraseprovider.NewFileBasedPassphraseProviderDataSource,
+		passphraseprovider.NewObscuredValuePassphraseProviderDataSource,
+		passphraseprovider.NewThirdPartyPassphraseProviderDataSource,
+		passphraseprovider.NewVaultPassphraseProviderDataSource,
+		passwordgenerator.NewGroovyScriptedPasswordGeneratorDataSource,
+		passwordgenerator.NewPassphrasePasswordGeneratorDataSource,
+		passwordgenerator.NewRandomPasswordGeneratorDataSource,
+		passwordgenerator.NewThirdPartyPasswordGeneratorDataSource,
+		passwordstoragescheme.NewAes256PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewAesPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewAmazonSecretsManagerPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewArgon2PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewArgon2dPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewArgon2iPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewArgon2idPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewAzureKeyVaultPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewBase64PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewBcryptPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewBlowfishPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewClearPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewConjurPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewCryptPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewMd5PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewPbkdf2PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewRc4PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewSaltedMd5PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewSaltedSha1PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewSaltedSha256PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewSaltedSha384PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewSaltedSha512PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewScryptPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewSha1PasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewThirdPartyEnhancedPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewThirdPartyPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewTripleDesPasswordStorageSchemeDataSource,
+		passwordstoragescheme.NewVaultPasswordStorageSchemeDataSource,
+		passwordvalidator.NewAttributeValuePasswordValidatorDataSource,
+		passwordvalidator.NewCharacterSetPasswordValidatorDataSource,
+		passwordvalidator.NewCustomPasswordValidatorDataSource,
+		passwordvalidator.NewDictionaryPasswordValidatorDataSource,
+		passwordvalidator.NewGroovyScriptedPasswordValidatorDataSource,
+		passwordvalidator.NewHaystackPasswordValidatorDataSource,
+		passwordvalidator.NewLengthBasedPasswordValidatorDataSource,
+		passwordvalidator.NewPwnedPasswordsPasswordValidatorDataSource,
+		passwordvalidator.NewRegularExpressionPasswordValidatorDataSource,
+		passwordvalidator.NewRepeatedCharactersPasswordValidatorDataSource,
+		passwordvalidator.NewSimilarityBasedPasswordValidatorDataSource,
+		passwordvalidator.NewThirdPartyPasswordValidatorDataSource,
+		passwordvalidator.NewUniqueCharactersPasswordValidatorDataSource,
+		plugin.NewAttributeMapperPluginDataSource,
+		plugin.NewChangeSubscriptionNotificationPluginDataSource,
+		plugin.NewChangelogPasswordEncryptionPluginDataSource,
+		plugin.NewCleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource,
+		plugin.NewCleanUpExpiredPingfederatePersistentSessionsPluginDataSource,
+		plugin.NewCleanUpInactivePingfederatePersistentSessionsPluginDataSource,
+		plugin.NewComposedAttributePluginDataSource,
+		plugin.NewCustomPluginDataSource,
+		plugin.NewDelayPluginDataSource,
+		plugin.NewDnMapperPluginDataSource,
+		plugin.NewEncryptAttributeValuesPluginDataSource,
+		plugin.NewGroovyScriptedPluginDataSource,
+		plugin.NewInternalSearchRatePluginDataSource,
+		plugin.NewLastAccessTimePluginDataSource,
+		plugin.NewLastModPluginDataSource,
+		plugin.NewLdapResultCodeTrackerPluginDataSource,
+		plugin.NewModifiablePasswordPolicyStatePluginDataSource,
+		plugin.NewMonitorHistoryPluginDataSource,
+		plugin.NewPassThroughAuthenticationPluginDataSource,
+		plugin.NewPasswordPolicyImportPluginDataSource,
+		plugin.NewPeriodicGcPluginDataSource,
+		plugin.NewPeriodicStatsLoggerPluginDataSource,
+		plugin.NewPingOnePassThroughAuthenticationPluginDataSource,
+		plugin.NewPluggablePassThroughAuthenticationPluginDataSource,
+		plugin.NewProcessingTimeHistogramPluginDataSource,
+		plugin.NewProfilerPluginDataSource,
+		plugin.NewPurgeExpiredDataPluginDataSource,
+		plugin.NewReferentialIntegrityPluginDataSource,
+		plugin.NewReferralOnUpdatePluginDataSource,
+		plugin.NewSearchShutdownPluginDataSource,
+		plugin.NewSevenBitCleanPluginDataSource,
+		plugin.NewSimpleToExternalBindPluginDataSource,
+		plugin.NewSnmpSubagentPluginDataSource,
+		plugin.NewStatsCollectorPluginDataSource,
+		plugin.NewSubOperationTimingPluginDataSource,
+		plugin.NewThirdPartyPluginDataSource,
+		plugin.NewUniqueAttributePluginDataSource,
+		recurringtask.NewAuditDataSecurityRecurringTaskDataSource,
+		recurringtask.NewBackupRecurringTaskDataSource,
+		recurringtask.NewCollectSupportDataRecurringTaskDataSource,
+		recurringtask.NewDelayRecurringTaskDataSource,
+		recurringtask.NewEnterLockdownModeRecurringTaskDataSource,
+		recurringtask.NewExecRecurringTaskDataSource,
+		recurringtask.NewFileRetentionRecurringTaskDataSource,
+		recurringtask.NewGenerateServerProfileRecurringTaskDataSource,
+		recurringtask.NewLdifExportRecurringTaskDataSource,
+		recurringtask.NewLeaveLockdownModeRecurringTaskDataSource,
+		recurringtask.NewStaticallyDefinedRecurringTaskDataSource,
+		recurringtask.NewThirdPartyRecurringTaskDataSource,
+		requestcriteria.NewAggregateRequestCriteriaDataSource,
+		requestcriteria.NewRootDseRequestCriteriaDataSource,
+		requestcriteria.NewSimpleRequestCriteriaDataSource,
+		requestcriteria.NewThirdPartyRequestCriteriaDataSource,
+		restresourcetype.NewGenericRestResourceTypeDataSource,
+		restresourcetype.NewGroupRestResourceTypeDataSource,
+		restresourcetype.NewUserRestResourceTypeDataSource,
+		resultcriteria.NewAggregateResultCriteriaDataSource,
+		resultcriteria.NewReplicationAssuranceResultCriteriaDataSource,
+		resultcriteria.NewSimpleResultCriteriaDataSource,
+		resultcriteria.NewThirdPartyResultCriteriaDataSource,
+		saslmechanismhandler.NewAnonymousSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewCramMd5SaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewDigestMd5SaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewExternalSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewGssapiSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewOauthBearerSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewPlainSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewThirdPartySaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewUnboundidCertificatePlusPasswordSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewUnboundidDeliveredOtpSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewUnboundidExternalAuthSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewUnboundidMsChapV2SaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewUnboundidTotpSaslMechanismHandlerDataSource,
+		saslmechanismhandler.NewUnboundidYubikeyOtpSaslMechanismHandlerDataSource,
+		searchentrycriteria.NewAggregateSearchEntryCriteriaDataSource,
+		searchentrycriteria.NewSimpleSearchEntryCriteriaDataSource,
+		searchentrycriteria.NewThirdPartySearchEntryCriteriaDataSource,
+		searchreferencecriteria.NewAggregateSearchReferenceCriteriaDataSource,
+		searchreferencecriteria.NewSimpleSearchReferenceCriteriaDataSource,
+		searchreferencecriteria.NewThirdPartySearchReferenceCriteriaDataSource,
+		serverinstance.NewAuthorizeServerInstanceDataSource,
+		serverinstance.NewDirectoryServerInstanceDataSource,
+		serverinstance.NewProxyServerInstanceDataSource,
+		serverinstance.NewSyncServerInstanceDataSource,
+		trustmanagerprovider.NewBlindTrustManagerProviderDataSource,
+		trustmanagerprovider.NewFileBasedTrustManagerProviderDataSource,
+		trustmanagerprovider.NewJvmDefaultTrustManagerProviderDataSource,
+		trustmanagerprovider.NewThirdPartyTrustManagerProviderDataSource,
+		virtualattribute.NewConstructedVirtualAttributeDataSource,
+		virtualattribute.NewCurrentTimeVirtualAttributeDataSource,
+		virtualattribute.NewCustomVirtualAttributeDataSource,
+		virtualattribute.NewDnJoinVirtualAttributeDataSource,
+		virtualattribute.NewEntryChecksumVirtualAttributeDataSource,
+		virtualattribute.NewEntryDnVirtualAttributeDataSource,
+		virtualattribute.NewEqualityJoinVirtualAttributeDataSource,
+		virtualattribute.NewGroovyScriptedVirtualAttributeDataSource,
+		virtualattribute.NewHasSubordinatesVirtualAttributeDataSource,
+		virtualattribute.NewIdentifyReferencesVirtualAttributeDataSource,
+		virtualattribute.NewInstanceNameVirtualAttributeDataSource,
+		virtualattribute.NewIsMemberOfVirtualAttributeDataSource,
+		virtualattribute.NewMemberOfServerGroupVirtualAttributeDataSource,
+		virtualattribute.NewMemberVirtualAttributeDataSource,
+		virtualattribute.NewMirrorVirtualAttributeDataSource,
+		virtualattribute.NewNumSubordinatesVirtualAttributeDataSource,
+		virtualattribute.NewPasswordPolicyStateJsonVirtualAttributeDataSource,
+		virtualattribute.NewReplicationStateDetailVirtualAttributeDataSource,
+		virtualattribute.NewReverseDnJoinVirtualAttributeDataSource,
+		virtualattribute.NewShortUniqueIdVirtualAttributeDataSource,
+		virtualattribute.NewSubschemaSubentryVirtualAttributeDataSource,
+		virtualattribute.NewThirdPartyVirtualAttributeDataSource,
+		virtualattribute.NewUserDefinedVirtualAttributeDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -312,6 +946,26 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		accesstokenvalidator.NewMockAccessTokenValidatorResource,
 		accesstokenvalidator.NewPingFederateAccessTokenValidatorResource,
 		accesstokenvalidator.NewThirdPartyAccessTokenValidatorResource,
+		alerthandler.NewCustomAlertHandlerResource,
+		alerthandler.NewDefaultErrorLogAlertHandlerResource,
+		alerthandler.NewDefaultExecAlertHandlerResource,
+		alerthandler.NewDefaultGroovyScriptedAlertHandlerResource,
+		alerthandler.NewDefaultJmxAlertHandlerResource,
+		alerthandler.NewDefaultSmtpAlertHandlerResource,
+		alerthandler.NewDefaultSnmpAlertHandlerResource,
+		alerthandler.NewDefaultSnmpSubAgentAlertHandlerResource,
+		alerthandler.NewDefaultThirdPartyAlertHandlerResource,
+		alerthandler.NewDefaultTwilioAlertHandlerResource,
+		alerthandler.NewErrorLogAlertHandlerResource,
+		alerthandler.NewExecAlertHandlerResource,
+		alerthandler.NewGroovyScriptedAlertHandlerResource,
+		alerthandler.NewJmxAlertHandlerResource,
+		alerthandler.NewOutputAlertHandlerResource,
+		alerthandler.NewSmtpAlertHandlerResource,
+		alerthandler.NewSnmpAlertHandlerResource,
+		alerthandler.NewSnmpSubAgentAlertHandlerResource,
+		alerthandler.NewThirdPartyAlertHandlerResource,
+		alerthandler.NewTwilioAlertHandlerResource,
 		backend.NewAlarmBackendResource,
 		backend.NewAlertBackendResource,
 		backend.NewBackupBackendResource,
@@ -326,14 +980,34 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		backend.NewSchemaBackendResource,
 		backend.NewTaskBackendResource,
 		backend.NewTrustStoreBackendResource,
+		certificatemapper.NewDefaultFingerprintCertificateMapperResource,
+		certificatemapper.NewDefaultGroovyScriptedCertificateMapperResource,
+		certificatemapper.NewDefaultSubjectAttributeToUserAttributeCertificateMapperResource,
+		certificatemapper.NewDefaultSubjectDnToUserAttributeCertificateMapperResource,
+		certificatemapper.NewDefaultSubjectEqualsDnCertificateMapperResource,
+		certificatemapper.NewDefaultThirdPartyCertificateMapperResource,
+		certificatemapper.NewFingerprintCertificateMapperResource,
+		certificatemapper.NewGroovyScriptedCertificateMapperResource,
+		certificatemapper.NewSubjectAttributeToUserAttributeCertificateMapperResource,
+		certificatemapper.NewSubjectDnToUserAttributeCertificateMapperResource,
+		certificatemapper.NewSubjectEqualsDnCertificateMapperResource,
+		certificatemapper.NewThirdPartyCertificateMapperResource,
+		config.NewAlarmManagerResource,
+		config.NewClientConnectionPolicyResource,
 		config.NewConsentDefinitionResource,
+		config.NewDefaultClientConnectionPolicyResource,
 		config.NewDefaultConsentDefinitionResource,
 		config.NewConsentDefinitionLocalizationResource,
 		config.NewDefaultConsentDefinitionLocalizationResource,
 		config.NewConsentServiceResource,
 		config.NewDebugTargetResource,
 		config.NewDefaultDebugTargetResource,
+		config.NewDefaultLocalDbCompositeIndexResource,
+		config.NewDefaultLocalDbVlvIndexResource,
 		config.NewDefaultLocationResource,
+		config.NewDefaultPasswordPolicyResource,
+		config.NewDefaultRecurringTaskChainResource,
+		config.NewDefaultReplicationAssurancePolicyResource,
 		config.NewDefaultRootDnUserResource,
 		config.NewDefaultDelegatedAdminResourceRightsResource,
 		config.NewDelegatedAdminResourceRightsResource,
@@ -343,8 +1017,14 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		config.NewHttpServletCrossOriginPolicyResource,
 		config.NewDefaultHttpServletCrossOriginPolicyResource,
 		config.NewDefaultLocalDbIndexResource,
+		config.NewLocalDbCompositeIndexResource,
 		config.NewLocalDbIndexResource,
+		config.NewLocalDbVlvIndexResource,
 		config.NewLocationResource,
+		config.NewPasswordPolicyResource,
+		config.NewRecurringTaskChainResource,
+		config.NewReplicationAssurancePolicyResource,
+		config.NewReplicationDomainResource,
 		config.NewRootDnResource,
 		config.NewRootDnUserResource,
 		config.NewDefaultTopologyAdminUserResource,
@@ -369,6 +1049,36 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		delegatedadminattribute.NewDefaultPhotoDelegatedAdminAttributeResource,
 		delegatedadminattribute.NewGenericDelegatedAdminAttributeResource,
 		delegatedadminattribute.NewPhotoDelegatedAdminAttributeResource,
+		extendedoperationhandler.NewBatchedTransactionsExtendedOperationHandlerResource,
+		extendedoperationhandler.NewCancelExtendedOperationHandlerResource,
+		extendedoperationhandler.NewCollectSupportDataExtendedOperationHandlerResource,
+		extendedoperationhandler.NewCustomExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultCollectSupportDataExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultDeliverOtpExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultDeliverPasswordResetTokenExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultExportReversiblePasswordsExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultReplaceCertificateExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultSingleUseTokensExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultThirdPartyExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDefaultValidateTotpPasswordExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDeliverOtpExtendedOperationHandlerResource,
+		extendedoperationhandler.NewDeliverPasswordResetTokenExtendedOperationHandlerResource,
+		extendedoperationhandler.NewExportReversiblePasswordsExtendedOperationHandlerResource,
+		extendedoperationhandler.NewGeneratePasswordExtendedOperationHandlerResource,
+		extendedoperationhandler.NewGetChangelogBatchExtendedOperationHandlerResource,
+		extendedoperationhandler.NewGetConnectionIdExtendedOperationHandlerResource,
+		extendedoperationhandler.NewGetPasswordQualityRequirementsExtendedOperationHandlerResource,
+		extendedoperationhandler.NewGetSupportedOtpDeliveryMechanismsExtendedOperationHandlerResource,
+		extendedoperationhandler.NewMultiUpdateExtendedOperationHandlerResource,
+		extendedoperationhandler.NewNotificationSubscriptionExtendedOperationHandlerResource,
+		extendedoperationhandler.NewPasswordModifyExtendedOperationHandlerResource,
+		extendedoperationhandler.NewPasswordPolicyStateExtendedOperationHandlerResource,
+		extendedoperationhandler.NewReplaceCertificateExtendedOperationHandlerResource,
+		extendedoperationhandler.NewSingleUseTokensExtendedOperationHandlerResource,
+		extendedoperationhandler.NewStartTlsExtendedOperationHandlerResource,
+		extendedoperationhandler.NewThirdPartyExtendedOperationHandlerResource,
+		extendedoperationhandler.NewValidateTotpPasswordExtendedOperationHandlerResource,
+		extendedoperationhandler.NewWhoAmIExtendedOperationHandlerResource,
 		externalserver.NewActiveDirectoryExternalServerResource,
 		externalserver.NewAmazonAwsExternalServerResource,
 		externalserver.NewConjurExternalServerResource,
@@ -437,6 +1147,18 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		identitymapper.NewGroovyScriptedIdentityMapperResource,
 		identitymapper.NewRegularExpressionIdentityMapperResource,
 		identitymapper.NewThirdPartyIdentityMapperResource,
+		keymanagerprovider.NewDefaultFileBasedKeyManagerProviderResource,
+		keymanagerprovider.NewDefaultPkcs11KeyManagerProviderResource,
+		keymanagerprovider.NewDefaultThirdPartyKeyManagerProviderResource,
+		keymanagerprovider.NewFileBasedKeyManagerProviderResource,
+		keymanagerprovider.NewPkcs11KeyManagerProviderResource,
+		keymanagerprovider.NewThirdPartyKeyManagerProviderResource,
+		logfilerotationlistener.NewCopyLogFileRotationListenerResource,
+		logfilerotationlistener.NewDefaultCopyLogFileRotationListenerResource,
+		logfilerotationlistener.NewDefaultSummarizeLogFileRotationListenerResource,
+		logfilerotationlistener.NewDefaultThirdPartyLogFileRotationListenerResource,
+		logfilerotationlistener.NewSummarizeLogFileRotationListenerResource,
+		logfilerotationlistener.NewThirdPartyLogFileRotationListenerResource,
 		logpublisher.NewAdminAlertAccessLogPublisherResource,
 		logpublisher.NewCommonLogFileHttpOperationLogPublisherResource,
 		logpublisher.NewConsoleJsonAccessLogPublisherResource,
@@ -511,6 +1233,129 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		logpublisher.NewThirdPartyFileBasedAccessLogPublisherResource,
 		logpublisher.NewThirdPartyFileBasedErrorLogPublisherResource,
 		logpublisher.NewThirdPartyHttpOperationLogPublisherResource,
+		logretentionpolicy.NewDefaultFileCountLogRetentionPolicyResource,
+		logretentionpolicy.NewDefaultFreeDiskSpaceLogRetentionPolicyResource,
+		logretentionpolicy.NewDefaultNeverDeleteLogRetentionPolicyResource,
+		logretentionpolicy.NewDefaultSizeLimitLogRetentionPolicyResource,
+		logretentionpolicy.NewDefaultTimeLimitLogRetentionPolicyResource,
+		logretentionpolicy.NewFileCountLogRetentionPolicyResource,
+		logretentionpolicy.NewFreeDiskSpaceLogRetentionPolicyResource,
+		logretentionpolicy.NewNeverDeleteLogRetentionPolicyResource,
+		logretentionpolicy.NewSizeLimitLogRetentionPolicyResource,
+		logretentionpolicy.NewTimeLimitLogRetentionPolicyResource,
+		logrotationpolicy.NewDefaultFixedTimeLogRotationPolicyResource,
+		logrotationpolicy.NewDefaultNeverRotateLogRotationPolicyResource,
+		logrotationpolicy.NewDefaultSizeLimitLogRotationPolicyResource,
+		logrotationpolicy.NewDefaultTimeLimitLogRotationPolicyResource,
+		logrotationpolicy.NewFixedTimeLogRotationPolicyResource,
+		logrotationpolicy.NewNeverRotateLogRotationPolicyResource,
+		logrotationpolicy.NewSizeLimitLogRotationPolicyResource,
+		logrotationpolicy.NewTimeLimitLogRotationPolicyResource,
+		monitorprovider.NewActiveOperationsMonitorProviderResource,
+		monitorprovider.NewClientConnectionMonitorProviderResource,
+		monitorprovider.NewCustomMonitorProviderResource,
+		monitorprovider.NewDefaultThirdPartyMonitorProviderResource,
+		monitorprovider.NewDiskSpaceUsageMonitorProviderResource,
+		monitorprovider.NewGeneralMonitorProviderResource,
+		monitorprovider.NewHostSystemMonitorProviderResource,
+		monitorprovider.NewMemoryUsageMonitorProviderResource,
+		monitorprovider.NewSslContextMonitorProviderResource,
+		monitorprovider.NewStackTraceMonitorProviderResource,
+		monitorprovider.NewSystemInfoMonitorProviderResource,
+		monitorprovider.NewThirdPartyMonitorProviderResource,
+		monitorprovider.NewVersionMonitorProviderResource,
+		passphraseprovider.NewAmazonSecretsManagerPassphraseProviderResource,
+		passphraseprovider.NewAzureKeyVaultPassphraseProviderResource,
+		passphraseprovider.NewConjurPassphraseProviderResource,
+		passphraseprovider.NewDefaultAmazonSecretsManagerPassphraseProviderResource,
+		passphraseprovider.NewDefaultAzureKeyVaultPassphraseProviderResource,
+		passphraseprovider.NewDefaultConjurPassphraseProviderResource,
+		passphraseprovider.NewDefaultEnvironmentVariablePassphraseProviderResource,
+		passphraseprovider.NewDefaultFileBasedPassphraseProviderResource,
+		passphraseprovider.NewDefaultObscuredValuePassphraseProviderResource,
+		passphraseprovider.NewDefaultThirdPartyPassphraseProviderResource,
+		passphraseprovider.NewDefaultVaultPassphraseProviderResource,
+		passphraseprovider.NewEnvironmentVariablePassphraseProviderResource,
+		passphraseprovider.NewFileBasedPassphraseProviderResource,
+		passphraseprovider.NewObscuredValuePassphraseProviderResource,
+		passphraseprovider.NewThirdPartyPassphraseProviderResource,
+		passphraseprovider.NewVaultPassphraseProviderResource,
+		passwordgenerator.NewDefaultGroovyScriptedPasswordGeneratorResource,
+		passwordgenerator.NewDefaultPassphrasePasswordGeneratorResource,
+		passwordgenerator.NewDefaultRandomPasswordGeneratorResource,
+		passwordgenerator.NewDefaultThirdPartyPasswordGeneratorResource,
+		passwordgenerator.NewGroovyScriptedPasswordGeneratorResource,
+		passwordgenerator.NewPassphrasePasswordGeneratorResource,
+		passwordgenerator.NewRandomPasswordGeneratorResource,
+		passwordgenerator.NewThirdPartyPasswordGeneratorResource,
+		passwordstoragescheme.NewAes256PasswordStorageSchemeResource,
+		passwordstoragescheme.NewAesPasswordStorageSchemeResource,
+		passwordstoragescheme.NewAmazonSecretsManagerPasswordStorageSchemeResource,
+		passwordstoragescheme.NewArgon2PasswordStorageSchemeResource,
+		passwordstoragescheme.NewArgon2dPasswordStorageSchemeResource,
+		passwordstoragescheme.NewArgon2iPasswordStorageSchemeResource,
+		passwordstoragescheme.NewArgon2idPasswordStorageSchemeResource,
+		passwordstoragescheme.NewAzureKeyVaultPasswordStorageSchemeResource,
+		passwordstoragescheme.NewBase64PasswordStorageSchemeResource,
+		passwordstoragescheme.NewBcryptPasswordStorageSchemeResource,
+		passwordstoragescheme.NewBlowfishPasswordStorageSchemeResource,
+		passwordstoragescheme.NewClearPasswordStorageSchemeResource,
+		passwordstoragescheme.NewConjurPasswordStorageSchemeResource,
+		passwordstoragescheme.NewCryptPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultAes256PasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultAmazonSecretsManagerPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultArgon2PasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultArgon2dPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultArgon2iPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultArgon2idPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultAzureKeyVaultPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultBcryptPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultConjurPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultCryptPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultPbkdf2PasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultScryptPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultThirdPartyEnhancedPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultThirdPartyPasswordStorageSchemeResource,
+		passwordstoragescheme.NewDefaultVaultPasswordStorageSchemeResource,
+		passwordstoragescheme.NewMd5PasswordStorageSchemeResource,
+		passwordstoragescheme.NewPbkdf2PasswordStorageSchemeResource,
+		passwordstoragescheme.NewRc4PasswordStorageSchemeResource,
+		passwordstoragescheme.NewSaltedMd5PasswordStorageSchemeResource,
+		passwordstoragescheme.NewSaltedSha1PasswordStorageSchemeResource,
+		passwordstoragescheme.NewSaltedSha256PasswordStorageSchemeResource,
+		passwordstoragescheme.NewSaltedSha384PasswordStorageSchemeResource,
+		passwordstoragescheme.NewSaltedSha512PasswordStorageSchemeResource,
+		passwordstoragescheme.NewScryptPasswordStorageSchemeResource,
+		passwordstoragescheme.NewSha1PasswordStorageSchemeResource,
+		passwordstoragescheme.NewThirdPartyEnhancedPasswordStorageSchemeResource,
+		passwordstoragescheme.NewThirdPartyPasswordStorageSchemeResource,
+		passwordstoragescheme.NewTripleDesPasswordStorageSchemeResource,
+		passwordstoragescheme.NewVaultPasswordStorageSchemeResource,
+		passwordvalidator.NewAttributeValuePasswordValidatorResource,
+		passwordvalidator.NewCharacterSetPasswordValidatorResource,
+		passwordvalidator.NewCustomPasswordValidatorResource,
+		passwordvalidator.NewDefaultAttributeValuePasswordValidatorResource,
+		passwordvalidator.NewDefaultCharacterSetPasswordValidatorResource,
+		passwordvalidator.NewDefaultDictionaryPasswordValidatorResource,
+		passwordvalidator.NewDefaultGroovyScriptedPasswordValidatorResource,
+		passwordvalidator.NewDefaultHaystackPasswordValidatorResource,
+		passwordvalidator.NewDefaultLengthBasedPasswordValidatorResource,
+		passwordvalidator.NewDefaultPwnedPasswordsPasswordValidatorResource,
+		passwordvalidator.NewDefaultRegularExpressionPasswordValidatorResource,
+		passwordvalidator.NewDefaultRepeatedCharactersPasswordValidatorResource,
+		passwordvalidator.NewDefaultSimilarityBasedPasswordValidatorResource,
+		passwordvalidator.NewDefaultThirdPartyPasswordValidatorResource,
+		passwordvalidator.NewDefaultUniqueCharactersPasswordValidatorResource,
+		passwordvalidator.NewDictionaryPasswordValidatorResource,
+		passwordvalidator.NewGroovyScriptedPasswordValidatorResource,
+		passwordvalidator.NewHaystackPasswordValidatorResource,
+		passwordvalidator.NewLengthBasedPasswordValidatorResource,
+		passwordvalidator.NewPwnedPasswordsPasswordValidatorResource,
+		passwordvalidator.NewRegularExpressionPasswordValidatorResource,
+		passwordvalidator.NewRepeatedCharactersPasswordValidatorResource,
+		passwordvalidator.NewSimilarityBasedPasswordValidatorResource,
+		passwordvalidator.NewThirdPartyPasswordValidatorResource,
+		passwordvalidator.NewUniqueCharactersPasswordValidatorResource,
 		plugin.NewAttributeMapperPluginResource,
 		plugin.NewChangeSubscriptionNotificationPluginResource,
 		plugin.NewChangelogPasswordEncryptionPluginResource,
@@ -611,6 +1456,44 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		restresourcetype.NewGenericRestResourceTypeResource,
 		restresourcetype.NewGroupRestResourceTypeResource,
 		restresourcetype.NewUserRestResourceTypeResource,
+		resultcriteria.NewAggregateResultCriteriaResource,
+		resultcriteria.NewDefaultAggregateResultCriteriaResource,
+		resultcriteria.NewDefaultReplicationAssuranceResultCriteriaResource,
+		resultcriteria.NewDefaultSimpleResultCriteriaResource,
+		resultcriteria.NewDefaultThirdPartyResultCriteriaResource,
+		resultcriteria.NewReplicationAssuranceResultCriteriaResource,
+		resultcriteria.NewSimpleResultCriteriaResource,
+		resultcriteria.NewThirdPartyResultCriteriaResource,
+		saslmechanismhandler.NewAnonymousSaslMechanismHandlerResource,
+		saslmechanismhandler.NewCramMd5SaslMechanismHandlerResource,
+		saslmechanismhandler.NewDefaultOauthBearerSaslMechanismHandlerResource,
+		saslmechanismhandler.NewDefaultThirdPartySaslMechanismHandlerResource,
+		saslmechanismhandler.NewDefaultUnboundidDeliveredOtpSaslMechanismHandlerResource,
+		saslmechanismhandler.NewDefaultUnboundidMsChapV2SaslMechanismHandlerResource,
+		saslmechanismhandler.NewDigestMd5SaslMechanismHandlerResource,
+		saslmechanismhandler.NewExternalSaslMechanismHandlerResource,
+		saslmechanismhandler.NewGssapiSaslMechanismHandlerResource,
+		saslmechanismhandler.NewOauthBearerSaslMechanismHandlerResource,
+		saslmechanismhandler.NewPlainSaslMechanismHandlerResource,
+		saslmechanismhandler.NewThirdPartySaslMechanismHandlerResource,
+		saslmechanismhandler.NewUnboundidCertificatePlusPasswordSaslMechanismHandlerResource,
+		saslmechanismhandler.NewUnboundidDeliveredOtpSaslMechanismHandlerResource,
+		saslmechanismhandler.NewUnboundidExternalAuthSaslMechanismHandlerResource,
+		saslmechanismhandler.NewUnboundidMsChapV2SaslMechanismHandlerResource,
+		saslmechanismhandler.NewUnboundidTotpSaslMechanismHandlerResource,
+		saslmechanismhandler.NewUnboundidYubikeyOtpSaslMechanismHandlerResource,
+		searchentrycriteria.NewAggregateSearchEntryCriteriaResource,
+		searchentrycriteria.NewDefaultAggregateSearchEntryCriteriaResource,
+		searchentrycriteria.NewDefaultSimpleSearchEntryCriteriaResource,
+		searchentrycriteria.NewDefaultThirdPartySearchEntryCriteriaResource,
+		searchentrycriteria.NewSimpleSearchEntryCriteriaResource,
+		searchentrycriteria.NewThirdPartySearchEntryCriteriaResource,
+		searchreferencecriteria.NewAggregateSearchReferenceCriteriaResource,
+		searchreferencecriteria.NewDefaultAggregateSearchReferenceCriteriaResource,
+		searchreferencecriteria.NewDefaultSimpleSearchReferenceCriteriaResource,
+		searchreferencecriteria.NewDefaultThirdPartySearchReferenceCriteriaResource,
+		searchreferencecriteria.NewSimpleSearchReferenceCriteriaResource,
+		searchreferencecriteria.NewThirdPartySearchReferenceCriteriaResource,
 		serverinstance.NewAuthorizeServerInstanceResource,
 		serverinstance.NewDirectoryServerInstanceResource,
 		serverinstance.NewProxyServerInstanceResource,
@@ -659,5 +1542,12 @@ func (p *pingdirectoryProvider) Resources(_ context.Context) []func() resource.R
 		virtualattribute.NewSubschemaSubentryVirtualAttributeResource,
 		virtualattribute.NewThirdPartyVirtualAttributeResource,
 		virtualattribute.NewUserDefinedVirtualAttributeResource,
+		task.NewBackupTaskResource,
+		task.NewEnterLockdownModeTaskResource,
+		task.NewLdifExportTaskResource,
+		task.NewLdifImportTaskResource,
+		task.NewLeaveLockdownModeTaskResource,
+		task.NewRebuildIndexTaskResource,
+		task.NewReloadHttpConnectionHandlerCertificatesTaskResource,
 	}
 }
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/recurringtask"
+)
+
+// Plan creating a resource, and return the warnings reported while planning
+func planCreateWarnings(ctx context.Context, t *testing.T, providerServer tfprotov6.ProviderServer, typeName string,
+	resourceType tftypes.Object, values map[string]tftypes.Value) []string {
+	nullState, err := tfprotov6.NewDynamicValue(resourceType, tftypes.NewValue(resourceType, nil))
+	if err != nil {
+		t.Fatalf("Failed to build dynamic value: %s", err.Error())
+	}
+	plan := testDynamicValue(t, resourceType, values)
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &nullState,
+		ProposedNewState: plan,
+		Config:           plan,
+	})
+	if err != nil {
+		t.Fatalf("Failed to plan %s: %s", typeName, err.Error())
+	}
+	checkNoErrors(t, "plan", planResp.Diagnostics)
+	var warnings []string
+	for _, diagnostic := range planResp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityWarning {
+			warnings = append(warnings, diagnostic.Summary)
+		}
+	}
+	return warnings
+}
+
+// Test that a Recurring Task Chain only warns about missing recurring tasks that aren't planned in the same operation
+func TestRecurringTaskChainPlannedRecurringTask(t *testing.T) {
+	ctx := context.Background()
+	// None of the recurring tasks exist on the server
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	providerServer := configureTestProviderServer(ctx, t, server.URL)
+
+	var chainSchemaResp resource.SchemaResponse
+	config.NewRecurringTaskChainResource().Schema(ctx, resource.SchemaRequest{}, &chainSchemaResp)
+	chainType := chainSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	chainValues := map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "MyChain"),
+		"recurring_task": tftypes.NewValue(tftypes.List{ElementType: tftypes.String},
+			[]tftypes.Value{tftypes.NewValue(tftypes.String, "MyTask")}),
+		"scheduled_date_selection_type": tftypes.NewValue(tftypes.String, "every-day"),
+		"scheduled_time_of_day": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String},
+			[]tftypes.Value{tftypes.NewValue(tftypes.String, "01:30")}),
+	}
+	var taskSchemaResp resource.SchemaResponse
+	recurringtask.NewDelayRecurringTaskResource().Schema(ctx, resource.SchemaRequest{}, &taskSchemaResp)
+	taskType := taskSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	taskValues := map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "MyTask"),
+		"sleep_duration": tftypes.NewValue(tftypes.String, "1 s"),
+	}
+
+	warnings := planCreateWarnings(ctx, t, providerServer, "pingdirectory_recurring_task_chain", chainType, chainValues)
+	if len(warnings) != 1 || warnings[0] != "Recurring task not found" {
+		t.Errorf("Expected a warning for a recurring task that isn't planned, found %v", warnings)
+	}
+
+	// Terraform plans the recurring task before a chain that references it
+	warnings = planCreateWarnings(ctx, t, providerServer, "pingdirectory_delay_recurring_task", taskType, taskValues)
+	if len(warnings) != 0 {
+		t.Errorf("Unexpected warnings when planning the recurring task: %v", warnings)
+	}
+	warnings = planCreateWarnings(ctx, t, providerServer, "pingdirectory_recurring_task_chain", chainType, chainValues)
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings for a recurring task planned in the same operation, found %v", warnings)
+	}
+
+	// Recurring tasks planned in an earlier operation aren't included
+	configureTestProvider(ctx, t, providerServer, server.URL)
+	warnings = planCreateWarnings(ctx, t, providerServer, "pingdirectory_recurring_task_chain", chainType, chainValues)
+	if len(warnings) != 1 || warnings[0] != "Recurring task not found" {
+		t.Errorf("Expected a warning for a recurring task planned in an earlier operation, found %v", warnings)
+	}
+}
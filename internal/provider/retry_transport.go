@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Upper bound on the delay between attempts, so that the doubling backoff or a Retry-After header
+// can't stall an apply indefinitely
+const maxRetryDelay = 30 * time.Second
+
+// retryTransport is an http.RoundTripper that retries requests to the PingDirectory server that fail
+// due to transient errors, such as the server restarting or being temporarily unavailable.
+type retryTransport struct {
+	base           http.RoundTripper
+	maxRetries     int64
+	backoff        time.Duration
+	requestTimeout time.Duration
+}
+
+// Body that cancels the context of a single request attempt when it is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// Whether the request can be safely repeated after any failure. Other requests are only retried
+// when it is known that the server did not process them.
+func isIdempotent(req *http.Request) bool {
+	return req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+}
+
+// Check if a failed request attempt should be retried, and return a description of the failure for logging
+func shouldRetry(req *http.Request, resp *http.Response, err error) (bool, string) {
+	if err != nil {
+		// The connection was refused, so the server never received the request
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return true, err.Error()
+		}
+		// The request may have been received, so only retry if it is safe to repeat
+		if isIdempotent(req) && (errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.DeadlineExceeded)) {
+			return true, err.Error()
+		}
+		return false, ""
+	}
+	// The server did not process the request
+	if resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode == http.StatusTooManyRequests {
+		return true, resp.Status
+	}
+	return false, ""
+}
+
+// Get the delay before the next attempt. Uses the Retry-After header if the server provided one,
+// otherwise the backoff doubles with each attempt. The delay never exceeds maxRetryDelay.
+func (t *retryTransport) retryDelay(attempt int64, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			if int64(seconds) >= int64(maxRetryDelay/time.Second) {
+				return maxRetryDelay
+			}
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	// Double the delay one step at a time, stopping at the cap before the duration can overflow
+	delay := t.backoff
+	for i := int64(0); i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+// Send a single request attempt, applying the request timeout if one is configured
+func (t *retryTransport) roundTripAttempt(req *http.Request) (*http.Response, error) {
+	if t.requestTimeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.requestTimeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := int64(0); ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil {
+			// The body was consumed by the previous attempt
+			if req.GetBody == nil {
+				return nil, errors.New("unable to retry " + req.Method + " request to " + req.URL.String() + ": request body cannot be re-read")
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.roundTripAttempt(attemptReq)
+		retry, reason := shouldRetry(req, resp, err)
+		// A canceled or expired parent context means the caller has given up
+		if !retry || attempt >= t.maxRetries || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := t.retryDelay(attempt, resp)
+		tflog.Warn(ctx, "Retrying "+req.Method+" request to "+req.URL.String()+" after failure: "+reason, map[string]interface{}{
+			"attempt":     attempt + 1,
+			"max_retries": t.maxRetries,
+			"delay":       delay.String(),
+		})
+		if resp != nil {
+			// Drain and close the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
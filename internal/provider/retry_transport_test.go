@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Build a retry transport with a short backoff, so that tests don't wait
+func testRetryTransport(maxRetries int64) *retryTransport {
+	return &retryTransport{
+		base:       http.DefaultTransport,
+		maxRetries: maxRetries,
+		backoff:    time.Millisecond,
+	}
+}
+
+// Handler that fails the first failures requests with the given status code, then succeeds.
+// The number of requests received is stored in count.
+func failingHandler(failures int32, statusCode int, count *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(count, 1) <= failures {
+			w.WriteHeader(statusCode)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// Handler that closes the connection without sending a response
+func closeConnectionHandler(count *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(count, 1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}
+}
+
+func TestRetryTransportRetriesServiceUnavailable(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(failingHandler(2, http.StatusServiceUnavailable, &count))
+	defer server.Close()
+
+	client := &http.Client{Transport: testRetryTransport(3)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status %d, found %d", http.StatusOK, resp.StatusCode)
+	}
+	if atomic.LoadInt32(&count) != 3 {
+		t.Errorf("Expected 3 requests, found %d", atomic.LoadInt32(&count))
+	}
+}
+
+func TestRetryTransportStopsAtMaxRetries(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(failingHandler(10, http.StatusServiceUnavailable, &count))
+	defer server.Close()
+
+	client := &http.Client{Transport: testRetryTransport(2)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status %d, found %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if atomic.LoadInt32(&count) != 3 {
+		t.Errorf("Expected 3 requests, found %d", atomic.LoadInt32(&count))
+	}
+}
+
+func TestRetryTransportDoesNotRetryOtherStatuses(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(failingHandler(1, http.StatusInternalServerError, &count))
+	defer server.Close()
+
+	client := &http.Client{Transport: testRetryTransport(3)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, found %d", http.StatusInternalServerError, resp.StatusCode)
+	}
+	if atomic.LoadInt32(&count) != 1 {
+		t.Errorf("Expected 1 request, found %d", atomic.LoadInt32(&count))
+	}
+}
+
+func TestRetryTransportRetriesConnectionRefused(t *testing.T) {
+	// Get the address of a server that is no longer listening
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	var attempts int32
+	transport := testRetryTransport(2)
+	transport.base = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client := &http.Client{Transport: transport}
+	resp, err := client.Post(url, "application/json", strings.NewReader("{}"))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected an error for a refused connection")
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("Expected 3 attempts, found %d", atomic.LoadInt32(&attempts))
+	}
+}
+
+func TestRetryTransportRetriesClosedConnectionForGet(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(closeConnectionHandler(&count))
+	defer server.Close()
+
+	transport := testRetryTransport(2)
+	// Use a new connection for each attempt, so that the standard library doesn't retry on its own
+	transport.base = &http.Transport{DisableKeepAlives: true}
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get(server.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected an error for a closed connection")
+	}
+	if atomic.LoadInt32(&count) != 3 {
+		t.Errorf("Expected 3 requests, found %d", atomic.LoadInt32(&count))
+	}
+}
+
+func TestRetryTransportDoesNotRetryClosedConnectionForPost(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(closeConnectionHandler(&count))
+	defer server.Close()
+
+	transport := testRetryTransport(2)
+	transport.base = &http.Transport{DisableKeepAlives: true}
+	client := &http.Client{Transport: transport}
+	// The server may have processed the request, so it must not be repeated
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected an error for a closed connection")
+	}
+	if atomic.LoadInt32(&count) != 1 {
+		t.Errorf("Expected 1 request, found %d", atomic.LoadInt32(&count))
+	}
+}
+
+func TestRetryTransportReplaysRequestBody(t *testing.T) {
+	var mutex sync.Mutex
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mutex.Lock()
+		bodies = append(bodies, string(body))
+		first := len(bodies) == 1
+		mutex.Unlock()
+		if first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: testRetryTransport(3)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"op":"add"}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	resp.Body.Close()
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(bodies) != 2 {
+		t.Fatalf("Expected 2 requests, found %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"op":"add"}` {
+			t.Errorf("Unexpected body for request %d: %s", i+1, body)
+		}
+	}
+}
+
+func TestRetryTransportRetryDelay(t *testing.T) {
+	transport := &retryTransport{backoff: time.Second}
+	expectedDelays := map[int64]time.Duration{
+		0:  time.Second,
+		1:  2 * time.Second,
+		4:  16 * time.Second,
+		5:  maxRetryDelay,
+		40: maxRetryDelay,
+		70: maxRetryDelay,
+	}
+	for attempt, expected := range expectedDelays {
+		if delay := transport.retryDelay(attempt, nil); delay != expected {
+			t.Errorf("Expected delay %s for attempt %d, found %s", expected, attempt, delay)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "5")
+	if delay := transport.retryDelay(0, resp); delay != 5*time.Second {
+		t.Errorf("Expected Retry-After delay of 5s, found %s", delay)
+	}
+	resp.Header.Set("Retry-After", "3600")
+	if delay := transport.retryDelay(0, resp); delay != maxRetryDelay {
+		t.Errorf("Expected Retry-After delay to be capped at %s, found %s", maxRetryDelay, delay)
+	}
+}
+
+// Adapter to use a function as an http.RoundTripper
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
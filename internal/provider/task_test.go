@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/task"
+)
+
+// Test that changing only the wait timeout of a task plans an in-place update that keeps the results of the task
+func TestTaskWaitTimeoutUpdatePlan(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	providerServer := configureTestProviderServer(ctx, t, server.URL)
+
+	var resourceSchemaResp resource.SchemaResponse
+	task.NewRebuildIndexTaskResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resourceType := resourceSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := map[string]tftypes.Value{
+		"base_dn":      tftypes.NewValue(tftypes.String, "dc=example,dc=com"),
+		"index":        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "uid")}),
+		"wait_timeout": tftypes.NewValue(tftypes.String, "10m"),
+	}
+	stateValues := map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "rebuild-index-task"),
+		"task_state": tftypes.NewValue(tftypes.String, "completed-successfully"),
+		"log_messages": tftypes.NewValue(tftypes.List{ElementType: tftypes.String},
+			[]tftypes.Value{tftypes.NewValue(tftypes.String, "Rebuild complete")}),
+	}
+	for name, value := range configValues {
+		stateValues[name] = value
+	}
+
+	// Terraform proposes the prior values of computed attributes that aren't set in the config
+	configValues["wait_timeout"] = tftypes.NewValue(tftypes.String, "20m")
+	proposedValues := map[string]tftypes.Value{}
+	for name, value := range stateValues {
+		proposedValues[name] = value
+	}
+	proposedValues["wait_timeout"] = configValues["wait_timeout"]
+
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "pingdirectory_rebuild_index_task",
+		PriorState:       testDynamicValue(t, resourceType, stateValues),
+		ProposedNewState: testDynamicValue(t, resourceType, proposedValues),
+		Config:           testDynamicValue(t, resourceType, configValues),
+	})
+	if err != nil {
+		t.Fatalf("Failed to plan resource change: %s", err.Error())
+	}
+	checkNoErrors(t, "plan", planResp.Diagnostics)
+	if len(planResp.RequiresReplace) > 0 {
+		t.Errorf("Expected an in-place update, found replacement required for %v", planResp.RequiresReplace)
+	}
+	plannedState, err := planResp.PlannedState.Unmarshal(resourceType)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal planned state: %s", err.Error())
+	}
+	var plannedValues map[string]tftypes.Value
+	if err = plannedState.As(&plannedValues); err != nil {
+		t.Fatalf("Failed to read planned state: %s", err.Error())
+	}
+	for _, name := range []string{"id", "task_state", "log_messages"} {
+		if !plannedValues[name].Equal(stateValues[name]) {
+			t.Errorf("Expected planned %s to be kept from state, found %s", name, plannedValues[name].String())
+		}
+	}
+	if !plannedValues["wait_timeout"].Equal(configValues["wait_timeout"]) {
+		t.Errorf("Expected planned wait_timeout to be updated, found %s", plannedValues["wait_timeout"].String())
+	}
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config/trustmanagerprovider"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
+)
+
+const (
+	testTrustManagerProviderId    = "MyId"
+	blindTrustManagerProviderJson = `{"schemas":["urn:pingidentity:schemas:configuration:2.0:trust-manager-provider:blind"],` +
+		`"id":"MyId","enabled":true}`
+	fileBasedTrustManagerProviderJson = `{"schemas":["urn:pingidentity:schemas:configuration:2.0:trust-manager-provider:file-based"],` +
+		`"id":"MyId","enabled":true,"trustStoreFile":"config/truststore"}`
+)
+
+// Config API server that returns a trust manager provider with the given JSON body
+type trustManagerProviderServer struct {
+	mutex sync.Mutex
+	body  string
+}
+
+func (s *trustManagerProviderServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasSuffix(r.URL.Path, "/trust-manager-providers/"+testTrustManagerProviderId) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(s.body))
+}
+
+func (s *trustManagerProviderServer) setBody(body string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.body = body
+}
+
+// Build a DynamicValue for an object type, with the given attribute values and all other attributes null
+func testDynamicValue(t *testing.T, objectType tftypes.Object, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	dynamicValue, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatalf("Failed to build dynamic value: %s", err.Error())
+	}
+	return &dynamicValue
+}
+
+func checkNoErrors(t *testing.T, operation string, diagnostics []*tfprotov6.Diagnostic) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("Unexpected error during %s: %s: %s", operation, diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
+
+func findDiagnostic(diagnostics []*tfprotov6.Diagnostic, severity tfprotov6.DiagnosticSeverity, detailPart string) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == severity && diagnostic.Summary == "Mismatched config object type" &&
+			strings.Contains(diagnostic.Detail, detailPart) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get a provider server configured to use the given test Config API server
+func configureTestProviderServer(ctx context.Context, t *testing.T, serverUrl string) tfprotov6.ProviderServer {
+	providerServer, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatalf("Failed to create provider server: %s", err.Error())
+	}
+
+	// Terraform always reads the schemas first, which also sets the resource type names
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Failed to get provider schema: %s", err.Error())
+	}
+	checkNoErrors(t, "get provider schema", schemaResp.Diagnostics)
+	configureTestProvider(ctx, t, providerServer, serverUrl)
+	return providerServer
+}
+
+// Configure the provider to use the given test Config API server. Terraform configures the provider at the
+// start of each operation.
+func configureTestProvider(ctx context.Context, t *testing.T, providerServer tfprotov6.ProviderServer, serverUrl string) {
+	var providerSchemaResp provider.SchemaResponse
+	New().Schema(ctx, provider.SchemaRequest{}, &providerSchemaResp)
+	providerType := providerSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: testDynamicValue(t, providerType, map[string]tftypes.Value{
+			"https_host":      tftypes.NewValue(tftypes.String, serverUrl),
+			"username":        tftypes.NewValue(tftypes.String, "cn=administrator"),
+			"password":        tftypes.NewValue(tftypes.String, "password"),
+			"product_version": tftypes.NewValue(tftypes.String, version.PingDirectory9200),
+			"max_retries":     tftypes.NewValue(tftypes.Number, 0),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Failed to configure provider: %s", err.Error())
+	}
+	checkNoErrors(t, "configure", configureResp.Diagnostics)
+}
+
+// Get the value of the provider's config_object_type private state key
+func configObjectTypePrivateState(t *testing.T, private []byte) string {
+	if len(private) == 0 {
+		return ""
+	}
+	var privateData map[string][]byte
+	if err := json.Unmarshal(private, &privateData); err != nil {
+		t.Fatalf("Failed to unmarshal private state: %s", err.Error())
+	}
+	return string(privateData["config_object_type"])
+}
+
+// Test that a config object replaced with one of a different type outside of Terraform plans a replacement,
+// and that importing a config object of a different type fails
+func TestResourceTypeMismatch(t *testing.T) {
+	ctx := context.Background()
+	configServer := &trustManagerProviderServer{body: fileBasedTrustManagerProviderJson}
+	server := httptest.NewServer(configServer)
+	defer server.Close()
+
+	providerServer := configureTestProviderServer(ctx, t, server.URL)
+
+	var resourceSchemaResp resource.SchemaResponse
+	trustmanagerprovider.NewBlindTrustManagerProviderResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resourceType := resourceSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, testTrustManagerProviderId),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+	}
+	stateValues := map[string]tftypes.Value{
+		"id":           configValues["id"],
+		"enabled":      configValues["enabled"],
+		"last_updated": tftypes.NewValue(tftypes.String, "Sunday, 18-Oct-26 09:00:00 UTC"),
+	}
+	state := testDynamicValue(t, resourceType, stateValues)
+
+	// Refreshing a resource that was created by Terraform keeps it in state, and marks the type mismatch
+	readResp, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     "pingdirectory_blind_trust_manager_provider",
+		CurrentState: state,
+	})
+	if err != nil {
+		t.Fatalf("Failed to read resource: %s", err.Error())
+	}
+	checkNoErrors(t, "read", readResp.Diagnostics)
+	if !findDiagnostic(readResp.Diagnostics, tfprotov6.DiagnosticSeverityWarning, "is a file-based trust manager provider") {
+		t.Errorf("Expected a mismatched type warning when reading, found %v", readResp.Diagnostics)
+	}
+	if readResp.NewState == nil {
+		t.Fatal("Expected the resource to be kept in state")
+	}
+	if !strings.Contains(configObjectTypePrivateState(t, readResp.Private), "mismatch_detail") {
+		t.Fatalf("Expected the mismatch to be recorded in private state, found '%s'", string(readResp.Private))
+	}
+
+	// Planning with the refreshed state replaces the resource
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "pingdirectory_blind_trust_manager_provider",
+		PriorState:       readResp.NewState,
+		ProposedNewState: readResp.NewState,
+		Config:           testDynamicValue(t, resourceType, configValues),
+		PriorPrivate:     readResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("Failed to plan resource change: %s", err.Error())
+	}
+	checkNoErrors(t, "plan", planResp.Diagnostics)
+	replaceLastUpdated := false
+	for _, attributePath := range planResp.RequiresReplace {
+		if attributePath.Equal(tftypes.NewAttributePath().WithAttributeName("last_updated")) {
+			replaceLastUpdated = true
+		}
+	}
+	if !replaceLastUpdated {
+		t.Errorf("Expected the plan to require replacement, found %v", planResp.RequiresReplace)
+	}
+
+	// Default resources can't create a replacement config object
+	planResp, err = providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "pingdirectory_default_blind_trust_manager_provider",
+		PriorState:       readResp.NewState,
+		ProposedNewState: readResp.NewState,
+		Config:           testDynamicValue(t, resourceType, configValues),
+		PriorPrivate:     readResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("Failed to plan resource change: %s", err.Error())
+	}
+	if !findDiagnostic(planResp.Diagnostics, tfprotov6.DiagnosticSeverityError, "terraform state rm") {
+		t.Errorf("Expected a mismatched type error when planning a default resource, found %v", planResp.Diagnostics)
+	}
+
+	// Reading the expected type again clears the mismatch
+	configServer.setBody(blindTrustManagerProviderJson)
+	readResp, err = providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     "pingdirectory_blind_trust_manager_provider",
+		CurrentState: state,
+		Private:      readResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("Failed to read resource: %s", err.Error())
+	}
+	checkNoErrors(t, "read", readResp.Diagnostics)
+	if strings.Contains(configObjectTypePrivateState(t, readResp.Private), "mismatch_detail") {
+		t.Errorf("Expected the mismatch to be cleared from private state, found '%s'", string(readResp.Private))
+	}
+
+	// Importing a config object of a different type fails
+	configServer.setBody(fileBasedTrustManagerProviderJson)
+	importResp, err := providerServer.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: "pingdirectory_blind_trust_manager_provider",
+		ID:       testTrustManagerProviderId,
+	})
+	if err != nil {
+		t.Fatalf("Failed to import resource: %s", err.Error())
+	}
+	checkNoErrors(t, "import", importResp.Diagnostics)
+	if len(importResp.ImportedResources) != 1 {
+		t.Fatalf("Expected 1 imported resource, found %d", len(importResp.ImportedResources))
+	}
+	readResp, err = providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     "pingdirectory_blind_trust_manager_provider",
+		CurrentState: importResp.ImportedResources[0].State,
+		Private:      importResp.ImportedResources[0].Private,
+	})
+	if err != nil {
+		t.Fatalf("Failed to read resource: %s", err.Error())
+	}
+	if !findDiagnostic(readResp.Diagnostics, tfprotov6.DiagnosticSeverityError, "Use the pingdirectory_file_based_trust_manager_provider resource") {
+		t.Errorf("Expected a mismatched type error when importing, found %v", readResp.Diagnostics)
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/version"
+)
+
+// Description suffix used for resources and attributes that are not available on earlier PingDirectory versions
+const supportedIn9200Description = "Supported in PingDirectory product version " + version.PingDirectory9200 + "+"
+
+// Every resource and attribute that is only supported in PingDirectory 9.2 must be rejected
+// in ModifyPlan when the provider is configured for PingDirectory 9.1, so that 9.2-only
+// properties are never sent to a 9.1 server.
+func TestVersionRestrictionsRejectedOnEarlierVersion(t *testing.T) {
+	ctx := context.Background()
+	resourceConfig := internaltypes.ResourceConfiguration{
+		ProviderConfig: internaltypes.ProviderConfiguration{
+			ProductVersion: version.PingDirectory9100,
+		},
+	}
+
+	checkedAttributes := 0
+	for _, newResource := range New().Resources(ctx) {
+		res := newResource()
+		var metadataResp resource.MetadataResponse
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "pingdirectory"}, &metadataResp)
+		var schemaResp resource.SchemaResponse
+		res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		resourceRestricted := strings.Contains(schemaResp.Schema.Description, supportedIn9200Description)
+
+		var restrictedAttributes []string
+		for name, attribute := range schemaResp.Schema.Attributes {
+			if strings.Contains(attribute.GetDescription(), supportedIn9200Description) {
+				restrictedAttributes = append(restrictedAttributes, name)
+			}
+		}
+		if !resourceRestricted && len(restrictedAttributes) == 0 {
+			continue
+		}
+
+		modifyPlanResource, ok := res.(resource.ResourceWithModifyPlan)
+		if !ok {
+			t.Errorf("%s has version restrictions but does not implement ModifyPlan", metadataResp.TypeName)
+			continue
+		}
+		if configurableResource, ok := res.(resource.ResourceWithConfigure); ok {
+			configurableResource.Configure(ctx, resource.ConfigureRequest{ProviderData: resourceConfig}, &resource.ConfigureResponse{})
+		}
+
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		if resourceRestricted {
+			resp := modifyPlan(ctx, t, modifyPlanResource, schemaResp, objectType, "")
+			if !resp.Diagnostics.HasError() {
+				t.Errorf("Expected %s to be rejected for PingDirectory version %s", metadataResp.TypeName, version.PingDirectory9100)
+			}
+			continue
+		}
+		for _, name := range restrictedAttributes {
+			checkedAttributes++
+			resp := modifyPlan(ctx, t, modifyPlanResource, schemaResp, objectType, name)
+			if !diagnosticsContain(resp, "'"+name+"'") {
+				t.Errorf("Expected %s attribute '%s' to be rejected for PingDirectory version %s", metadataResp.TypeName, name, version.PingDirectory9100)
+			}
+		}
+	}
+	if checkedAttributes == 0 {
+		t.Errorf("Expected at least one attribute restricted to PingDirectory version %s", version.PingDirectory9200)
+	}
+}
+
+// Run ModifyPlan with a plan where only the given attribute is set. All other attributes are null.
+func modifyPlan(ctx context.Context, t *testing.T, res resource.ResourceWithModifyPlan, schemaResp resource.SchemaResponse, objectType tftypes.Object, attributeName string) *resource.ModifyPlanResponse {
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if name == attributeName {
+			values[name] = testValue(t, name, attributeType)
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+	req := resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	res.ModifyPlan(ctx, req, resp)
+	return resp
+}
+
+// Get a non-empty value of the given type
+func testValue(t *testing.T, name string, attributeType tftypes.Type) tftypes.Value {
+	switch {
+	case attributeType.Is(tftypes.String):
+		return tftypes.NewValue(attributeType, "value")
+	case attributeType.Is(tftypes.Number):
+		return tftypes.NewValue(attributeType, big.NewFloat(1))
+	case attributeType.Is(tftypes.Bool):
+		return tftypes.NewValue(attributeType, true)
+	case attributeType.Is(tftypes.Set{ElementType: tftypes.String}):
+		return tftypes.NewValue(attributeType, []tftypes.Value{tftypes.NewValue(tftypes.String, "value")})
+	case attributeType.Is(tftypes.List{ElementType: tftypes.String}):
+		return tftypes.NewValue(attributeType, []tftypes.Value{tftypes.NewValue(tftypes.String, "value")})
+	}
+	t.Fatalf("Unsupported type %s for attribute '%s'", attributeType.String(), name)
+	return tftypes.Value{}
+}
+
+func diagnosticsContain(resp *resource.ModifyPlanResponse, summaryPart string) bool {
+	for _, diagnostic := range resp.Diagnostics.Errors() {
+		if strings.Contains(diagnostic.Summary(), summaryPart) {
+			return true
+		}
+	}
+	return false
+}
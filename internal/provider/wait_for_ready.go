@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Default settings for waiting for the PingDirectory server to become available
+const (
+	defaultAvailabilityStatePath = "/available-state"
+	waitForReadyPollInterval     = 2 * time.Second
+)
+
+// Poll the availability state endpoint of the PingDirectory server until it reports that the server is available,
+// or until the timeout expires. The endpoint is provided by an Availability State HTTP Servlet Extension, which
+// returns a 200 response when the server is available.
+func waitForReady(ctx context.Context, httpClient *http.Client, url string, timeout, pollInterval time.Duration) error {
+	tflog.Info(ctx, "Waiting up to "+timeout.String()+" for the PingDirectory server to become available at "+url)
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lastStatus := "no response"
+	timeoutError := func() error {
+		return errors.New("the PingDirectory server did not become available within " + timeout.String() + ", last result: " + lastStatus)
+	}
+	for {
+		req, err := http.NewRequestWithContext(waitCtx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				// The request was cancelled by the timeout, so keep the result of the previous attempt
+				return timeoutError()
+			}
+			lastStatus = err.Error()
+		} else {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				tflog.Info(ctx, "PingDirectory server is available")
+				return nil
+			}
+			lastStatus = "HTTP status " + strconv.Itoa(resp.StatusCode)
+		}
+		tflog.Debug(ctx, "PingDirectory server is not yet available: "+lastStatus)
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-waitCtx.Done():
+			timer.Stop()
+			return timeoutError()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForReadySucceedsAfterUnavailable(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(failingHandler(3, http.StatusServiceUnavailable, &count))
+	defer server.Close()
+
+	err := waitForReady(context.Background(), server.Client(), server.URL+defaultAvailabilityStatePath, 10*time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if atomic.LoadInt32(&count) != 4 {
+		t.Errorf("Expected 4 requests, found %d", atomic.LoadInt32(&count))
+	}
+}
+
+func TestWaitForReadySucceedsAfterConnectionRefused(t *testing.T) {
+	var attempts int32
+	var count int32
+	server := httptest.NewServer(failingHandler(0, http.StatusServiceUnavailable, &count))
+	defer server.Close()
+
+	// Refuse the first two connections, as if the server was still starting
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			return nil, errors.New("connection refused")
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	err := waitForReady(context.Background(), httpClient, server.URL, 10*time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("Expected 3 attempts, found %d", atomic.LoadInt32(&attempts))
+	}
+}
+
+func TestWaitForReadyTimeoutReportsLastStatus(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(failingHandler(1000000, http.StatusServiceUnavailable, &count))
+	defer server.Close()
+
+	err := waitForReady(context.Background(), server.Client(), server.URL, 100*time.Millisecond, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("Expected an error when the server does not become available")
+	}
+	if !strings.Contains(err.Error(), "did not become available within 100ms") {
+		t.Errorf("Expected the error to report the timeout, found: %s", err.Error())
+	}
+	if !strings.Contains(err.Error(), "last result: HTTP status 503") {
+		t.Errorf("Expected the error to report the last status, found: %s", err.Error())
+	}
+	if atomic.LoadInt32(&count) < 2 {
+		t.Errorf("Expected the endpoint to be polled more than once, found %d requests", atomic.LoadInt32(&count))
+	}
+}
+
+func TestWaitForReadyTimeoutDuringRequestReportsLastStatus(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		// Hang until the client gives up
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	err := waitForReady(context.Background(), server.Client(), server.URL, 100*time.Millisecond, time.Millisecond)
+	if err == nil {
+		t.Fatal("Expected an error when the server does not become available")
+	}
+	// A request cancelled by the timeout doesn't replace the result of the previous attempt
+	if !strings.Contains(err.Error(), "last result: HTTP status 503") {
+		t.Errorf("Expected the error to report the last status, found: %s", err.Error())
+	}
+}
@@ -0,0 +1,86 @@
+package accesscontrolhandler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dseeCompatAccessControlHandlerDataSource{}
+	_ datasource.DataSourceWithConfigure = &dseeCompatAccessControlHandlerDataSource{}
+)
+
+// Create a Dsee Compat Access Control Handler data source
+func NewDseeCompatAccessControlHandlerDataSource() datasource.DataSource {
+	return &dseeCompatAccessControlHandlerDataSource{}
+}
+
+// dseeCompatAccessControlHandlerDataSource is the datasource implementation.
+type dseeCompatAccessControlHandlerDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *dseeCompatAccessControlHandlerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dsee_compat_access_control_handler"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *dseeCompatAccessControlHandlerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *dseeCompatAccessControlHandlerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var resourceSchemaResp resource.SchemaResponse
+	(&dseeCompatAccessControlHandlerResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Schema = config.ToDataSourceSchema(resourceSchemaResp.Schema, nil)
+}
+
+// Read resource information
+func (r *dseeCompatAccessControlHandlerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current config
+	var state dseeCompatAccessControlHandlerResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.AccessControlHandlerApi.GetAccessControlHandler(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dsee Compat Access Control Handler", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readDseeCompatAccessControlHandlerResponse(ctx, readResponse, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -185,6 +185,7 @@ func (r *dseeCompatAccessControlHandlerResource) Create(ctx context.Context, req
 
 		// Read the response
 		readDseeCompatAccessControlHandlerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -209,7 +210,12 @@ func (r *dseeCompatAccessControlHandlerResource) Read(ctx context.Context, req r
 	readResponse, httpResp, err := r.apiClient.AccessControlHandlerApi.GetAccessControlHandler(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dsee Compat Access Control Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Dsee Compat Access Control Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dsee Compat Access Control Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -267,6 +273,7 @@ func (r *dseeCompatAccessControlHandlerResource) Update(ctx context.Context, req
 
 		// Read the response
 		readDseeCompatAccessControlHandlerResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
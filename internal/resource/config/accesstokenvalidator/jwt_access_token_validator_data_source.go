@@ -0,0 +1,92 @@
+package accesstokenvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &jwtAccessTokenValidatorDataSource{}
+	_ datasource.DataSourceWithConfigure = &jwtAccessTokenValidatorDataSource{}
+)
+
+// Create a Jwt Access Token Validator data source
+func NewJwtAccessTokenValidatorDataSource() datasource.DataSource {
+	return &jwtAccessTokenValidatorDataSource{}
+}
+
+// jwtAccessTokenValidatorDataSource is the datasource implementation.
+type jwtAccessTokenValidatorDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *jwtAccessTokenValidatorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_access_token_validator"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *jwtAccessTokenValidatorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *jwtAccessTokenValidatorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var resourceSchemaResp resource.SchemaResponse
+	jwtAccessTokenValidatorSchema(ctx, resource.SchemaRequest{}, &resourceSchemaResp, false)
+	resp.Schema = config.ToDataSourceSchema(resourceSchemaResp.Schema, []string{"id"})
+}
+
+// Read resource information
+func (r *jwtAccessTokenValidatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the config values used to identify the config object
+	var state jwtAccessTokenValidatorResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.AccessTokenValidatorApi.GetAccessTokenValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jwt Access Token Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.JwtAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jwt Access Token Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the response into the state
+	readJwtAccessTokenValidatorResponse(ctx, readResponse.JwtAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
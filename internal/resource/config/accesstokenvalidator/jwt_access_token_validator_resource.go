@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &jwtAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &jwtAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &jwtAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &jwtAccessTokenValidatorResource{}
 	_ resource.Resource                = &defaultJwtAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultJwtAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultJwtAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJwtAccessTokenValidatorResource{}
 )
 
 // Create a Jwt Access Token Validator resource
@@ -241,6 +243,15 @@ func jwtAccessTokenValidatorSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jwtAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJwtAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJwtAccessTokenValidatorFields(ctx context.Context, addRequest *client.AddJwtAccessTokenValidatorRequest, plan jwtAccessTokenValidatorResourceModel) error {
 	if internaltypes.IsDefined(plan.AllowedSigningAlgorithm) {
@@ -429,6 +440,7 @@ func (r *jwtAccessTokenValidatorResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state jwtAccessTokenValidatorResourceModel
 	readJwtAccessTokenValidatorResponse(ctx, addResponse.JwtAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -467,6 +479,12 @@ func (r *defaultJwtAccessTokenValidatorResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JwtAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jwt Access Token Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jwtAccessTokenValidatorResourceModel
 	readJwtAccessTokenValidatorResponse(ctx, readResponse.JwtAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
@@ -493,6 +511,7 @@ func (r *defaultJwtAccessTokenValidatorResource) Create(ctx context.Context, req
 
 		// Read the response
 		readJwtAccessTokenValidatorResponse(ctx, updateResponse.JwtAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -525,7 +544,12 @@ func readJwtAccessTokenValidator(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.AccessTokenValidatorApi.GetAccessTokenValidator(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jwt Access Token Validator", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Jwt Access Token Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jwt Access Token Validator", err, httpResp)
+		}
 		return
 	}
 
@@ -535,6 +559,13 @@ func readJwtAccessTokenValidator(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JwtAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Jwt Access Token Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJwtAccessTokenValidatorResponse(ctx, readResponse.JwtAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
 
@@ -591,6 +622,7 @@ func updateJwtAccessTokenValidator(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readJwtAccessTokenValidatorResponse(ctx, updateResponse.JwtAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -639,4 +671,5 @@ func (r *defaultJwtAccessTokenValidatorResource) ImportState(ctx context.Context
 func importJwtAccessTokenValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,92 @@
+package accesstokenvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mockAccessTokenValidatorDataSource{}
+	_ datasource.DataSourceWithConfigure = &mockAccessTokenValidatorDataSource{}
+)
+
+// Create a Mock Access Token Validator data source
+func NewMockAccessTokenValidatorDataSource() datasource.DataSource {
+	return &mockAccessTokenValidatorDataSource{}
+}
+
+// mockAccessTokenValidatorDataSource is the datasource implementation.
+type mockAccessTokenValidatorDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *mockAccessTokenValidatorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mock_access_token_validator"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *mockAccessTokenValidatorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *mockAccessTokenValidatorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var resourceSchemaResp resource.SchemaResponse
+	mockAccessTokenValidatorSchema(ctx, resource.SchemaRequest{}, &resourceSchemaResp, false)
+	resp.Schema = config.ToDataSourceSchema(resourceSchemaResp.Schema, []string{"id"})
+}
+
+// Read resource information
+func (r *mockAccessTokenValidatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the config values used to identify the config object
+	var state mockAccessTokenValidatorResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.AccessTokenValidatorApi.GetAccessTokenValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Mock Access Token Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.MockAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Mock Access Token Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the response into the state
+	readMockAccessTokenValidatorResponse(ctx, readResponse.MockAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
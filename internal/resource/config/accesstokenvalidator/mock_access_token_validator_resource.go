@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &mockAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &mockAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &mockAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &mockAccessTokenValidatorResource{}
 	_ resource.Resource                = &defaultMockAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultMockAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultMockAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultMockAccessTokenValidatorResource{}
 )
 
 // Create a Mock Access Token Validator resource
@@ -164,6 +166,15 @@ func mockAccessTokenValidatorSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *mockAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultMockAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalMockAccessTokenValidatorFields(ctx context.Context, addRequest *client.AddMockAccessTokenValidatorRequest, plan mockAccessTokenValidatorResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -262,6 +273,7 @@ func (r *mockAccessTokenValidatorResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state mockAccessTokenValidatorResourceModel
 	readMockAccessTokenValidatorResponse(ctx, addResponse.MockAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -300,6 +312,12 @@ func (r *defaultMockAccessTokenValidatorResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MockAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Mock Access Token Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state mockAccessTokenValidatorResourceModel
 	readMockAccessTokenValidatorResponse(ctx, readResponse.MockAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
@@ -326,6 +344,7 @@ func (r *defaultMockAccessTokenValidatorResource) Create(ctx context.Context, re
 
 		// Read the response
 		readMockAccessTokenValidatorResponse(ctx, updateResponse.MockAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -358,7 +377,12 @@ func readMockAccessTokenValidator(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.AccessTokenValidatorApi.GetAccessTokenValidator(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Mock Access Token Validator", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Mock Access Token Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Mock Access Token Validator", err, httpResp)
+		}
 		return
 	}
 
@@ -368,6 +392,13 @@ func readMockAccessTokenValidator(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MockAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Mock Access Token Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMockAccessTokenValidatorResponse(ctx, readResponse.MockAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
 
@@ -424,6 +455,7 @@ func updateMockAccessTokenValidator(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readMockAccessTokenValidatorResponse(ctx, updateResponse.MockAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -472,4 +504,5 @@ func (r *defaultMockAccessTokenValidatorResource) ImportState(ctx context.Contex
 func importMockAccessTokenValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
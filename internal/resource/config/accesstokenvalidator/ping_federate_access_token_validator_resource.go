@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &pingFederateAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &pingFederateAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &pingFederateAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &pingFederateAccessTokenValidatorResource{}
 	_ resource.Resource                = &defaultPingFederateAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultPingFederateAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultPingFederateAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPingFederateAccessTokenValidatorResource{}
 )
 
 // Create a Ping Federate Access Token Validator resource
@@ -199,6 +201,15 @@ func pingFederateAccessTokenValidatorSchema(ctx context.Context, req resource.Sc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *pingFederateAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPingFederateAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPingFederateAccessTokenValidatorFields(ctx context.Context, addRequest *client.AddPingFederateAccessTokenValidatorRequest, plan pingFederateAccessTokenValidatorResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -330,6 +341,7 @@ func (r *pingFederateAccessTokenValidatorResource) Create(ctx context.Context, r
 	// Read the response into the state
 	var state pingFederateAccessTokenValidatorResourceModel
 	readPingFederateAccessTokenValidatorResponse(ctx, addResponse.PingFederateAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -368,6 +380,12 @@ func (r *defaultPingFederateAccessTokenValidatorResource) Create(ctx context.Con
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingFederateAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ping Federate Access Token Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state pingFederateAccessTokenValidatorResourceModel
 	readPingFederateAccessTokenValidatorResponse(ctx, readResponse.PingFederateAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
@@ -394,6 +412,7 @@ func (r *defaultPingFederateAccessTokenValidatorResource) Create(ctx context.Con
 
 		// Read the response
 		readPingFederateAccessTokenValidatorResponse(ctx, updateResponse.PingFederateAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -426,7 +445,12 @@ func readPingFederateAccessTokenValidator(ctx context.Context, req resource.Read
 	readResponse, httpResp, err := apiClient.AccessTokenValidatorApi.GetAccessTokenValidator(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping Federate Access Token Validator", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ping Federate Access Token Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping Federate Access Token Validator", err, httpResp)
+		}
 		return
 	}
 
@@ -436,6 +460,13 @@ func readPingFederateAccessTokenValidator(ctx context.Context, req resource.Read
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingFederateAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ping Federate Access Token Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPingFederateAccessTokenValidatorResponse(ctx, readResponse.PingFederateAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
 
@@ -492,6 +523,7 @@ func updatePingFederateAccessTokenValidator(ctx context.Context, req resource.Up
 
 		// Read the response
 		readPingFederateAccessTokenValidatorResponse(ctx, updateResponse.PingFederateAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -540,4 +572,5 @@ func (r *defaultPingFederateAccessTokenValidatorResource) ImportState(ctx contex
 func importPingFederateAccessTokenValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
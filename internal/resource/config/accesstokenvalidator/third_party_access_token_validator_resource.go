@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &thirdPartyAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyAccessTokenValidatorResource{}
 	_ resource.Resource                = &defaultThirdPartyAccessTokenValidatorResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyAccessTokenValidatorResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyAccessTokenValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyAccessTokenValidatorResource{}
 )
 
 // Create a Third Party Access Token Validator resource
@@ -157,6 +159,15 @@ func thirdPartyAccessTokenValidatorSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyAccessTokenValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyAccessTokenValidatorFields(ctx context.Context, addRequest *client.AddThirdPartyAccessTokenValidatorRequest, plan thirdPartyAccessTokenValidatorResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -248,6 +259,7 @@ func (r *thirdPartyAccessTokenValidatorResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state thirdPartyAccessTokenValidatorResourceModel
 	readThirdPartyAccessTokenValidatorResponse(ctx, addResponse.ThirdPartyAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -286,6 +298,12 @@ func (r *defaultThirdPartyAccessTokenValidatorResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Access Token Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyAccessTokenValidatorResourceModel
 	readThirdPartyAccessTokenValidatorResponse(ctx, readResponse.ThirdPartyAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
@@ -312,6 +330,7 @@ func (r *defaultThirdPartyAccessTokenValidatorResource) Create(ctx context.Conte
 
 		// Read the response
 		readThirdPartyAccessTokenValidatorResponse(ctx, updateResponse.ThirdPartyAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -344,7 +363,12 @@ func readThirdPartyAccessTokenValidator(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.AccessTokenValidatorApi.GetAccessTokenValidator(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Access Token Validator", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Access Token Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Access Token Validator", err, httpResp)
+		}
 		return
 	}
 
@@ -354,6 +378,13 @@ func readThirdPartyAccessTokenValidator(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyAccessTokenValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Access Token Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyAccessTokenValidatorResponse(ctx, readResponse.ThirdPartyAccessTokenValidatorResponse, &state, &state, &resp.Diagnostics)
 
@@ -410,6 +441,7 @@ func updateThirdPartyAccessTokenValidator(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readThirdPartyAccessTokenValidatorResponse(ctx, updateResponse.ThirdPartyAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -458,4 +490,5 @@ func (r *defaultThirdPartyAccessTokenValidatorResource) ImportState(ctx context.
 func importThirdPartyAccessTokenValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &adminAlertAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &adminAlertAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &adminAlertAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &adminAlertAccountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultAdminAlertAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultAdminAlertAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultAdminAlertAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAdminAlertAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Admin Alert Account Status Notification Handler resource
@@ -152,6 +154,15 @@ func adminAlertAccountStatusNotificationHandlerSchema(ctx context.Context, req r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *adminAlertAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAdminAlertAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAdminAlertAccountStatusNotificationHandlerFields(ctx context.Context, addRequest *client.AddAdminAlertAccountStatusNotificationHandlerRequest, plan adminAlertAccountStatusNotificationHandlerResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -242,6 +253,7 @@ func (r *adminAlertAccountStatusNotificationHandlerResource) Create(ctx context.
 	// Read the response into the state
 	var state adminAlertAccountStatusNotificationHandlerResourceModel
 	readAdminAlertAccountStatusNotificationHandlerResponse(ctx, addResponse.AdminAlertAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -280,6 +292,12 @@ func (r *defaultAdminAlertAccountStatusNotificationHandlerResource) Create(ctx c
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AdminAlertAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Admin Alert Account Status Notification Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state adminAlertAccountStatusNotificationHandlerResourceModel
 	readAdminAlertAccountStatusNotificationHandlerResponse(ctx, readResponse.AdminAlertAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -306,6 +324,7 @@ func (r *defaultAdminAlertAccountStatusNotificationHandlerResource) Create(ctx c
 
 		// Read the response
 		readAdminAlertAccountStatusNotificationHandlerResponse(ctx, updateResponse.AdminAlertAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -338,7 +357,12 @@ func readAdminAlertAccountStatusNotificationHandler(ctx context.Context, req res
 	readResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerApi.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Admin Alert Account Status Notification Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Admin Alert Account Status Notification Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Admin Alert Account Status Notification Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -348,6 +372,13 @@ func readAdminAlertAccountStatusNotificationHandler(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AdminAlertAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Admin Alert Account Status Notification Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAdminAlertAccountStatusNotificationHandlerResponse(ctx, readResponse.AdminAlertAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -404,6 +435,7 @@ func updateAdminAlertAccountStatusNotificationHandler(ctx context.Context, req r
 
 		// Read the response
 		readAdminAlertAccountStatusNotificationHandlerResponse(ctx, updateResponse.AdminAlertAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -452,4 +484,5 @@ func (r *defaultAdminAlertAccountStatusNotificationHandlerResource) ImportState(
 func importAdminAlertAccountStatusNotificationHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &errorLogAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &errorLogAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &errorLogAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &errorLogAccountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultErrorLogAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultErrorLogAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultErrorLogAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultErrorLogAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Error Log Account Status Notification Handler resource
@@ -152,6 +154,15 @@ func errorLogAccountStatusNotificationHandlerSchema(ctx context.Context, req res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *errorLogAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultErrorLogAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalErrorLogAccountStatusNotificationHandlerFields(ctx context.Context, addRequest *client.AddErrorLogAccountStatusNotificationHandlerRequest, plan errorLogAccountStatusNotificationHandlerResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -242,6 +253,7 @@ func (r *errorLogAccountStatusNotificationHandlerResource) Create(ctx context.Co
 	// Read the response into the state
 	var state errorLogAccountStatusNotificationHandlerResourceModel
 	readErrorLogAccountStatusNotificationHandlerResponse(ctx, addResponse.ErrorLogAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -280,6 +292,12 @@ func (r *defaultErrorLogAccountStatusNotificationHandlerResource) Create(ctx con
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ErrorLogAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Error Log Account Status Notification Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state errorLogAccountStatusNotificationHandlerResourceModel
 	readErrorLogAccountStatusNotificationHandlerResponse(ctx, readResponse.ErrorLogAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -306,6 +324,7 @@ func (r *defaultErrorLogAccountStatusNotificationHandlerResource) Create(ctx con
 
 		// Read the response
 		readErrorLogAccountStatusNotificationHandlerResponse(ctx, updateResponse.ErrorLogAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -338,7 +357,12 @@ func readErrorLogAccountStatusNotificationHandler(ctx context.Context, req resou
 	readResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerApi.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Error Log Account Status Notification Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Error Log Account Status Notification Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Error Log Account Status Notification Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -348,6 +372,13 @@ func readErrorLogAccountStatusNotificationHandler(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ErrorLogAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Error Log Account Status Notification Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readErrorLogAccountStatusNotificationHandlerResponse(ctx, readResponse.ErrorLogAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -404,6 +435,7 @@ func updateErrorLogAccountStatusNotificationHandler(ctx context.Context, req res
 
 		// Read the response
 		readErrorLogAccountStatusNotificationHandlerResponse(ctx, updateResponse.ErrorLogAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -452,4 +484,5 @@ func (r *defaultErrorLogAccountStatusNotificationHandlerResource) ImportState(ct
 func importErrorLogAccountStatusNotificationHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &groovyScriptedAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedAccountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultGroovyScriptedAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Groovy Scripted Account Status Notification Handler resource
@@ -162,6 +164,15 @@ func groovyScriptedAccountStatusNotificationHandlerSchema(ctx context.Context, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedAccountStatusNotificationHandlerFields(ctx context.Context, addRequest *client.AddGroovyScriptedAccountStatusNotificationHandlerRequest, plan groovyScriptedAccountStatusNotificationHandlerResourceModel) {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -256,6 +267,7 @@ func (r *groovyScriptedAccountStatusNotificationHandlerResource) Create(ctx cont
 	// Read the response into the state
 	var state groovyScriptedAccountStatusNotificationHandlerResourceModel
 	readGroovyScriptedAccountStatusNotificationHandlerResponse(ctx, addResponse.GroovyScriptedAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -294,6 +306,12 @@ func (r *defaultGroovyScriptedAccountStatusNotificationHandlerResource) Create(c
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Account Status Notification Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedAccountStatusNotificationHandlerResourceModel
 	readGroovyScriptedAccountStatusNotificationHandlerResponse(ctx, readResponse.GroovyScriptedAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -320,6 +338,7 @@ func (r *defaultGroovyScriptedAccountStatusNotificationHandlerResource) Create(c
 
 		// Read the response
 		readGroovyScriptedAccountStatusNotificationHandlerResponse(ctx, updateResponse.GroovyScriptedAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -352,7 +371,12 @@ func readGroovyScriptedAccountStatusNotificationHandler(ctx context.Context, req
 	readResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerApi.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Account Status Notification Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Account Status Notification Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Account Status Notification Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -362,6 +386,13 @@ func readGroovyScriptedAccountStatusNotificationHandler(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Account Status Notification Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedAccountStatusNotificationHandlerResponse(ctx, readResponse.GroovyScriptedAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -418,6 +449,7 @@ func updateGroovyScriptedAccountStatusNotificationHandler(ctx context.Context, r
 
 		// Read the response
 		readGroovyScriptedAccountStatusNotificationHandlerResponse(ctx, updateResponse.GroovyScriptedAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -466,4 +498,5 @@ func (r *defaultGroovyScriptedAccountStatusNotificationHandlerResource) ImportSt
 func importGroovyScriptedAccountStatusNotificationHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &multiPartEmailAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &multiPartEmailAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &multiPartEmailAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &multiPartEmailAccountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultMultiPartEmailAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultMultiPartEmailAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultMultiPartEmailAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultMultiPartEmailAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Multi Part Email Account Status Notification Handler resource
@@ -299,6 +301,15 @@ func multiPartEmailAccountStatusNotificationHandlerSchema(ctx context.Context, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *multiPartEmailAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultMultiPartEmailAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalMultiPartEmailAccountStatusNotificationHandlerFields(ctx context.Context, addRequest *client.AddMultiPartEmailAccountStatusNotificationHandlerRequest, plan multiPartEmailAccountStatusNotificationHandlerResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -502,6 +513,7 @@ func (r *multiPartEmailAccountStatusNotificationHandlerResource) Create(ctx cont
 	// Read the response into the state
 	var state multiPartEmailAccountStatusNotificationHandlerResourceModel
 	readMultiPartEmailAccountStatusNotificationHandlerResponse(ctx, addResponse.MultiPartEmailAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -540,6 +552,12 @@ func (r *defaultMultiPartEmailAccountStatusNotificationHandlerResource) Create(c
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MultiPartEmailAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Multi Part Email Account Status Notification Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state multiPartEmailAccountStatusNotificationHandlerResourceModel
 	readMultiPartEmailAccountStatusNotificationHandlerResponse(ctx, readResponse.MultiPartEmailAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -566,6 +584,7 @@ func (r *defaultMultiPartEmailAccountStatusNotificationHandlerResource) Create(c
 
 		// Read the response
 		readMultiPartEmailAccountStatusNotificationHandlerResponse(ctx, updateResponse.MultiPartEmailAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -598,7 +617,12 @@ func readMultiPartEmailAccountStatusNotificationHandler(ctx context.Context, req
 	readResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerApi.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Multi Part Email Account Status Notification Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Multi Part Email Account Status Notification Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Multi Part Email Account Status Notification Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -608,6 +632,13 @@ func readMultiPartEmailAccountStatusNotificationHandler(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MultiPartEmailAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Multi Part Email Account Status Notification Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMultiPartEmailAccountStatusNotificationHandlerResponse(ctx, readResponse.MultiPartEmailAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -664,6 +695,7 @@ func updateMultiPartEmailAccountStatusNotificationHandler(ctx context.Context, r
 
 		// Read the response
 		readMultiPartEmailAccountStatusNotificationHandlerResponse(ctx, updateResponse.MultiPartEmailAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -712,4 +744,5 @@ func (r *defaultMultiPartEmailAccountStatusNotificationHandlerResource) ImportSt
 func importMultiPartEmailAccountStatusNotificationHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &smtpAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &smtpAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &smtpAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &smtpAccountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultSmtpAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultSmtpAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultSmtpAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSmtpAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Smtp Account Status Notification Handler resource
@@ -203,6 +205,15 @@ func smtpAccountStatusNotificationHandlerSchema(ctx context.Context, req resourc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *smtpAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSmtpAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSmtpAccountStatusNotificationHandlerFields(ctx context.Context, addRequest *client.AddSmtpAccountStatusNotificationHandlerRequest, plan smtpAccountStatusNotificationHandlerResourceModel) {
 	if internaltypes.IsDefined(plan.EmailAddressAttributeType) {
@@ -334,6 +345,7 @@ func (r *smtpAccountStatusNotificationHandlerResource) Create(ctx context.Contex
 	// Read the response into the state
 	var state smtpAccountStatusNotificationHandlerResourceModel
 	readSmtpAccountStatusNotificationHandlerResponse(ctx, addResponse.SmtpAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -372,6 +384,12 @@ func (r *defaultSmtpAccountStatusNotificationHandlerResource) Create(ctx context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SmtpAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Smtp Account Status Notification Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state smtpAccountStatusNotificationHandlerResourceModel
 	readSmtpAccountStatusNotificationHandlerResponse(ctx, readResponse.SmtpAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -398,6 +416,7 @@ func (r *defaultSmtpAccountStatusNotificationHandlerResource) Create(ctx context
 
 		// Read the response
 		readSmtpAccountStatusNotificationHandlerResponse(ctx, updateResponse.SmtpAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -430,7 +449,12 @@ func readSmtpAccountStatusNotificationHandler(ctx context.Context, req resource.
 	readResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerApi.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Smtp Account Status Notification Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Smtp Account Status Notification Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Smtp Account Status Notification Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -440,6 +464,13 @@ func readSmtpAccountStatusNotificationHandler(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SmtpAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Smtp Account Status Notification Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSmtpAccountStatusNotificationHandlerResponse(ctx, readResponse.SmtpAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -496,6 +527,7 @@ func updateSmtpAccountStatusNotificationHandler(ctx context.Context, req resourc
 
 		// Read the response
 		readSmtpAccountStatusNotificationHandlerResponse(ctx, updateResponse.SmtpAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -544,4 +576,5 @@ func (r *defaultSmtpAccountStatusNotificationHandlerResource) ImportState(ctx co
 func importSmtpAccountStatusNotificationHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
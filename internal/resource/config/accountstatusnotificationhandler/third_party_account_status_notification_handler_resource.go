@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &thirdPartyAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &thirdPartyAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyAccountStatusNotificationHandlerResource{}
 	_ resource.Resource                = &defaultThirdPartyAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyAccountStatusNotificationHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyAccountStatusNotificationHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyAccountStatusNotificationHandlerResource{}
 )
 
 // Create a Third Party Account Status Notification Handler resource
@@ -162,6 +164,15 @@ func thirdPartyAccountStatusNotificationHandlerSchema(ctx context.Context, req r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyAccountStatusNotificationHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyAccountStatusNotificationHandlerFields(ctx context.Context, addRequest *client.AddThirdPartyAccountStatusNotificationHandlerRequest, plan thirdPartyAccountStatusNotificationHandlerResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -256,6 +267,7 @@ func (r *thirdPartyAccountStatusNotificationHandlerResource) Create(ctx context.
 	// Read the response into the state
 	var state thirdPartyAccountStatusNotificationHandlerResourceModel
 	readThirdPartyAccountStatusNotificationHandlerResponse(ctx, addResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -294,6 +306,12 @@ func (r *defaultThirdPartyAccountStatusNotificationHandlerResource) Create(ctx c
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Account Status Notification Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyAccountStatusNotificationHandlerResourceModel
 	readThirdPartyAccountStatusNotificationHandlerResponse(ctx, readResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -320,6 +338,7 @@ func (r *defaultThirdPartyAccountStatusNotificationHandlerResource) Create(ctx c
 
 		// Read the response
 		readThirdPartyAccountStatusNotificationHandlerResponse(ctx, updateResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -352,7 +371,12 @@ func readThirdPartyAccountStatusNotificationHandler(ctx context.Context, req res
 	readResponse, httpResp, err := apiClient.AccountStatusNotificationHandlerApi.GetAccountStatusNotificationHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Account Status Notification Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Account Status Notification Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Account Status Notification Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -362,6 +386,13 @@ func readThirdPartyAccountStatusNotificationHandler(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyAccountStatusNotificationHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Account Status Notification Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyAccountStatusNotificationHandlerResponse(ctx, readResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -418,6 +449,7 @@ func updateThirdPartyAccountStatusNotificationHandler(ctx context.Context, req r
 
 		// Read the response
 		readThirdPartyAccountStatusNotificationHandlerResponse(ctx, updateResponse.ThirdPartyAccountStatusNotificationHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -466,4 +498,5 @@ func (r *defaultThirdPartyAccountStatusNotificationHandlerResource) ImportState(
 func importThirdPartyAccountStatusNotificationHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
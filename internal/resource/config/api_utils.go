@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
@@ -22,8 +25,13 @@ func BasicAuthContext(ctx context.Context, username, password string) context.Co
 	})
 }
 
-// Get a BasicAuth context from a ProviderConfiguration
+// Get a BasicAuth context from a ProviderConfiguration. When the provider authenticates with an access token
+// or a client certificate instead of a username and password, the credentials are added by the provider's
+// HTTP client, so the context is returned unchanged.
 func ProviderBasicAuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
+	if providerConfig.Username == "" {
+		return ctx
+	}
 	return BasicAuthContext(ctx, providerConfig.Username, providerConfig.Password)
 }
 
@@ -39,12 +47,14 @@ func ReportHttpError(ctx context.Context, diagnostics *diag.Diagnostics, errorSu
 	httpErrorPrinted := false
 	var internalError error
 	if httpResp != nil {
-		body, internalError := io.ReadAll(httpResp.Body)
+		var body []byte
+		body, internalError = io.ReadAll(httpResp.Body)
 		if internalError == nil {
 			tflog.Debug(ctx, "Error HTTP response body: "+string(body))
 			var pdError pingDirectoryError
 			internalError = json.Unmarshal(body, &pdError)
-			if internalError == nil {
+			// The detail describes what the server rejected, for example an incorrect key store PIN
+			if internalError == nil && pdError.Detail != "" {
 				diagnostics.AddError(errorSummary, err.Error()+" - Detail: "+pdError.Detail)
 				httpErrorPrinted = true
 			}
@@ -58,6 +68,151 @@ func ReportHttpError(ctx context.Context, diagnostics *diag.Diagnostics, errorSu
 	}
 }
 
+// Report a warning that a resource was not found on the server. Used when a config object has been
+// deleted outside of Terraform, so that the resource can be removed from state and planned for re-creation.
+func AddResourceNotFoundWarning(ctx context.Context, diagnostics *diag.Diagnostics, resourceType string, httpResp *http.Response) {
+	if httpResp != nil {
+		body, err := io.ReadAll(httpResp.Body)
+		if err == nil {
+			tflog.Debug(ctx, "Resource not found HTTP response body: "+string(body))
+		}
+	}
+	tflog.Warn(ctx, "The "+resourceType+" was not found on the PingDirectory server, removing it from state")
+	diagnostics.AddWarning("Resource not found", "The "+resourceType+" was not found on the PingDirectory server. "+
+		"It may have been deleted outside of Terraform. It has been removed from the Terraform state.")
+}
+
+// Describe a config object that has a different type than a resource expects, based on the schema URN in the
+// response body. For example an object with the schema "urn:pingidentity:schemas:configuration:2.0:log-publisher:file-based-access"
+// is described as a file-based-access log publisher, managed by the pingdirectory_file_based_access_log_publisher resource.
+func resourceTypeMismatchDetail(ctx context.Context, resourceType, id string, httpResp *http.Response) string {
+	detail := "The config object \"" + id + "\" is not a " + resourceType + "."
+	if httpResp == nil {
+		return detail
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return detail
+	}
+	tflog.Debug(ctx, "Mismatched type HTTP response body: "+string(body))
+	var response struct {
+		Schemas []string `json:"schemas"`
+	}
+	if json.Unmarshal(body, &response) != nil {
+		return detail
+	}
+	for _, schemaUrn := range response.Schemas {
+		if !strings.HasPrefix(schemaUrn, configurationSchemaUrnPrefix) {
+			continue
+		}
+		parts := strings.Split(strings.TrimPrefix(schemaUrn, configurationSchemaUrnPrefix), ":")
+		if len(parts) != 2 {
+			continue
+		}
+		resourceName := "pingdirectory_" + strings.ReplaceAll(parts[1]+"_"+parts[0], "-", "_")
+		return "The config object \"" + id + "\" is a " + parts[1] + " " + strings.ReplaceAll(parts[0], "-", " ") +
+			", not a " + resourceType + ". Use the " + resourceName + " resource to manage it."
+	}
+	return detail
+}
+
+// Private state data of a resource. The framework's private state type is internal, so this interface is used
+// to pass the Private field of a response.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// Private state key used to track config objects that have a different type than the resource expects
+const configObjectTypeKey = "config_object_type"
+
+type configObjectTypeState struct {
+	// Set when the resource is imported, until the config object has been read with the expected type
+	Imported bool `json:"imported,omitempty"`
+	// Describes the config object found on the server, when it has a different type than the resource expects
+	MismatchDetail string `json:"mismatch_detail,omitempty"`
+}
+
+func getConfigObjectTypeState(ctx context.Context, private PrivateState, diagnostics *diag.Diagnostics) configObjectTypeState {
+	var typeState configObjectTypeState
+	value, diags := private.GetKey(ctx, configObjectTypeKey)
+	diagnostics.Append(diags...)
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &typeState); err != nil {
+			tflog.Warn(ctx, "Failed to unmarshal private state key "+configObjectTypeKey+": "+err.Error())
+		}
+	}
+	return typeState
+}
+
+func setConfigObjectTypeState(ctx context.Context, private PrivateState, typeState configObjectTypeState, diagnostics *diag.Diagnostics) {
+	value, err := json.Marshal(typeState)
+	if err != nil {
+		diagnostics.AddError("Failed to marshal private state", err.Error())
+		return
+	}
+	diagnostics.Append(private.SetKey(ctx, configObjectTypeKey, value)...)
+}
+
+// Record that a resource has been imported. If the imported config object has a different type than the
+// resource expects, reading it reports an error rather than planning a replacement.
+func SetResourceImported(ctx context.Context, private PrivateState, diagnostics *diag.Diagnostics) {
+	setConfigObjectTypeState(ctx, private, configObjectTypeState{Imported: true}, diagnostics)
+}
+
+// Record that the config object read from the server has the type the resource expects
+func SetResourceTypeMatched(ctx context.Context, private PrivateState, diagnostics *diag.Diagnostics) {
+	if getConfigObjectTypeState(ctx, private, diagnostics) != (configObjectTypeState{}) {
+		setConfigObjectTypeState(ctx, private, configObjectTypeState{}, diagnostics)
+	}
+}
+
+// Report that a config object read from the server has a different type than the resource expects. When importing,
+// an error is reported. Otherwise, the config object was replaced outside of Terraform. The resource is kept in state
+// and marked as mismatched, so that ModifyPlanForResourceTypeMismatch plans a replacement.
+func AddResourceTypeMismatchReadDiagnostics(ctx context.Context, private PrivateState, diagnostics *diag.Diagnostics, resourceType, id string, httpResp *http.Response) {
+	detail := resourceTypeMismatchDetail(ctx, resourceType, id, httpResp)
+	typeState := getConfigObjectTypeState(ctx, private, diagnostics)
+	if typeState.Imported {
+		tflog.Error(ctx, detail)
+		diagnostics.AddError("Mismatched config object type", detail)
+		return
+	}
+	tflog.Warn(ctx, detail)
+	diagnostics.AddWarning("Mismatched config object type", detail+" It may have been replaced outside of Terraform.")
+	typeState.MismatchDetail = detail
+	setConfigObjectTypeState(ctx, private, typeState, diagnostics)
+}
+
+// Plan a replacement of a resource whose config object was found to have a different type during refresh. Resources
+// for default config objects can't create a replacement config object, so an error is reported for them instead.
+func ModifyPlanForResourceTypeMismatch(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, isDefault bool) {
+	// Nothing to replace when creating or destroying the resource
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	typeState := getConfigObjectTypeState(ctx, req.Private, &resp.Diagnostics)
+	if typeState.MismatchDetail == "" {
+		return
+	}
+	if isDefault {
+		resp.Diagnostics.AddError("Mismatched config object type", typeState.MismatchDetail+" Remove this resource from "+
+			"the Terraform state with \"terraform state rm\", and import the config object as the correct resource type.")
+		return
+	}
+	resp.Diagnostics.AddWarning("Mismatched config object type", typeState.MismatchDetail+" The config object will be "+
+		"deleted and re-created with the expected type.")
+	// Terraform only replaces a resource when an attribute that requires replacement changes. The last_updated
+	// timestamp is set again when the replacement config object is created.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_updated"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("last_updated"))
+}
+
+// Report an error that a config object on the server has a different type than the resource or data source expects
+func AddResourceTypeMismatchError(ctx context.Context, diagnostics *diag.Diagnostics, resourceType, id string, httpResp *http.Response) {
+	diagnostics.AddError("Mismatched config object type", resourceTypeMismatchDetail(ctx, resourceType, id, httpResp))
+}
+
 // Write out messages from the Config API response to tflog
 func logMessages(ctx context.Context, messages *client.MetaUrnPingidentitySchemasConfigurationMessages20, diagnostics *diag.Diagnostics) {
 	if messages == nil {
@@ -73,7 +228,58 @@ func logMessages(ctx context.Context, messages *client.MetaUrnPingidentitySchema
 		actionJson, err := action.MarshalJSON()
 		if err == nil {
 			tflog.Warn(ctx, "Configuration API RequiredAction: "+string(actionJson))
-			diagnostics.AddWarning("Configuration API RequiredAction", string(actionJson))
+		}
+	}
+}
+
+// Report required actions returned by the Configuration API after an update, based on the
+// provider's required_action_behavior setting
+func CheckRequiredActions(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, requiredActions types.Set, diagnostics *diag.Diagnostics) {
+	checkRequiredActions(ctx, providerConfig, requiredActions, diagnostics, true)
+}
+
+// Report required actions returned by the Configuration API after a create. Terraform marks a resource as tainted
+// when its creation reports an error, and the config object would then be deleted and re-created on every apply,
+// returning the same required actions each time. So required actions are reported as warnings, even when
+// required_action_behavior is "fail".
+func CheckRequiredActionsOnCreate(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, requiredActions types.Set, diagnostics *diag.Diagnostics) {
+	checkRequiredActions(ctx, providerConfig, requiredActions, diagnostics, false)
+}
+
+func checkRequiredActions(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, requiredActions types.Set, diagnostics *diag.Diagnostics, allowFailure bool) {
+	if requiredActions.IsNull() || requiredActions.IsUnknown() {
+		return
+	}
+	for _, element := range requiredActions.Elements() {
+		action, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		summary := "Configuration API RequiredAction"
+		detail := ""
+		if actionType, ok := action.Attributes()["type"].(types.String); ok {
+			summary += ": " + actionType.ValueString()
+		}
+		if property, ok := action.Attributes()["property"].(types.String); ok && !property.IsNull() {
+			detail += "Property: " + property.ValueString() + ". "
+		}
+		if synopsis, ok := action.Attributes()["synopsis"].(types.String); ok {
+			detail += synopsis.ValueString()
+		}
+		switch providerConfig.RequiredActionBehavior {
+		case internaltypes.RequiredActionBehaviorIgnore:
+			tflog.Debug(ctx, "Ignoring "+summary+" - "+detail)
+		case internaltypes.RequiredActionBehaviorFail:
+			if !allowFailure {
+				diagnostics.AddWarning(summary, detail+" The config object has been created, but the required action must be completed "+
+					"before it takes effect. Required actions returned when creating a config object are reported as warnings, "+
+					"because reporting an error would cause the config object to be deleted and re-created on the next apply.")
+				continue
+			}
+			diagnostics.AddError(summary, detail+" The change has been applied, but the required action must be completed "+
+				"before it takes effect. Set required_action_behavior to \"warn\" to allow the apply to succeed.")
+		default:
+			diagnostics.AddWarning(summary, detail)
 		}
 	}
 }
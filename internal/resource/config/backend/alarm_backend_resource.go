@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &alarmBackendResource{}
 	_ resource.ResourceWithConfigure   = &alarmBackendResource{}
 	_ resource.ResourceWithImportState = &alarmBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &alarmBackendResource{}
 )
 
 // Create a Alarm Backend resource
@@ -181,6 +182,11 @@ func (r *alarmBackendResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *alarmBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a AlarmBackendResponse object into the model struct
 func readAlarmBackendResponse(ctx context.Context, r *client.AlarmBackendResponse, state *alarmBackendResourceModel, expectedValues *alarmBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -245,6 +251,12 @@ func (r *alarmBackendResource) Create(ctx context.Context, req resource.CreateRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AlarmBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Alarm Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state alarmBackendResourceModel
 	readAlarmBackendResponse(ctx, readResponse.AlarmBackendResponse, &state, &state, &resp.Diagnostics)
@@ -271,6 +283,7 @@ func (r *alarmBackendResource) Create(ctx context.Context, req resource.CreateRe
 
 		// Read the response
 		readAlarmBackendResponse(ctx, updateResponse.AlarmBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -295,7 +308,12 @@ func (r *alarmBackendResource) Read(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Alarm Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Alarm Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Alarm Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -305,6 +323,13 @@ func (r *alarmBackendResource) Read(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AlarmBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Alarm Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAlarmBackendResponse(ctx, readResponse.AlarmBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -353,6 +378,7 @@ func (r *alarmBackendResource) Update(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readAlarmBackendResponse(ctx, updateResponse.AlarmBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -376,4 +402,5 @@ func (r *alarmBackendResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *alarmBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
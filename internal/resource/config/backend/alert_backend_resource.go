@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &alertBackendResource{}
 	_ resource.ResourceWithConfigure   = &alertBackendResource{}
 	_ resource.ResourceWithImportState = &alertBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &alertBackendResource{}
 )
 
 // Create a Alert Backend resource
@@ -191,6 +192,11 @@ func (r *alertBackendResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *alertBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a AlertBackendResponse object into the model struct
 func readAlertBackendResponse(ctx context.Context, r *client.AlertBackendResponse, state *alertBackendResourceModel, expectedValues *alertBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -258,6 +264,12 @@ func (r *alertBackendResource) Create(ctx context.Context, req resource.CreateRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AlertBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Alert Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state alertBackendResourceModel
 	readAlertBackendResponse(ctx, readResponse.AlertBackendResponse, &state, &state, &resp.Diagnostics)
@@ -284,6 +296,7 @@ func (r *alertBackendResource) Create(ctx context.Context, req resource.CreateRe
 
 		// Read the response
 		readAlertBackendResponse(ctx, updateResponse.AlertBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -308,7 +321,12 @@ func (r *alertBackendResource) Read(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Alert Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Alert Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Alert Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -318,6 +336,13 @@ func (r *alertBackendResource) Read(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AlertBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Alert Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAlertBackendResponse(ctx, readResponse.AlertBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -366,6 +391,7 @@ func (r *alertBackendResource) Update(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readAlertBackendResponse(ctx, updateResponse.AlertBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -389,4 +415,5 @@ func (r *alertBackendResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *alertBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &backupBackendResource{}
 	_ resource.ResourceWithConfigure   = &backupBackendResource{}
 	_ resource.ResourceWithImportState = &backupBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &backupBackendResource{}
 )
 
 // Create a Backup Backend resource
@@ -154,6 +155,11 @@ func (r *backupBackendResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *backupBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a BackupBackendResponse object into the model struct
 func readBackupBackendResponse(ctx context.Context, r *client.BackupBackendResponse, state *backupBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -210,6 +216,12 @@ func (r *backupBackendResource) Create(ctx context.Context, req resource.CreateR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.BackupBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Backup Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state backupBackendResourceModel
 	readBackupBackendResponse(ctx, readResponse.BackupBackendResponse, &state, &resp.Diagnostics)
@@ -236,6 +248,7 @@ func (r *backupBackendResource) Create(ctx context.Context, req resource.CreateR
 
 		// Read the response
 		readBackupBackendResponse(ctx, updateResponse.BackupBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -260,7 +273,12 @@ func (r *backupBackendResource) Read(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Backup Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Backup Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Backup Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -270,6 +288,13 @@ func (r *backupBackendResource) Read(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.BackupBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Backup Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readBackupBackendResponse(ctx, readResponse.BackupBackendResponse, &state, &resp.Diagnostics)
 
@@ -318,6 +343,7 @@ func (r *backupBackendResource) Update(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readBackupBackendResponse(ctx, updateResponse.BackupBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -341,4 +367,5 @@ func (r *backupBackendResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *backupBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
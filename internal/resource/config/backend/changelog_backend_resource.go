@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &changelogBackendResource{}
 	_ resource.ResourceWithConfigure   = &changelogBackendResource{}
 	_ resource.ResourceWithImportState = &changelogBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &changelogBackendResource{}
 )
 
 // Create a Changelog Backend resource
@@ -393,6 +394,11 @@ func (r *changelogBackendResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *changelogBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ChangelogBackendResponse object into the model struct
 func readChangelogBackendResponse(ctx context.Context, r *client.ChangelogBackendResponse, state *changelogBackendResourceModel, expectedValues *changelogBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -506,6 +512,12 @@ func (r *changelogBackendResource) Create(ctx context.Context, req resource.Crea
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ChangelogBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Changelog Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state changelogBackendResourceModel
 	readChangelogBackendResponse(ctx, readResponse.ChangelogBackendResponse, &state, &state, &resp.Diagnostics)
@@ -532,6 +544,7 @@ func (r *changelogBackendResource) Create(ctx context.Context, req resource.Crea
 
 		// Read the response
 		readChangelogBackendResponse(ctx, updateResponse.ChangelogBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -556,7 +569,12 @@ func (r *changelogBackendResource) Read(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Changelog Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Changelog Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Changelog Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -566,6 +584,13 @@ func (r *changelogBackendResource) Read(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ChangelogBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Changelog Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readChangelogBackendResponse(ctx, readResponse.ChangelogBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -614,6 +639,7 @@ func (r *changelogBackendResource) Update(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readChangelogBackendResponse(ctx, updateResponse.ChangelogBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -637,4 +663,5 @@ func (r *changelogBackendResource) Delete(ctx context.Context, req resource.Dele
 func (r *changelogBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
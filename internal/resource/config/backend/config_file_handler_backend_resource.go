@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &configFileHandlerBackendResource{}
 	_ resource.ResourceWithConfigure   = &configFileHandlerBackendResource{}
 	_ resource.ResourceWithImportState = &configFileHandlerBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &configFileHandlerBackendResource{}
 )
 
 // Create a Config File Handler Backend resource
@@ -190,6 +191,11 @@ func (r *configFileHandlerBackendResource) Schema(ctx context.Context, req resou
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *configFileHandlerBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ConfigFileHandlerBackendResponse object into the model struct
 func readConfigFileHandlerBackendResponse(ctx context.Context, r *client.ConfigFileHandlerBackendResponse, state *configFileHandlerBackendResourceModel, expectedValues *configFileHandlerBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -260,6 +266,12 @@ func (r *configFileHandlerBackendResource) Create(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConfigFileHandlerBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Config File Handler Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state configFileHandlerBackendResourceModel
 	readConfigFileHandlerBackendResponse(ctx, readResponse.ConfigFileHandlerBackendResponse, &state, &state, &resp.Diagnostics)
@@ -286,6 +298,7 @@ func (r *configFileHandlerBackendResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readConfigFileHandlerBackendResponse(ctx, updateResponse.ConfigFileHandlerBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -310,7 +323,12 @@ func (r *configFileHandlerBackendResource) Read(ctx context.Context, req resourc
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Config File Handler Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Config File Handler Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Config File Handler Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -320,6 +338,13 @@ func (r *configFileHandlerBackendResource) Read(ctx context.Context, req resourc
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConfigFileHandlerBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Config File Handler Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConfigFileHandlerBackendResponse(ctx, readResponse.ConfigFileHandlerBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -368,6 +393,7 @@ func (r *configFileHandlerBackendResource) Update(ctx context.Context, req resou
 
 		// Read the response
 		readConfigFileHandlerBackendResponse(ctx, updateResponse.ConfigFileHandlerBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -391,4 +417,5 @@ func (r *configFileHandlerBackendResource) Delete(ctx context.Context, req resou
 func (r *configFileHandlerBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &customBackendResource{}
 	_ resource.ResourceWithConfigure   = &customBackendResource{}
 	_ resource.ResourceWithImportState = &customBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &customBackendResource{}
 )
 
 // Create a Custom Backend resource
@@ -153,6 +154,11 @@ func (r *customBackendResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *customBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a CustomBackendResponse object into the model struct
 func readCustomBackendResponse(ctx context.Context, r *client.CustomBackendResponse, state *customBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -209,6 +215,12 @@ func (r *customBackendResource) Create(ctx context.Context, req resource.CreateR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CustomBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Custom Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state customBackendResourceModel
 	readCustomBackendResponse(ctx, readResponse.CustomBackendResponse, &state, &resp.Diagnostics)
@@ -235,6 +247,7 @@ func (r *customBackendResource) Create(ctx context.Context, req resource.CreateR
 
 		// Read the response
 		readCustomBackendResponse(ctx, updateResponse.CustomBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -259,7 +272,12 @@ func (r *customBackendResource) Read(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Custom Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -269,6 +287,13 @@ func (r *customBackendResource) Read(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CustomBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Custom Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCustomBackendResponse(ctx, readResponse.CustomBackendResponse, &state, &resp.Diagnostics)
 
@@ -317,6 +342,7 @@ func (r *customBackendResource) Update(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readCustomBackendResponse(ctx, updateResponse.CustomBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -340,4 +366,5 @@ func (r *customBackendResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *customBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
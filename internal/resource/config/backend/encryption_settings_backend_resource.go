@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &encryptionSettingsBackendResource{}
 	_ resource.ResourceWithConfigure   = &encryptionSettingsBackendResource{}
 	_ resource.ResourceWithImportState = &encryptionSettingsBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &encryptionSettingsBackendResource{}
 )
 
 // Create a Encryption Settings Backend resource
@@ -144,6 +145,11 @@ func (r *encryptionSettingsBackendResource) Schema(ctx context.Context, req reso
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *encryptionSettingsBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a EncryptionSettingsBackendResponse object into the model struct
 func readEncryptionSettingsBackendResponse(ctx context.Context, r *client.EncryptionSettingsBackendResponse, state *encryptionSettingsBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -198,6 +204,12 @@ func (r *encryptionSettingsBackendResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EncryptionSettingsBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Encryption Settings Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state encryptionSettingsBackendResourceModel
 	readEncryptionSettingsBackendResponse(ctx, readResponse.EncryptionSettingsBackendResponse, &state, &resp.Diagnostics)
@@ -224,6 +236,7 @@ func (r *encryptionSettingsBackendResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readEncryptionSettingsBackendResponse(ctx, updateResponse.EncryptionSettingsBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -248,7 +261,12 @@ func (r *encryptionSettingsBackendResource) Read(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Encryption Settings Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Encryption Settings Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Encryption Settings Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -258,6 +276,13 @@ func (r *encryptionSettingsBackendResource) Read(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EncryptionSettingsBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Encryption Settings Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readEncryptionSettingsBackendResponse(ctx, readResponse.EncryptionSettingsBackendResponse, &state, &resp.Diagnostics)
 
@@ -306,6 +331,7 @@ func (r *encryptionSettingsBackendResource) Update(ctx context.Context, req reso
 
 		// Read the response
 		readEncryptionSettingsBackendResponse(ctx, updateResponse.EncryptionSettingsBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -329,4 +355,5 @@ func (r *encryptionSettingsBackendResource) Delete(ctx context.Context, req reso
 func (r *encryptionSettingsBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &ldifBackendResource{}
 	_ resource.ResourceWithConfigure   = &ldifBackendResource{}
 	_ resource.ResourceWithImportState = &ldifBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &ldifBackendResource{}
 )
 
 // Create a Ldif Backend resource
@@ -171,6 +172,11 @@ func (r *ldifBackendResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *ldifBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a LdifBackendResponse object into the model struct
 func readLdifBackendResponse(ctx context.Context, r *client.LdifBackendResponse, state *ldifBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -231,6 +237,12 @@ func (r *ldifBackendResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdifBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldif Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldifBackendResourceModel
 	readLdifBackendResponse(ctx, readResponse.LdifBackendResponse, &state, &resp.Diagnostics)
@@ -257,6 +269,7 @@ func (r *ldifBackendResource) Create(ctx context.Context, req resource.CreateReq
 
 		// Read the response
 		readLdifBackendResponse(ctx, updateResponse.LdifBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -281,7 +294,12 @@ func (r *ldifBackendResource) Read(ctx context.Context, req resource.ReadRequest
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldif Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldif Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldif Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -291,6 +309,13 @@ func (r *ldifBackendResource) Read(ctx context.Context, req resource.ReadRequest
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdifBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldif Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdifBackendResponse(ctx, readResponse.LdifBackendResponse, &state, &resp.Diagnostics)
 
@@ -339,6 +364,7 @@ func (r *ldifBackendResource) Update(ctx context.Context, req resource.UpdateReq
 
 		// Read the response
 		readLdifBackendResponse(ctx, updateResponse.LdifBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -362,4 +388,5 @@ func (r *ldifBackendResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *ldifBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &localDbBackendResource{}
 	_ resource.ResourceWithConfigure   = &localDbBackendResource{}
 	_ resource.ResourceWithImportState = &localDbBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &localDbBackendResource{}
 	_ resource.Resource                = &defaultLocalDbBackendResource{}
 	_ resource.ResourceWithConfigure   = &defaultLocalDbBackendResource{}
 	_ resource.ResourceWithImportState = &defaultLocalDbBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLocalDbBackendResource{}
 )
 
 // Create a Local Db Backend resource
@@ -614,6 +616,15 @@ func localDbBackendSchema(ctx context.Context, req resource.SchemaRequest, resp
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *localDbBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultLocalDbBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalLocalDbBackendFields(ctx context.Context, addRequest *client.AddLocalDbBackendRequest, plan localDbBackendResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -1117,6 +1128,7 @@ func (r *localDbBackendResource) Create(ctx context.Context, req resource.Create
 	// Read the response into the state
 	var state localDbBackendResourceModel
 	readLocalDbBackendResponse(ctx, addResponse.LocalDbBackendResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -1155,6 +1167,12 @@ func (r *defaultLocalDbBackendResource) Create(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LocalDbBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Local Db Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state localDbBackendResourceModel
 	readLocalDbBackendResponse(ctx, readResponse.LocalDbBackendResponse, &state, &state, &resp.Diagnostics)
@@ -1181,6 +1199,7 @@ func (r *defaultLocalDbBackendResource) Create(ctx context.Context, req resource
 
 		// Read the response
 		readLocalDbBackendResponse(ctx, updateResponse.LocalDbBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -1213,7 +1232,12 @@ func readLocalDbBackend(ctx context.Context, req resource.ReadRequest, resp *res
 	readResponse, httpResp, err := apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Local Db Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Local Db Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Local Db Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -1223,6 +1247,13 @@ func readLocalDbBackend(ctx context.Context, req resource.ReadRequest, resp *res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LocalDbBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Local Db Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLocalDbBackendResponse(ctx, readResponse.LocalDbBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -1279,6 +1310,7 @@ func updateLocalDbBackend(ctx context.Context, req resource.UpdateRequest, resp
 
 		// Read the response
 		readLocalDbBackendResponse(ctx, updateResponse.LocalDbBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1327,4 +1359,5 @@ func (r *defaultLocalDbBackendResource) ImportState(ctx context.Context, req res
 func importLocalDbBackend(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
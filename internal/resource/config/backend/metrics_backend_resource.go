@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &metricsBackendResource{}
 	_ resource.ResourceWithConfigure   = &metricsBackendResource{}
 	_ resource.ResourceWithImportState = &metricsBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &metricsBackendResource{}
 )
 
 // Create a Metrics Backend resource
@@ -162,6 +163,11 @@ func (r *metricsBackendResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *metricsBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a MetricsBackendResponse object into the model struct
 func readMetricsBackendResponse(ctx context.Context, r *client.MetricsBackendResponse, state *metricsBackendResourceModel, expectedValues *metricsBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -222,6 +228,12 @@ func (r *metricsBackendResource) Create(ctx context.Context, req resource.Create
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MetricsBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Metrics Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state metricsBackendResourceModel
 	readMetricsBackendResponse(ctx, readResponse.MetricsBackendResponse, &state, &state, &resp.Diagnostics)
@@ -248,6 +260,7 @@ func (r *metricsBackendResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readMetricsBackendResponse(ctx, updateResponse.MetricsBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -272,7 +285,12 @@ func (r *metricsBackendResource) Read(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Metrics Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Metrics Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Metrics Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -282,6 +300,13 @@ func (r *metricsBackendResource) Read(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MetricsBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Metrics Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMetricsBackendResponse(ctx, readResponse.MetricsBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -330,6 +355,7 @@ func (r *metricsBackendResource) Update(ctx context.Context, req resource.Update
 
 		// Read the response
 		readMetricsBackendResponse(ctx, updateResponse.MetricsBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -353,4 +379,5 @@ func (r *metricsBackendResource) Delete(ctx context.Context, req resource.Delete
 func (r *metricsBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
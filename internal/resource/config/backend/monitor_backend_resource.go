@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &monitorBackendResource{}
 	_ resource.ResourceWithConfigure   = &monitorBackendResource{}
 	_ resource.ResourceWithImportState = &monitorBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &monitorBackendResource{}
 )
 
 // Create a Monitor Backend resource
@@ -135,6 +136,11 @@ func (r *monitorBackendResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *monitorBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a MonitorBackendResponse object into the model struct
 func readMonitorBackendResponse(ctx context.Context, r *client.MonitorBackendResponse, state *monitorBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -187,6 +193,12 @@ func (r *monitorBackendResource) Create(ctx context.Context, req resource.Create
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MonitorBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Monitor Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state monitorBackendResourceModel
 	readMonitorBackendResponse(ctx, readResponse.MonitorBackendResponse, &state, &resp.Diagnostics)
@@ -213,6 +225,7 @@ func (r *monitorBackendResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readMonitorBackendResponse(ctx, updateResponse.MonitorBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -237,7 +250,12 @@ func (r *monitorBackendResource) Read(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitor Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Monitor Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitor Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -247,6 +265,13 @@ func (r *monitorBackendResource) Read(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MonitorBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Monitor Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMonitorBackendResponse(ctx, readResponse.MonitorBackendResponse, &state, &resp.Diagnostics)
 
@@ -295,6 +320,7 @@ func (r *monitorBackendResource) Update(ctx context.Context, req resource.Update
 
 		// Read the response
 		readMonitorBackendResponse(ctx, updateResponse.MonitorBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -318,4 +344,5 @@ func (r *monitorBackendResource) Delete(ctx context.Context, req resource.Delete
 func (r *monitorBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &schemaBackendResource{}
 	_ resource.ResourceWithConfigure   = &schemaBackendResource{}
 	_ resource.ResourceWithImportState = &schemaBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &schemaBackendResource{}
 )
 
 // Create a Schema Backend resource
@@ -182,6 +183,11 @@ func (r *schemaBackendResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *schemaBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a SchemaBackendResponse object into the model struct
 func readSchemaBackendResponse(ctx context.Context, r *client.SchemaBackendResponse, state *schemaBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -244,6 +250,12 @@ func (r *schemaBackendResource) Create(ctx context.Context, req resource.CreateR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SchemaBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Schema Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state schemaBackendResourceModel
 	readSchemaBackendResponse(ctx, readResponse.SchemaBackendResponse, &state, &resp.Diagnostics)
@@ -270,6 +282,7 @@ func (r *schemaBackendResource) Create(ctx context.Context, req resource.CreateR
 
 		// Read the response
 		readSchemaBackendResponse(ctx, updateResponse.SchemaBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -294,7 +307,12 @@ func (r *schemaBackendResource) Read(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Schema Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Schema Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Schema Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -304,6 +322,13 @@ func (r *schemaBackendResource) Read(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SchemaBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Schema Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSchemaBackendResponse(ctx, readResponse.SchemaBackendResponse, &state, &resp.Diagnostics)
 
@@ -352,6 +377,7 @@ func (r *schemaBackendResource) Update(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readSchemaBackendResponse(ctx, updateResponse.SchemaBackendResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -375,4 +401,5 @@ func (r *schemaBackendResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *schemaBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
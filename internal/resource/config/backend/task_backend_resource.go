@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &taskBackendResource{}
 	_ resource.ResourceWithConfigure   = &taskBackendResource{}
 	_ resource.ResourceWithImportState = &taskBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &taskBackendResource{}
 )
 
 // Create a Task Backend resource
@@ -199,6 +200,11 @@ func (r *taskBackendResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *taskBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a TaskBackendResponse object into the model struct
 func readTaskBackendResponse(ctx context.Context, r *client.TaskBackendResponse, state *taskBackendResourceModel, expectedValues *taskBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -267,6 +273,12 @@ func (r *taskBackendResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.TaskBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Task Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state taskBackendResourceModel
 	readTaskBackendResponse(ctx, readResponse.TaskBackendResponse, &state, &state, &resp.Diagnostics)
@@ -293,6 +305,7 @@ func (r *taskBackendResource) Create(ctx context.Context, req resource.CreateReq
 
 		// Read the response
 		readTaskBackendResponse(ctx, updateResponse.TaskBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -317,7 +330,12 @@ func (r *taskBackendResource) Read(ctx context.Context, req resource.ReadRequest
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Task Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Task Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Task Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -327,6 +345,13 @@ func (r *taskBackendResource) Read(ctx context.Context, req resource.ReadRequest
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.TaskBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Task Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readTaskBackendResponse(ctx, readResponse.TaskBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -375,6 +400,7 @@ func (r *taskBackendResource) Update(ctx context.Context, req resource.UpdateReq
 
 		// Read the response
 		readTaskBackendResponse(ctx, updateResponse.TaskBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -398,4 +424,5 @@ func (r *taskBackendResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *taskBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &trustStoreBackendResource{}
 	_ resource.ResourceWithConfigure   = &trustStoreBackendResource{}
 	_ resource.ResourceWithImportState = &trustStoreBackendResource{}
+	_ resource.ResourceWithModifyPlan  = &trustStoreBackendResource{}
 )
 
 // Create a Trust Store Backend resource
@@ -199,6 +200,11 @@ func (r *trustStoreBackendResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *trustStoreBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a TrustStoreBackendResponse object into the model struct
 func readTrustStoreBackendResponse(ctx context.Context, r *client.TrustStoreBackendResponse, state *trustStoreBackendResourceModel, expectedValues *trustStoreBackendResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -266,6 +272,12 @@ func (r *trustStoreBackendResource) Create(ctx context.Context, req resource.Cre
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.TrustStoreBackendResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Trust Store Backend", plan.BackendID.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state trustStoreBackendResourceModel
 	readTrustStoreBackendResponse(ctx, readResponse.TrustStoreBackendResponse, &state, &state, &resp.Diagnostics)
@@ -292,6 +304,7 @@ func (r *trustStoreBackendResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readTrustStoreBackendResponse(ctx, updateResponse.TrustStoreBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -316,7 +329,12 @@ func (r *trustStoreBackendResource) Read(ctx context.Context, req resource.ReadR
 	readResponse, httpResp, err := r.apiClient.BackendApi.GetBackend(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.BackendID.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Trust Store Backend", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Trust Store Backend", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Trust Store Backend", err, httpResp)
+		}
 		return
 	}
 
@@ -326,6 +344,13 @@ func (r *trustStoreBackendResource) Read(ctx context.Context, req resource.ReadR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.TrustStoreBackendResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Trust Store Backend", state.BackendID.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readTrustStoreBackendResponse(ctx, readResponse.TrustStoreBackendResponse, &state, &state, &resp.Diagnostics)
 
@@ -374,6 +399,7 @@ func (r *trustStoreBackendResource) Update(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readTrustStoreBackendResponse(ctx, updateResponse.TrustStoreBackendResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -397,4 +423,5 @@ func (r *trustStoreBackendResource) Delete(ctx context.Context, req resource.Del
 func (r *trustStoreBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to backend_id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("backend_id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -1,12 +1,16 @@
 package config
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -95,6 +99,16 @@ func SetAllAttributesToOptionalAndComputed(s *schema.Schema, exemptAttributes []
 				s.Attributes[key] = setAttr
 				continue
 			}
+			listAttr, ok := attribute.(schema.ListAttribute)
+			anyOk = ok || anyOk
+			if ok && (!listAttr.Computed || !listAttr.Optional) {
+				listAttr.Required = false
+				listAttr.Optional = true
+				listAttr.Computed = true
+				listAttr.PlanModifiers = append(listAttr.PlanModifiers, listplanmodifier.UseStateForUnknown())
+				s.Attributes[key] = listAttr
+				continue
+			}
 			boolAttr, ok := attribute.(schema.BoolAttribute)
 			anyOk = ok || anyOk
 			if ok && (!boolAttr.Computed || !boolAttr.Optional) {
@@ -133,6 +147,69 @@ func SetAllAttributesToOptionalAndComputed(s *schema.Schema, exemptAttributes []
 	}
 }
 
+// Build a data source schema from a resource schema. Every attribute is marked as Computed, except for
+// the attributes used to identify the config object being read, which are marked as Required.
+func ToDataSourceSchema(s schema.Schema, requiredAttributes []string) datasourceschema.Schema {
+	dataSourceSchema := datasourceschema.Schema{
+		Description: strings.Replace(s.Description, "Manages a", "Describes a", 1),
+		Attributes:  map[string]datasourceschema.Attribute{},
+	}
+	for key, attribute := range s.Attributes {
+		required := internaltypes.StringSliceContains(requiredAttributes, key)
+		// If more attribute types are used by this provider, this method will need to be updated
+		switch attr := attribute.(type) {
+		case schema.StringAttribute:
+			dataSourceSchema.Attributes[key] = datasourceschema.StringAttribute{
+				Description: attr.Description,
+				Required:    required,
+				Computed:    !required,
+				Sensitive:   attr.Sensitive,
+			}
+		case schema.SetAttribute:
+			dataSourceSchema.Attributes[key] = datasourceschema.SetAttribute{
+				Description: attr.Description,
+				ElementType: attr.ElementType,
+				Required:    required,
+				Computed:    !required,
+				Sensitive:   attr.Sensitive,
+			}
+		case schema.ListAttribute:
+			dataSourceSchema.Attributes[key] = datasourceschema.ListAttribute{
+				Description: attr.Description,
+				ElementType: attr.ElementType,
+				Required:    required,
+				Computed:    !required,
+				Sensitive:   attr.Sensitive,
+			}
+		case schema.BoolAttribute:
+			dataSourceSchema.Attributes[key] = datasourceschema.BoolAttribute{
+				Description: attr.Description,
+				Required:    required,
+				Computed:    !required,
+				Sensitive:   attr.Sensitive,
+			}
+		case schema.Int64Attribute:
+			dataSourceSchema.Attributes[key] = datasourceschema.Int64Attribute{
+				Description: attr.Description,
+				Required:    required,
+				Computed:    !required,
+				Sensitive:   attr.Sensitive,
+			}
+		case schema.Float64Attribute:
+			dataSourceSchema.Attributes[key] = datasourceschema.Float64Attribute{
+				Description: attr.Description,
+				Required:    required,
+				Computed:    !required,
+				Sensitive:   attr.Sensitive,
+			}
+		default:
+			//lintignore:R009
+			panic("No valid schema attribute type found when converting to data source schema: " + key)
+		}
+	}
+	return dataSourceSchema
+}
+
 // Get the set of required actions from the configuration messages returned by the config API
 func GetRequiredActionsSet(messages client.MetaUrnPingidentitySchemasConfigurationMessages20) (types.Set, diag.Diagnostics) {
 	setValues := make([]attr.Value, len(messages.RequiredActions))
@@ -0,0 +1,461 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configObjectListDataSource{}
+	_ datasource.DataSourceWithConfigure = &configObjectListDataSource{}
+)
+
+// Prefix of the schema URNs used as the type discriminator for config objects
+const configurationSchemaUrnPrefix = "urn:pingidentity:schemas:configuration:2.0:"
+
+// Create a Backends data source
+func NewBackendsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_backends", objectType: "Backend", listPath: "/backends"}
+}
+
+// Create a Local DB Indexes data source
+func NewLocalDbIndexesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_local_db_indexes", objectType: "Local DB Index", listPath: "/backends/%s/local-db-indexes",
+		parentAttribute: "backend_name", parentDescription: "Name of the parent Backend"}
+}
+
+// Create a Log Publishers data source
+func NewLogPublishersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_log_publishers", objectType: "Log Publisher", listPath: "/log-publishers"}
+}
+
+// Create a Plugins data source
+func NewPluginsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_plugins", objectType: "Plugin", listPath: "/plugin-root/plugins"}
+}
+
+// Create an Access Token Validators data source
+func NewAccessTokenValidatorsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_access_token_validators", objectType: "Access Token Validator", listPath: "/access-token-validators"}
+}
+
+// Create an Account Status Notification Handlers data source
+func NewAccountStatusNotificationHandlersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_account_status_notification_handlers", objectType: "Account Status Notification Handler", listPath: "/account-status-notification-handlers"}
+}
+
+// Create an Alert Handlers data source
+func NewAlertHandlersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_alert_handlers", objectType: "Alert Handler", listPath: "/alert-handlers"}
+}
+
+// Create a Certificate Mappers data source
+func NewCertificateMappersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_certificate_mappers", objectType: "Certificate Mapper", listPath: "/certificate-mappers"}
+}
+
+// Create a Client Connection Policies data source
+func NewClientConnectionPoliciesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_client_connection_policies", objectType: "Client Connection Policy", listPath: "/client-connection-policies"}
+}
+
+// Create a Connection Handlers data source
+func NewConnectionHandlersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_connection_handlers", objectType: "Connection Handler", listPath: "/connection-handlers"}
+}
+
+// Create a Delegated Admin Attributes data source
+func NewDelegatedAdminAttributesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_delegated_admin_attributes", objectType: "Delegated Admin Attribute", listPath: "/rest-resource-types/%s/delegated-admin-attributes",
+		parentAttribute: "rest_resource_type_name", parentDescription: "Name of the parent REST Resource Type"}
+}
+
+// Create an Extended Operation Handlers data source
+func NewExtendedOperationHandlersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_extended_operation_handlers", objectType: "Extended Operation Handler", listPath: "/extended-operation-handlers"}
+}
+
+// Create an External Servers data source
+func NewExternalServersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_external_servers", objectType: "External Server", listPath: "/external-servers"}
+}
+
+// Create a Gauges data source
+func NewGaugesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_gauges", objectType: "Gauge", listPath: "/gauges"}
+}
+
+// Create an HTTP Servlet Extensions data source
+func NewHttpServletExtensionsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_http_servlet_extensions", objectType: "HTTP Servlet Extension", listPath: "/http-servlet-extensions"}
+}
+
+// Create an Identity Mappers data source
+func NewIdentityMappersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_identity_mappers", objectType: "Identity Mapper", listPath: "/identity-mappers"}
+}
+
+// Create a Key Manager Providers data source
+func NewKeyManagerProvidersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_key_manager_providers", objectType: "Key Manager Provider", listPath: "/key-manager-providers"}
+}
+
+// Create a Local DB Composite Indexes data source
+func NewLocalDbCompositeIndexesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_local_db_composite_indexes", objectType: "Local DB Composite Index", listPath: "/backends/%s/local-db-composite-indexes",
+		parentAttribute: "backend_name", parentDescription: "Name of the parent Backend"}
+}
+
+// Create a Local DB VLV Indexes data source
+func NewLocalDbVlvIndexesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_local_db_vlv_indexes", objectType: "Local DB VLV Index", listPath: "/backends/%s/local-db-vlv-indexes",
+		parentAttribute: "backend_name", parentDescription: "Name of the parent Backend"}
+}
+
+// Create a Locations data source
+func NewLocationsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_locations", objectType: "Location", listPath: "/locations"}
+}
+
+// Create a Log File Rotation Listeners data source
+func NewLogFileRotationListenersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_log_file_rotation_listeners", objectType: "Log File Rotation Listener", listPath: "/log-file-rotation-listeners"}
+}
+
+// Create a Log Retention Policies data source
+func NewLogRetentionPoliciesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_log_retention_policies", objectType: "Log Retention Policy", listPath: "/log-retention-policies"}
+}
+
+// Create a Log Rotation Policies data source
+func NewLogRotationPoliciesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_log_rotation_policies", objectType: "Log Rotation Policy", listPath: "/log-rotation-policies"}
+}
+
+// Create a Monitor Providers data source
+func NewMonitorProvidersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_monitor_providers", objectType: "Monitor Provider", listPath: "/monitor-providers"}
+}
+
+// Create a Passphrase Providers data source
+func NewPassphraseProvidersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_passphrase_providers", objectType: "Passphrase Provider", listPath: "/passphrase-providers"}
+}
+
+// Create a Password Generators data source
+func NewPasswordGeneratorsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_password_generators", objectType: "Password Generator", listPath: "/password-generators"}
+}
+
+// Create a Password Policies data source
+func NewPasswordPoliciesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_password_policies", objectType: "Password Policy", listPath: "/password-policies"}
+}
+
+// Create a Password Storage Schemes data source
+func NewPasswordStorageSchemesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_password_storage_schemes", objectType: "Password Storage Scheme", listPath: "/password-storage-schemes"}
+}
+
+// Create a Password Validators data source
+func NewPasswordValidatorsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_password_validators", objectType: "Password Validator", listPath: "/password-validators"}
+}
+
+// Create a Recurring Task Chains data source
+func NewRecurringTaskChainsDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_recurring_task_chains", objectType: "Recurring Task Chain", listPath: "/recurring-task-chains"}
+}
+
+// Create a Recurring Tasks data source
+func NewRecurringTasksDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_recurring_tasks", objectType: "Recurring Task", listPath: "/recurring-tasks"}
+}
+
+// Create a Replication Assurance Policies data source
+func NewReplicationAssurancePoliciesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_replication_assurance_policies", objectType: "Replication Assurance Policy", listPath: "/replication-assurance-policies"}
+}
+
+// Create a REST Resource Types data source
+func NewRestResourceTypesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_rest_resource_types", objectType: "REST Resource Type", listPath: "/rest-resource-types"}
+}
+
+// Create a Result Criteria data source
+func NewResultCriteriaDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_result_criteria", objectType: "Result Criteria", listPath: "/result-criteria"}
+}
+
+// Create a SASL Mechanism Handlers data source
+func NewSaslMechanismHandlersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_sasl_mechanism_handlers", objectType: "SASL Mechanism Handler", listPath: "/sasl-mechanism-handlers"}
+}
+
+// Create a Search Entry Criteria data source
+func NewSearchEntryCriteriaDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_search_entry_criteria", objectType: "Search Entry Criteria", listPath: "/search-entry-criteria"}
+}
+
+// Create a Search Reference Criteria data source
+func NewSearchReferenceCriteriaDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_search_reference_criteria", objectType: "Search Reference Criteria", listPath: "/search-reference-criteria"}
+}
+
+// Create a Trust Manager Providers data source
+func NewTrustManagerProvidersDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_trust_manager_providers", objectType: "Trust Manager Provider", listPath: "/trust-manager-providers"}
+}
+
+// Create a Virtual Attributes data source
+func NewVirtualAttributesDataSource() datasource.DataSource {
+	return &configObjectListDataSource{typeName: "_virtual_attributes", objectType: "Virtual Attribute", listPath: "/virtual-attributes"}
+}
+
+// configObjectListDataSource is the datasource implementation for listing all config objects of a given type.
+type configObjectListDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+	// Suffix appended to the provider type name
+	typeName string
+	// Human-readable name of the listed config object type
+	objectType string
+	// Path of the Config API list endpoint, relative to the /config base path. If the objects
+	// have a parent object, the path contains a single %s for the parent name.
+	listPath string
+	// Name of the attribute identifying the parent object, if any
+	parentAttribute   string
+	parentDescription string
+}
+
+// A single config object returned by a Config API list endpoint
+type configObjectSummary struct {
+	Id      string   `json:"id"`
+	Schemas []string `json:"schemas"`
+	Enabled *bool    `json:"enabled"`
+	// Only returned for config objects that are evaluated in order, such as Client Connection Policies
+	EvaluationOrderIndex *int64 `json:"evaluationOrderIndex"`
+}
+
+// List response returned by the Config API
+type configObjectListResponse struct {
+	Resources []configObjectSummary `json:"Resources"`
+}
+
+// Get the attribute types of the objects listed by the data source
+func getConfigObjectSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":      types.StringType,
+		"type":    types.StringType,
+		"enabled": types.BoolType,
+	}
+}
+
+// Metadata returns the data source type name.
+func (r *configObjectListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *configObjectListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *configObjectListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	s := schema.Schema{
+		Description: "Lists the " + r.objectType + " config objects on the server, optionally filtered by type, enabled state, and name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder name of this object required by Terraform.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only include " + r.objectType + " config objects of this type, for example \"file-based-access\" for a File Based Access Log Publisher.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only include " + r.objectType + " config objects with this enabled state. Objects that do not have an enabled property are excluded when this is set.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only include " + r.objectType + " config objects with a name matching this regular expression.",
+				Optional:    true,
+			},
+			"ids": schema.SetAttribute{
+				Description: "Names of the matching " + r.objectType + " config objects.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"objects": schema.ListAttribute{
+				Description: "The matching " + r.objectType + " config objects, sorted by name. Each object includes the name, the type, and the enabled state if the object has one.",
+				ElementType: types.ObjectType{AttrTypes: getConfigObjectSummaryAttrTypes()},
+				Computed:    true,
+			},
+		},
+	}
+	if r.parentAttribute != "" {
+		s.Attributes[r.parentAttribute] = schema.StringAttribute{
+			Description: r.parentDescription,
+			Required:    true,
+		}
+	}
+	resp.Schema = s
+}
+
+// Get the type of a config object from its schema URNs. For example an object with the schema
+// "urn:pingidentity:schemas:configuration:2.0:log-publisher:file-based-access" has the type "file-based-access".
+func configObjectType(schemas []string) string {
+	for _, schemaUrn := range schemas {
+		if strings.HasPrefix(schemaUrn, configurationSchemaUrnPrefix) {
+			return schemaUrn[strings.LastIndex(schemaUrn, ":")+1:]
+		}
+	}
+	return ""
+}
+
+// Call a Config API list endpoint. The http.Response is returned to allow for reporting errors.
+func listConfigObjects(ctx context.Context, apiClient *client.APIClient, listPath string) ([]configObjectSummary, *http.Response, error) {
+	clientConfig := apiClient.GetConfig()
+	if len(clientConfig.Servers) == 0 {
+		return nil, nil, errors.New("no Configuration API server configured")
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, clientConfig.Servers[0].URL+listPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", clientConfig.UserAgent)
+	// Use the same authentication as the generated client
+	if auth, ok := ctx.Value(client.ContextBasicAuth).(client.BasicAuth); ok {
+		httpReq.SetBasicAuth(auth.UserName, auth.Password)
+	}
+	for header, value := range clientConfig.DefaultHeader {
+		httpReq.Header.Add(header, value)
+	}
+
+	httpResp, err := clientConfig.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	httpResp.Body.Close()
+	if err != nil {
+		return nil, httpResp, err
+	}
+	// Allow the body to be read again when reporting errors
+	httpResp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if httpResp.StatusCode >= 300 {
+		return nil, httpResp, errors.New(httpResp.Status)
+	}
+	tflog.Debug(ctx, "List response: "+string(body))
+
+	var listResponse configObjectListResponse
+	err = json.Unmarshal(body, &listResponse)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return listResponse.Resources, httpResp, nil
+}
+
+// Read resource information
+func (r *configObjectListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the filters from the config
+	var typeFilter, nameRegex types.String
+	var enabledFilter types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &typeFilter)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enabled"), &enabledFilter)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	listPath := r.listPath
+	var parentName types.String
+	if r.parentAttribute != "" {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(r.parentAttribute), &parentName)...)
+		listPath = fmt.Sprintf(r.listPath, url.PathEscape(parentName.ValueString()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegexp *regexp.Regexp
+	if internaltypes.IsNonEmptyString(nameRegex) {
+		var err error
+		nameRegexp, err = regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	summaries, httpResp, err := listConfigObjects(ProviderBasicAuthContext(ctx, r.providerConfig), r.apiClient, listPath)
+	if err != nil {
+		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while listing the "+r.objectType+" config objects", err, httpResp)
+		return
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Id < summaries[j].Id
+	})
+	var ids []string
+	objects := []attr.Value{}
+	for _, summary := range summaries {
+		objectType := configObjectType(summary.Schemas)
+		if internaltypes.IsNonEmptyString(typeFilter) && typeFilter.ValueString() != objectType {
+			continue
+		}
+		if internaltypes.IsDefined(enabledFilter) && (summary.Enabled == nil || *summary.Enabled != enabledFilter.ValueBool()) {
+			continue
+		}
+		if nameRegexp != nil && !nameRegexp.MatchString(summary.Id) {
+			continue
+		}
+		ids = append(ids, summary.Id)
+		object, diags := types.ObjectValue(getConfigObjectSummaryAttrTypes(), map[string]attr.Value{
+			"id":      types.StringValue(summary.Id),
+			"type":    types.StringValue(objectType),
+			"enabled": internaltypes.BoolTypeOrNil(summary.Enabled),
+		})
+		resp.Diagnostics.Append(diags...)
+		objects = append(objects, object)
+	}
+	objectList, diags := types.ListValue(types.ObjectType{AttrTypes: getConfigObjectSummaryAttrTypes()}, objects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue("id"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), typeFilter)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), enabledFilter)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name_regex"), nameRegex)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ids"), internaltypes.GetStringSet(ids))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("objects"), objectList)...)
+	if r.parentAttribute != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.parentAttribute), parentName)...)
+	}
+}
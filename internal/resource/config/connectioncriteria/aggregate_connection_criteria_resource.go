@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &aggregateConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &aggregateConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &aggregateConnectionCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &aggregateConnectionCriteriaResource{}
 	_ resource.Resource                = &defaultAggregateConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultAggregateConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultAggregateConnectionCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAggregateConnectionCriteriaResource{}
 )
 
 // Create a Aggregate Connection Criteria resource
@@ -153,6 +155,15 @@ func aggregateConnectionCriteriaSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *aggregateConnectionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAggregateConnectionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAggregateConnectionCriteriaFields(ctx context.Context, addRequest *client.AddAggregateConnectionCriteriaRequest, plan aggregateConnectionCriteriaResourceModel) {
 	if internaltypes.IsDefined(plan.AllIncludedConnectionCriteria) {
@@ -242,6 +253,7 @@ func (r *aggregateConnectionCriteriaResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state aggregateConnectionCriteriaResourceModel
 	readAggregateConnectionCriteriaResponse(ctx, addResponse.AggregateConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -280,6 +292,12 @@ func (r *defaultAggregateConnectionCriteriaResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AggregateConnectionCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Aggregate Connection Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state aggregateConnectionCriteriaResourceModel
 	readAggregateConnectionCriteriaResponse(ctx, readResponse.AggregateConnectionCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -306,6 +324,7 @@ func (r *defaultAggregateConnectionCriteriaResource) Create(ctx context.Context,
 
 		// Read the response
 		readAggregateConnectionCriteriaResponse(ctx, updateResponse.AggregateConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -338,7 +357,12 @@ func readAggregateConnectionCriteria(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.ConnectionCriteriaApi.GetConnectionCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Aggregate Connection Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Aggregate Connection Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Aggregate Connection Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -348,6 +372,13 @@ func readAggregateConnectionCriteria(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AggregateConnectionCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Aggregate Connection Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAggregateConnectionCriteriaResponse(ctx, readResponse.AggregateConnectionCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -404,6 +435,7 @@ func updateAggregateConnectionCriteria(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readAggregateConnectionCriteriaResponse(ctx, updateResponse.AggregateConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -452,4 +484,5 @@ func (r *defaultAggregateConnectionCriteriaResource) ImportState(ctx context.Con
 func importAggregateConnectionCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &simpleConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &simpleConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &simpleConnectionCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &simpleConnectionCriteriaResource{}
 	_ resource.Resource                = &defaultSimpleConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultSimpleConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultSimpleConnectionCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSimpleConnectionCriteriaResource{}
 )
 
 // Create a Simple Connection Criteria resource
@@ -362,6 +364,15 @@ func simpleConnectionCriteriaSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *simpleConnectionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSimpleConnectionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSimpleConnectionCriteriaFields(ctx context.Context, addRequest *client.AddSimpleConnectionCriteriaRequest, plan simpleConnectionCriteriaResourceModel) error {
 	if internaltypes.IsDefined(plan.IncludedClientAddress) {
@@ -656,6 +667,7 @@ func (r *simpleConnectionCriteriaResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state simpleConnectionCriteriaResourceModel
 	readSimpleConnectionCriteriaResponse(ctx, addResponse.SimpleConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -694,6 +706,12 @@ func (r *defaultSimpleConnectionCriteriaResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SimpleConnectionCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Simple Connection Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state simpleConnectionCriteriaResourceModel
 	readSimpleConnectionCriteriaResponse(ctx, readResponse.SimpleConnectionCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -720,6 +738,7 @@ func (r *defaultSimpleConnectionCriteriaResource) Create(ctx context.Context, re
 
 		// Read the response
 		readSimpleConnectionCriteriaResponse(ctx, updateResponse.SimpleConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -752,7 +771,12 @@ func readSimpleConnectionCriteria(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.ConnectionCriteriaApi.GetConnectionCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Simple Connection Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Simple Connection Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Simple Connection Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -762,6 +786,13 @@ func readSimpleConnectionCriteria(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SimpleConnectionCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Simple Connection Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSimpleConnectionCriteriaResponse(ctx, readResponse.SimpleConnectionCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -818,6 +849,7 @@ func updateSimpleConnectionCriteria(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readSimpleConnectionCriteriaResponse(ctx, updateResponse.SimpleConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -866,4 +898,5 @@ func (r *defaultSimpleConnectionCriteriaResource) ImportState(ctx context.Contex
 func importSimpleConnectionCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &thirdPartyConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &thirdPartyConnectionCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyConnectionCriteriaResource{}
 	_ resource.Resource                = &defaultThirdPartyConnectionCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyConnectionCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyConnectionCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyConnectionCriteriaResource{}
 )
 
 // Create a Third Party Connection Criteria resource
@@ -128,6 +130,15 @@ func thirdPartyConnectionCriteriaSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyConnectionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyConnectionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyConnectionCriteriaFields(ctx context.Context, addRequest *client.AddThirdPartyConnectionCriteriaRequest, plan thirdPartyConnectionCriteriaResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -199,6 +210,7 @@ func (r *thirdPartyConnectionCriteriaResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state thirdPartyConnectionCriteriaResourceModel
 	readThirdPartyConnectionCriteriaResponse(ctx, addResponse.ThirdPartyConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -237,6 +249,12 @@ func (r *defaultThirdPartyConnectionCriteriaResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyConnectionCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Connection Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyConnectionCriteriaResourceModel
 	readThirdPartyConnectionCriteriaResponse(ctx, readResponse.ThirdPartyConnectionCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -263,6 +281,7 @@ func (r *defaultThirdPartyConnectionCriteriaResource) Create(ctx context.Context
 
 		// Read the response
 		readThirdPartyConnectionCriteriaResponse(ctx, updateResponse.ThirdPartyConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -295,7 +314,12 @@ func readThirdPartyConnectionCriteria(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.ConnectionCriteriaApi.GetConnectionCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Connection Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Connection Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Connection Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -305,6 +329,13 @@ func readThirdPartyConnectionCriteria(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyConnectionCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Connection Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyConnectionCriteriaResponse(ctx, readResponse.ThirdPartyConnectionCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -361,6 +392,7 @@ func updateThirdPartyConnectionCriteria(ctx context.Context, req resource.Update
 
 		// Read the response
 		readThirdPartyConnectionCriteriaResponse(ctx, updateResponse.ThirdPartyConnectionCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -409,4 +441,5 @@ func (r *defaultThirdPartyConnectionCriteriaResource) ImportState(ctx context.Co
 func importThirdPartyConnectionCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
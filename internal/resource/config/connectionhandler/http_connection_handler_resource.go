@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &httpConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &httpConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &httpConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &httpConnectionHandlerResource{}
 	_ resource.Resource                = &defaultHttpConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultHttpConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultHttpConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultHttpConnectionHandlerResource{}
 )
 
 // Create a Http Connection Handler resource
@@ -350,6 +352,15 @@ func httpConnectionHandlerSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *httpConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultHttpConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalHttpConnectionHandlerFields(ctx context.Context, addRequest *client.AddHttpConnectionHandlerRequest, plan httpConnectionHandlerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -595,6 +606,7 @@ func (r *httpConnectionHandlerResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state httpConnectionHandlerResourceModel
 	readHttpConnectionHandlerResponse(ctx, addResponse.HttpConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -633,6 +645,12 @@ func (r *defaultHttpConnectionHandlerResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HttpConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Http Connection Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state httpConnectionHandlerResourceModel
 	readHttpConnectionHandlerResponse(ctx, readResponse.HttpConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -659,6 +677,7 @@ func (r *defaultHttpConnectionHandlerResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readHttpConnectionHandlerResponse(ctx, updateResponse.HttpConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -691,7 +710,12 @@ func readHttpConnectionHandler(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.ConnectionHandlerApi.GetConnectionHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Connection Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Http Connection Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Connection Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -701,6 +725,13 @@ func readHttpConnectionHandler(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HttpConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Http Connection Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readHttpConnectionHandlerResponse(ctx, readResponse.HttpConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -757,6 +788,7 @@ func updateHttpConnectionHandler(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readHttpConnectionHandlerResponse(ctx, updateResponse.HttpConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -805,4 +837,5 @@ func (r *defaultHttpConnectionHandlerResource) ImportState(ctx context.Context,
 func importHttpConnectionHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &jmxConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &jmxConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &jmxConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &jmxConnectionHandlerResource{}
 	_ resource.Resource                = &defaultJmxConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultJmxConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultJmxConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJmxConnectionHandlerResource{}
 )
 
 // Create a Jmx Connection Handler resource
@@ -168,6 +170,15 @@ func jmxConnectionHandlerSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jmxConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJmxConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJmxConnectionHandlerFields(ctx context.Context, addRequest *client.AddJmxConnectionHandlerRequest, plan jmxConnectionHandlerResourceModel) {
 	if internaltypes.IsDefined(plan.UseSSL) {
@@ -269,6 +280,7 @@ func (r *jmxConnectionHandlerResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state jmxConnectionHandlerResourceModel
 	readJmxConnectionHandlerResponse(ctx, addResponse.JmxConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -307,6 +319,12 @@ func (r *defaultJmxConnectionHandlerResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JmxConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jmx Connection Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jmxConnectionHandlerResourceModel
 	readJmxConnectionHandlerResponse(ctx, readResponse.JmxConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -333,6 +351,7 @@ func (r *defaultJmxConnectionHandlerResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readJmxConnectionHandlerResponse(ctx, updateResponse.JmxConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -365,7 +384,12 @@ func readJmxConnectionHandler(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.ConnectionHandlerApi.GetConnectionHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jmx Connection Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Jmx Connection Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jmx Connection Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -375,6 +399,13 @@ func readJmxConnectionHandler(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JmxConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Jmx Connection Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJmxConnectionHandlerResponse(ctx, readResponse.JmxConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -431,6 +462,7 @@ func updateJmxConnectionHandler(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readJmxConnectionHandlerResponse(ctx, updateResponse.JmxConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -479,4 +511,5 @@ func (r *defaultJmxConnectionHandlerResource) ImportState(ctx context.Context, r
 func importJmxConnectionHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
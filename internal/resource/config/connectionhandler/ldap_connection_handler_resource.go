@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &ldapConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &ldapConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &ldapConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &ldapConnectionHandlerResource{}
 	_ resource.Resource                = &defaultLdapConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultLdapConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultLdapConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLdapConnectionHandlerResource{}
 )
 
 // Create a Ldap Connection Handler resource
@@ -339,6 +341,15 @@ func ldapConnectionHandlerSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *ldapConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultLdapConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalLdapConnectionHandlerFields(ctx context.Context, addRequest *client.AddLdapConnectionHandlerRequest, plan ldapConnectionHandlerResourceModel) error {
 	if internaltypes.IsDefined(plan.ListenAddress) {
@@ -577,6 +588,7 @@ func (r *ldapConnectionHandlerResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state ldapConnectionHandlerResourceModel
 	readLdapConnectionHandlerResponse(ctx, addResponse.LdapConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -615,6 +627,12 @@ func (r *defaultLdapConnectionHandlerResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldap Connection Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldapConnectionHandlerResourceModel
 	readLdapConnectionHandlerResponse(ctx, readResponse.LdapConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -641,6 +659,7 @@ func (r *defaultLdapConnectionHandlerResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readLdapConnectionHandlerResponse(ctx, updateResponse.LdapConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -673,7 +692,12 @@ func readLdapConnectionHandler(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.ConnectionHandlerApi.GetConnectionHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Connection Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldap Connection Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Connection Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -683,6 +707,13 @@ func readLdapConnectionHandler(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldap Connection Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdapConnectionHandlerResponse(ctx, readResponse.LdapConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -739,6 +770,7 @@ func updateLdapConnectionHandler(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readLdapConnectionHandlerResponse(ctx, updateResponse.LdapConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -787,4 +819,5 @@ func (r *defaultLdapConnectionHandlerResource) ImportState(ctx context.Context,
 func importLdapConnectionHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &ldifConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &ldifConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &ldifConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &ldifConnectionHandlerResource{}
 	_ resource.Resource                = &defaultLdifConnectionHandlerResource{}
 	_ resource.ResourceWithConfigure   = &defaultLdifConnectionHandlerResource{}
 	_ resource.ResourceWithImportState = &defaultLdifConnectionHandlerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLdifConnectionHandlerResource{}
 )
 
 // Create a Ldif Connection Handler resource
@@ -157,6 +159,15 @@ func ldifConnectionHandlerSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *ldifConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultLdifConnectionHandlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalLdifConnectionHandlerFields(ctx context.Context, addRequest *client.AddLdifConnectionHandlerRequest, plan ldifConnectionHandlerResourceModel) {
 	if internaltypes.IsDefined(plan.AllowedClient) {
@@ -251,6 +262,7 @@ func (r *ldifConnectionHandlerResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state ldifConnectionHandlerResourceModel
 	readLdifConnectionHandlerResponse(ctx, addResponse.LdifConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -289,6 +301,12 @@ func (r *defaultLdifConnectionHandlerResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdifConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldif Connection Handler", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldifConnectionHandlerResourceModel
 	readLdifConnectionHandlerResponse(ctx, readResponse.LdifConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
@@ -315,6 +333,7 @@ func (r *defaultLdifConnectionHandlerResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readLdifConnectionHandlerResponse(ctx, updateResponse.LdifConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -347,7 +366,12 @@ func readLdifConnectionHandler(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.ConnectionHandlerApi.GetConnectionHandler(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldif Connection Handler", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldif Connection Handler", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldif Connection Handler", err, httpResp)
+		}
 		return
 	}
 
@@ -357,6 +381,13 @@ func readLdifConnectionHandler(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdifConnectionHandlerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldif Connection Handler", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdifConnectionHandlerResponse(ctx, readResponse.LdifConnectionHandlerResponse, &state, &state, &resp.Diagnostics)
 
@@ -413,6 +444,7 @@ func updateLdifConnectionHandler(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readLdifConnectionHandlerResponse(ctx, updateResponse.LdifConnectionHandlerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -461,4 +493,5 @@ func (r *defaultLdifConnectionHandlerResource) ImportState(ctx context.Context,
 func importLdifConnectionHandler(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
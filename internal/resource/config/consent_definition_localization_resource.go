@@ -216,6 +216,7 @@ func (r *consentDefinitionLocalizationResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state consentDefinitionLocalizationResourceModel
 	readConsentDefinitionLocalizationResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -280,6 +281,7 @@ func (r *defaultConsentDefinitionLocalizationResource) Create(ctx context.Contex
 
 		// Read the response
 		readConsentDefinitionLocalizationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -312,7 +314,12 @@ func readConsentDefinitionLocalization(ctx context.Context, req resource.ReadReq
 	readResponse, httpResp, err := apiClient.ConsentDefinitionLocalizationApi.GetConsentDefinitionLocalization(
 		ProviderBasicAuthContext(ctx, providerConfig), state.Locale.ValueString(), state.ConsentDefinitionName.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Definition Localization", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Consent Definition Localization", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Definition Localization", err, httpResp)
+		}
 		return
 	}
 
@@ -378,6 +385,7 @@ func updateConsentDefinitionLocalization(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readConsentDefinitionLocalizationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -212,6 +212,7 @@ func (r *consentDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Read the response into the state
 	var state consentDefinitionResourceModel
 	readConsentDefinitionResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -276,6 +277,7 @@ func (r *defaultConsentDefinitionResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readConsentDefinitionResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -308,7 +310,12 @@ func readConsentDefinition(ctx context.Context, req resource.ReadRequest, resp *
 	readResponse, httpResp, err := apiClient.ConsentDefinitionApi.GetConsentDefinition(
 		ProviderBasicAuthContext(ctx, providerConfig), state.UniqueID.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Definition", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Consent Definition", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Definition", err, httpResp)
+		}
 		return
 	}
 
@@ -374,6 +381,7 @@ func updateConsentDefinition(ctx context.Context, req resource.UpdateRequest, re
 
 		// Read the response
 		readConsentDefinitionResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -239,6 +239,7 @@ func (r *consentServiceResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readConsentServiceResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -263,7 +264,12 @@ func (r *consentServiceResource) Read(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := r.apiClient.ConsentServiceApi.GetConsentService(
 		ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Service", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Consent Service", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Service", err, httpResp)
+		}
 		return
 	}
 
@@ -321,6 +327,7 @@ func (r *consentServiceResource) Update(ctx context.Context, req resource.Update
 
 		// Read the response
 		readConsentServiceResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+		CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -299,6 +299,7 @@ func (r *debugTargetResource) Create(ctx context.Context, req resource.CreateReq
 	// Read the response into the state
 	var state debugTargetResourceModel
 	readDebugTargetResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -363,6 +364,7 @@ func (r *defaultDebugTargetResource) Create(ctx context.Context, req resource.Cr
 
 		// Read the response
 		readDebugTargetResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -395,7 +397,12 @@ func readDebugTarget(ctx context.Context, req resource.ReadRequest, resp *resour
 	readResponse, httpResp, err := apiClient.DebugTargetApi.GetDebugTarget(
 		ProviderBasicAuthContext(ctx, providerConfig), state.DebugScope.ValueString(), state.LogPublisherName.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Debug Target", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Debug Target", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Debug Target", err, httpResp)
+		}
 		return
 	}
 
@@ -461,6 +468,7 @@ func updateDebugTarget(ctx context.Context, req resource.UpdateRequest, resp *re
 
 		// Read the response
 		readDebugTargetResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
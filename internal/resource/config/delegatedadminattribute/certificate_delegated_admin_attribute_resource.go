@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &certificateDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &certificateDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &certificateDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &certificateDelegatedAdminAttributeResource{}
 	_ resource.Resource                = &defaultCertificateDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultCertificateDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultCertificateDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCertificateDelegatedAdminAttributeResource{}
 )
 
 // Create a Certificate Delegated Admin Attribute resource
@@ -207,6 +209,15 @@ func certificateDelegatedAdminAttributeSchema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *certificateDelegatedAdminAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultCertificateDelegatedAdminAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalCertificateDelegatedAdminAttributeFields(ctx context.Context, addRequest *client.AddCertificateDelegatedAdminAttributeRequest, plan certificateDelegatedAdminAttributeResourceModel) error {
 	if internaltypes.IsDefined(plan.AllowedMIMEType) {
@@ -349,6 +360,7 @@ func (r *certificateDelegatedAdminAttributeResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state certificateDelegatedAdminAttributeResourceModel
 	readCertificateDelegatedAdminAttributeResponse(ctx, addResponse.CertificateDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -387,6 +399,12 @@ func (r *defaultCertificateDelegatedAdminAttributeResource) Create(ctx context.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CertificateDelegatedAdminAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Certificate Delegated Admin Attribute", plan.RestResourceTypeName.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state certificateDelegatedAdminAttributeResourceModel
 	readCertificateDelegatedAdminAttributeResponse(ctx, readResponse.CertificateDelegatedAdminAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -413,6 +431,7 @@ func (r *defaultCertificateDelegatedAdminAttributeResource) Create(ctx context.C
 
 		// Read the response
 		readCertificateDelegatedAdminAttributeResponse(ctx, updateResponse.CertificateDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -445,7 +464,12 @@ func readCertificateDelegatedAdminAttribute(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := apiClient.DelegatedAdminAttributeApi.GetDelegatedAdminAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.AttributeType.ValueString(), state.RestResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Certificate Delegated Admin Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Certificate Delegated Admin Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Certificate Delegated Admin Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -455,6 +479,13 @@ func readCertificateDelegatedAdminAttribute(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CertificateDelegatedAdminAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Certificate Delegated Admin Attribute", state.RestResourceTypeName.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCertificateDelegatedAdminAttributeResponse(ctx, readResponse.CertificateDelegatedAdminAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -511,6 +542,7 @@ func updateCertificateDelegatedAdminAttribute(ctx context.Context, req resource.
 
 		// Read the response
 		readCertificateDelegatedAdminAttributeResponse(ctx, updateResponse.CertificateDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -565,4 +597,5 @@ func importCertificateDelegatedAdminAttribute(ctx context.Context, req resource.
 	// Set the required attributes to read the resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_type"), split[1])...)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &genericDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &genericDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &genericDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &genericDelegatedAdminAttributeResource{}
 	_ resource.Resource                = &defaultGenericDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultGenericDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultGenericDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGenericDelegatedAdminAttributeResource{}
 )
 
 // Create a Generic Delegated Admin Attribute resource
@@ -205,6 +207,15 @@ func genericDelegatedAdminAttributeSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *genericDelegatedAdminAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGenericDelegatedAdminAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGenericDelegatedAdminAttributeFields(ctx context.Context, addRequest *client.AddGenericDelegatedAdminAttributeRequest, plan genericDelegatedAdminAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -337,6 +348,7 @@ func (r *genericDelegatedAdminAttributeResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state genericDelegatedAdminAttributeResourceModel
 	readGenericDelegatedAdminAttributeResponse(ctx, addResponse.GenericDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -375,6 +387,12 @@ func (r *defaultGenericDelegatedAdminAttributeResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GenericDelegatedAdminAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Generic Delegated Admin Attribute", plan.RestResourceTypeName.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state genericDelegatedAdminAttributeResourceModel
 	readGenericDelegatedAdminAttributeResponse(ctx, readResponse.GenericDelegatedAdminAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -401,6 +419,7 @@ func (r *defaultGenericDelegatedAdminAttributeResource) Create(ctx context.Conte
 
 		// Read the response
 		readGenericDelegatedAdminAttributeResponse(ctx, updateResponse.GenericDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -433,7 +452,12 @@ func readGenericDelegatedAdminAttribute(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.DelegatedAdminAttributeApi.GetDelegatedAdminAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.AttributeType.ValueString(), state.RestResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Generic Delegated Admin Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Generic Delegated Admin Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Generic Delegated Admin Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -443,6 +467,13 @@ func readGenericDelegatedAdminAttribute(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GenericDelegatedAdminAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Generic Delegated Admin Attribute", state.RestResourceTypeName.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGenericDelegatedAdminAttributeResponse(ctx, readResponse.GenericDelegatedAdminAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -499,6 +530,7 @@ func updateGenericDelegatedAdminAttribute(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readGenericDelegatedAdminAttributeResponse(ctx, updateResponse.GenericDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -553,4 +585,5 @@ func importGenericDelegatedAdminAttribute(ctx context.Context, req resource.Impo
 	// Set the required attributes to read the resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_type"), split[1])...)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
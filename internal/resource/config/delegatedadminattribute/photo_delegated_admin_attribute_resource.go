@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &photoDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &photoDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &photoDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &photoDelegatedAdminAttributeResource{}
 	_ resource.Resource                = &defaultPhotoDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultPhotoDelegatedAdminAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultPhotoDelegatedAdminAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPhotoDelegatedAdminAttributeResource{}
 )
 
 // Create a Photo Delegated Admin Attribute resource
@@ -207,6 +209,15 @@ func photoDelegatedAdminAttributeSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *photoDelegatedAdminAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPhotoDelegatedAdminAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPhotoDelegatedAdminAttributeFields(ctx context.Context, addRequest *client.AddPhotoDelegatedAdminAttributeRequest, plan photoDelegatedAdminAttributeResourceModel) error {
 	if internaltypes.IsDefined(plan.AllowedMIMEType) {
@@ -349,6 +360,7 @@ func (r *photoDelegatedAdminAttributeResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state photoDelegatedAdminAttributeResourceModel
 	readPhotoDelegatedAdminAttributeResponse(ctx, addResponse.PhotoDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -387,6 +399,12 @@ func (r *defaultPhotoDelegatedAdminAttributeResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PhotoDelegatedAdminAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Photo Delegated Admin Attribute", plan.RestResourceTypeName.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state photoDelegatedAdminAttributeResourceModel
 	readPhotoDelegatedAdminAttributeResponse(ctx, readResponse.PhotoDelegatedAdminAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -413,6 +431,7 @@ func (r *defaultPhotoDelegatedAdminAttributeResource) Create(ctx context.Context
 
 		// Read the response
 		readPhotoDelegatedAdminAttributeResponse(ctx, updateResponse.PhotoDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -445,7 +464,12 @@ func readPhotoDelegatedAdminAttribute(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.DelegatedAdminAttributeApi.GetDelegatedAdminAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.AttributeType.ValueString(), state.RestResourceTypeName.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Photo Delegated Admin Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Photo Delegated Admin Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Photo Delegated Admin Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -455,6 +479,13 @@ func readPhotoDelegatedAdminAttribute(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PhotoDelegatedAdminAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Photo Delegated Admin Attribute", state.RestResourceTypeName.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPhotoDelegatedAdminAttributeResponse(ctx, readResponse.PhotoDelegatedAdminAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -511,6 +542,7 @@ func updatePhotoDelegatedAdminAttribute(ctx context.Context, req resource.Update
 
 		// Read the response
 		readPhotoDelegatedAdminAttributeResponse(ctx, updateResponse.PhotoDelegatedAdminAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -565,4 +597,5 @@ func importPhotoDelegatedAdminAttribute(ctx context.Context, req resource.Import
 	// Set the required attributes to read the resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_resource_type_name"), split[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_type"), split[1])...)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
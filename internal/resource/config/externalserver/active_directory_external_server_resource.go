@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &activeDirectoryExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &activeDirectoryExternalServerResource{}
 	_ resource.ResourceWithImportState = &activeDirectoryExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &activeDirectoryExternalServerResource{}
 	_ resource.Resource                = &defaultActiveDirectoryExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultActiveDirectoryExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultActiveDirectoryExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultActiveDirectoryExternalServerResource{}
 )
 
 // Create a Active Directory External Server resource
@@ -270,6 +272,15 @@ func activeDirectoryExternalServerSchema(ctx context.Context, req resource.Schem
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *activeDirectoryExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultActiveDirectoryExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalActiveDirectoryExternalServerFields(ctx context.Context, addRequest *client.AddActiveDirectoryExternalServerRequest, plan activeDirectoryExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -497,6 +508,7 @@ func (r *activeDirectoryExternalServerResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state activeDirectoryExternalServerResourceModel
 	readActiveDirectoryExternalServerResponse(ctx, addResponse.ActiveDirectoryExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -535,6 +547,12 @@ func (r *defaultActiveDirectoryExternalServerResource) Create(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ActiveDirectoryExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Active Directory External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state activeDirectoryExternalServerResourceModel
 	readActiveDirectoryExternalServerResponse(ctx, readResponse.ActiveDirectoryExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -561,6 +579,7 @@ func (r *defaultActiveDirectoryExternalServerResource) Create(ctx context.Contex
 
 		// Read the response
 		readActiveDirectoryExternalServerResponse(ctx, updateResponse.ActiveDirectoryExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -593,7 +612,12 @@ func readActiveDirectoryExternalServer(ctx context.Context, req resource.ReadReq
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Active Directory External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Active Directory External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Active Directory External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -603,6 +627,13 @@ func readActiveDirectoryExternalServer(ctx context.Context, req resource.ReadReq
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ActiveDirectoryExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Active Directory External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readActiveDirectoryExternalServerResponse(ctx, readResponse.ActiveDirectoryExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -659,6 +690,7 @@ func updateActiveDirectoryExternalServer(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readActiveDirectoryExternalServerResponse(ctx, updateResponse.ActiveDirectoryExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -707,4 +739,5 @@ func (r *defaultActiveDirectoryExternalServerResource) ImportState(ctx context.C
 func importActiveDirectoryExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -148,12 +148,15 @@ func amazonAwsExternalServerSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
-// Validate that any version restrictions are met in the plan
+// Plan a replacement if the config object has been replaced with one of a different type, and validate
+// that any version restrictions are met in the plan
 func (r *amazonAwsExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
 	modifyPlanAmazonAwsExternalServer(ctx, req, resp, r.apiClient, r.providerConfig)
 }
 
 func (r *defaultAmazonAwsExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
 	modifyPlanAmazonAwsExternalServer(ctx, req, resp, r.apiClient, r.providerConfig)
 }
 
@@ -279,6 +282,7 @@ func (r *amazonAwsExternalServerResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state amazonAwsExternalServerResourceModel
 	readAmazonAwsExternalServerResponse(ctx, addResponse.AmazonAwsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -317,6 +321,12 @@ func (r *defaultAmazonAwsExternalServerResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AmazonAwsExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Amazon Aws External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state amazonAwsExternalServerResourceModel
 	readAmazonAwsExternalServerResponse(ctx, readResponse.AmazonAwsExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -343,6 +353,7 @@ func (r *defaultAmazonAwsExternalServerResource) Create(ctx context.Context, req
 
 		// Read the response
 		readAmazonAwsExternalServerResponse(ctx, updateResponse.AmazonAwsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -375,7 +386,12 @@ func readAmazonAwsExternalServer(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Amazon Aws External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Amazon Aws External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Amazon Aws External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -385,6 +401,13 @@ func readAmazonAwsExternalServer(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AmazonAwsExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Amazon Aws External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAmazonAwsExternalServerResponse(ctx, readResponse.AmazonAwsExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -441,6 +464,7 @@ func updateAmazonAwsExternalServer(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readAmazonAwsExternalServerResponse(ctx, updateResponse.AmazonAwsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -489,4 +513,5 @@ func (r *defaultAmazonAwsExternalServerResource) ImportState(ctx context.Context
 func importAmazonAwsExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &conjurExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &conjurExternalServerResource{}
 	_ resource.ResourceWithImportState = &conjurExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &conjurExternalServerResource{}
 	_ resource.Resource                = &defaultConjurExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultConjurExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultConjurExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultConjurExternalServerResource{}
 )
 
 // Create a Conjur External Server resource
@@ -149,6 +151,15 @@ func conjurExternalServerSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *conjurExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultConjurExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalConjurExternalServerFields(ctx context.Context, addRequest *client.AddConjurExternalServerRequest, plan conjurExternalServerResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -243,6 +254,7 @@ func (r *conjurExternalServerResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state conjurExternalServerResourceModel
 	readConjurExternalServerResponse(ctx, addResponse.ConjurExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -281,6 +293,12 @@ func (r *defaultConjurExternalServerResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConjurExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Conjur External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state conjurExternalServerResourceModel
 	readConjurExternalServerResponse(ctx, readResponse.ConjurExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -307,6 +325,7 @@ func (r *defaultConjurExternalServerResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readConjurExternalServerResponse(ctx, updateResponse.ConjurExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -339,7 +358,12 @@ func readConjurExternalServer(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Conjur External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Conjur External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Conjur External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -349,6 +373,13 @@ func readConjurExternalServer(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConjurExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Conjur External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConjurExternalServerResponse(ctx, readResponse.ConjurExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -405,6 +436,7 @@ func updateConjurExternalServer(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readConjurExternalServerResponse(ctx, updateResponse.ConjurExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -453,4 +485,5 @@ func (r *defaultConjurExternalServerResource) ImportState(ctx context.Context, r
 func importConjurExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &httpExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &httpExternalServerResource{}
 	_ resource.ResourceWithImportState = &httpExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &httpExternalServerResource{}
 	_ resource.Resource                = &defaultHttpExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultHttpExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultHttpExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultHttpExternalServerResource{}
 )
 
 // Create a Http External Server resource
@@ -172,6 +174,15 @@ func httpExternalServerSchema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *httpExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultHttpExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalHttpExternalServerFields(ctx context.Context, addRequest *client.AddHttpExternalServerRequest, plan httpExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -291,6 +302,7 @@ func (r *httpExternalServerResource) Create(ctx context.Context, req resource.Cr
 	// Read the response into the state
 	var state httpExternalServerResourceModel
 	readHttpExternalServerResponse(ctx, addResponse.HttpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -329,6 +341,12 @@ func (r *defaultHttpExternalServerResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HttpExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Http External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state httpExternalServerResourceModel
 	readHttpExternalServerResponse(ctx, readResponse.HttpExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -355,6 +373,7 @@ func (r *defaultHttpExternalServerResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readHttpExternalServerResponse(ctx, updateResponse.HttpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -387,7 +406,12 @@ func readHttpExternalServer(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Http External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -397,6 +421,13 @@ func readHttpExternalServer(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HttpExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Http External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readHttpExternalServerResponse(ctx, readResponse.HttpExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -453,6 +484,7 @@ func updateHttpExternalServer(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readHttpExternalServerResponse(ctx, updateResponse.HttpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -501,4 +533,5 @@ func (r *defaultHttpExternalServerResource) ImportState(ctx context.Context, req
 func importHttpExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
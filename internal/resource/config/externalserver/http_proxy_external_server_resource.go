@@ -142,12 +142,15 @@ func httpProxyExternalServerSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
-// Validate that any version restrictions are met in the plan
+// Plan a replacement if the config object has been replaced with one of a different type, and validate
+// that any version restrictions are met in the plan
 func (r *httpProxyExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
 	modifyPlanHttpProxyExternalServer(ctx, req, resp, r.apiClient, r.providerConfig, "pingdirectory_http_proxy_external_server")
 }
 
 func (r *defaultHttpProxyExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
 	modifyPlanHttpProxyExternalServer(ctx, req, resp, r.apiClient, r.providerConfig, "pingdirectory_default_http_proxy_external_server")
 }
 
@@ -237,6 +240,7 @@ func (r *httpProxyExternalServerResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state httpProxyExternalServerResourceModel
 	readHttpProxyExternalServerResponse(ctx, addResponse.HttpProxyExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -275,6 +279,12 @@ func (r *defaultHttpProxyExternalServerResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HttpProxyExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Http Proxy External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state httpProxyExternalServerResourceModel
 	readHttpProxyExternalServerResponse(ctx, readResponse.HttpProxyExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -301,6 +311,7 @@ func (r *defaultHttpProxyExternalServerResource) Create(ctx context.Context, req
 
 		// Read the response
 		readHttpProxyExternalServerResponse(ctx, updateResponse.HttpProxyExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -333,7 +344,12 @@ func readHttpProxyExternalServer(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Proxy External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Http Proxy External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Proxy External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -343,6 +359,13 @@ func readHttpProxyExternalServer(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HttpProxyExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Http Proxy External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readHttpProxyExternalServerResponse(ctx, readResponse.HttpProxyExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -399,6 +422,7 @@ func updateHttpProxyExternalServer(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readHttpProxyExternalServerResponse(ctx, updateResponse.HttpProxyExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -447,4 +471,5 @@ func (r *defaultHttpProxyExternalServerResource) ImportState(ctx context.Context
 func importHttpProxyExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &jdbcExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &jdbcExternalServerResource{}
 	_ resource.ResourceWithImportState = &jdbcExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &jdbcExternalServerResource{}
 	_ resource.Resource                = &defaultJdbcExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultJdbcExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultJdbcExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJdbcExternalServerResource{}
 )
 
 // Create a Jdbc External Server resource
@@ -188,6 +190,15 @@ func jdbcExternalServerSchema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jdbcExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJdbcExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJdbcExternalServerFields(ctx context.Context, addRequest *client.AddJdbcExternalServerRequest, plan jdbcExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -345,6 +356,7 @@ func (r *jdbcExternalServerResource) Create(ctx context.Context, req resource.Cr
 	// Read the response into the state
 	var state jdbcExternalServerResourceModel
 	readJdbcExternalServerResponse(ctx, addResponse.JdbcExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -383,6 +395,12 @@ func (r *defaultJdbcExternalServerResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JdbcExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jdbc External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jdbcExternalServerResourceModel
 	readJdbcExternalServerResponse(ctx, readResponse.JdbcExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -409,6 +427,7 @@ func (r *defaultJdbcExternalServerResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readJdbcExternalServerResponse(ctx, updateResponse.JdbcExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -441,7 +460,12 @@ func readJdbcExternalServer(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jdbc External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Jdbc External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jdbc External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -451,6 +475,13 @@ func readJdbcExternalServer(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JdbcExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Jdbc External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJdbcExternalServerResponse(ctx, readResponse.JdbcExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -507,6 +538,7 @@ func updateJdbcExternalServer(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readJdbcExternalServerResponse(ctx, updateResponse.JdbcExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -555,4 +587,5 @@ func (r *defaultJdbcExternalServerResource) ImportState(ctx context.Context, req
 func importJdbcExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
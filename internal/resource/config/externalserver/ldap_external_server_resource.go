@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &ldapExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &ldapExternalServerResource{}
 	_ resource.ResourceWithImportState = &ldapExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &ldapExternalServerResource{}
 	_ resource.Resource                = &defaultLdapExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultLdapExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultLdapExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLdapExternalServerResource{}
 )
 
 // Create a Ldap External Server resource
@@ -270,6 +272,15 @@ func ldapExternalServerSchema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *ldapExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultLdapExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalLdapExternalServerFields(ctx context.Context, addRequest *client.AddLdapExternalServerRequest, plan ldapExternalServerResourceModel) error {
 	if internaltypes.IsDefined(plan.ServerPort) {
@@ -497,6 +508,7 @@ func (r *ldapExternalServerResource) Create(ctx context.Context, req resource.Cr
 	// Read the response into the state
 	var state ldapExternalServerResourceModel
 	readLdapExternalServerResponse(ctx, addResponse.LdapExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -535,6 +547,12 @@ func (r *defaultLdapExternalServerResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldap External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldapExternalServerResourceModel
 	readLdapExternalServerResponse(ctx, readResponse.LdapExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -561,6 +579,7 @@ func (r *defaultLdapExternalServerResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readLdapExternalServerResponse(ctx, updateResponse.LdapExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -593,7 +612,12 @@ func readLdapExternalServer(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldap External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -603,6 +627,13 @@ func readLdapExternalServer(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldap External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdapExternalServerResponse(ctx, readResponse.LdapExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -659,6 +690,7 @@ func updateLdapExternalServer(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readLdapExternalServerResponse(ctx, updateResponse.LdapExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -707,4 +739,5 @@ func (r *defaultLdapExternalServerResource) ImportState(ctx context.Context, req
 func importLdapExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &nokiaDsExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &nokiaDsExternalServerResource{}
 	_ resource.ResourceWithImportState = &nokiaDsExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &nokiaDsExternalServerResource{}
 	_ resource.Resource                = &defaultNokiaDsExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultNokiaDsExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultNokiaDsExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultNokiaDsExternalServerResource{}
 )
 
 // Create a Nokia Ds External Server resource
@@ -279,6 +281,15 @@ func nokiaDsExternalServerSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *nokiaDsExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultNokiaDsExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalNokiaDsExternalServerFields(ctx context.Context, addRequest *client.AddNokiaDsExternalServerRequest, plan nokiaDsExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -512,6 +523,7 @@ func (r *nokiaDsExternalServerResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state nokiaDsExternalServerResourceModel
 	readNokiaDsExternalServerResponse(ctx, addResponse.NokiaDsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -550,6 +562,12 @@ func (r *defaultNokiaDsExternalServerResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.NokiaDsExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Nokia Ds External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state nokiaDsExternalServerResourceModel
 	readNokiaDsExternalServerResponse(ctx, readResponse.NokiaDsExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -576,6 +594,7 @@ func (r *defaultNokiaDsExternalServerResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readNokiaDsExternalServerResponse(ctx, updateResponse.NokiaDsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -608,7 +627,12 @@ func readNokiaDsExternalServer(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Nokia Ds External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Nokia Ds External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Nokia Ds External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -618,6 +642,13 @@ func readNokiaDsExternalServer(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.NokiaDsExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Nokia Ds External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readNokiaDsExternalServerResponse(ctx, readResponse.NokiaDsExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -674,6 +705,7 @@ func updateNokiaDsExternalServer(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readNokiaDsExternalServerResponse(ctx, updateResponse.NokiaDsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -722,4 +754,5 @@ func (r *defaultNokiaDsExternalServerResource) ImportState(ctx context.Context,
 func importNokiaDsExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
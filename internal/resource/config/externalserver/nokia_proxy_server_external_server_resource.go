@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &nokiaProxyServerExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &nokiaProxyServerExternalServerResource{}
 	_ resource.ResourceWithImportState = &nokiaProxyServerExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &nokiaProxyServerExternalServerResource{}
 	_ resource.Resource                = &defaultNokiaProxyServerExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultNokiaProxyServerExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultNokiaProxyServerExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultNokiaProxyServerExternalServerResource{}
 )
 
 // Create a Nokia Proxy Server External Server resource
@@ -279,6 +281,15 @@ func nokiaProxyServerExternalServerSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *nokiaProxyServerExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultNokiaProxyServerExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalNokiaProxyServerExternalServerFields(ctx context.Context, addRequest *client.AddNokiaProxyServerExternalServerRequest, plan nokiaProxyServerExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -512,6 +523,7 @@ func (r *nokiaProxyServerExternalServerResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state nokiaProxyServerExternalServerResourceModel
 	readNokiaProxyServerExternalServerResponse(ctx, addResponse.NokiaProxyServerExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -550,6 +562,12 @@ func (r *defaultNokiaProxyServerExternalServerResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.NokiaProxyServerExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Nokia Proxy Server External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state nokiaProxyServerExternalServerResourceModel
 	readNokiaProxyServerExternalServerResponse(ctx, readResponse.NokiaProxyServerExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -576,6 +594,7 @@ func (r *defaultNokiaProxyServerExternalServerResource) Create(ctx context.Conte
 
 		// Read the response
 		readNokiaProxyServerExternalServerResponse(ctx, updateResponse.NokiaProxyServerExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -608,7 +627,12 @@ func readNokiaProxyServerExternalServer(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Nokia Proxy Server External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Nokia Proxy Server External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Nokia Proxy Server External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -618,6 +642,13 @@ func readNokiaProxyServerExternalServer(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.NokiaProxyServerExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Nokia Proxy Server External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readNokiaProxyServerExternalServerResponse(ctx, readResponse.NokiaProxyServerExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -674,6 +705,7 @@ func updateNokiaProxyServerExternalServer(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readNokiaProxyServerExternalServerResponse(ctx, updateResponse.NokiaProxyServerExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -722,4 +754,5 @@ func (r *defaultNokiaProxyServerExternalServerResource) ImportState(ctx context.
 func importNokiaProxyServerExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
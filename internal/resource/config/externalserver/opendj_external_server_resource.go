@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &opendjExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &opendjExternalServerResource{}
 	_ resource.ResourceWithImportState = &opendjExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &opendjExternalServerResource{}
 	_ resource.Resource                = &defaultOpendjExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultOpendjExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultOpendjExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultOpendjExternalServerResource{}
 )
 
 // Create a Opendj External Server resource
@@ -270,6 +272,15 @@ func opendjExternalServerSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *opendjExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultOpendjExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalOpendjExternalServerFields(ctx context.Context, addRequest *client.AddOpendjExternalServerRequest, plan opendjExternalServerResourceModel) error {
 	if internaltypes.IsDefined(plan.ServerPort) {
@@ -497,6 +508,7 @@ func (r *opendjExternalServerResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state opendjExternalServerResourceModel
 	readOpendjExternalServerResponse(ctx, addResponse.OpendjExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -535,6 +547,12 @@ func (r *defaultOpendjExternalServerResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.OpendjExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Opendj External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state opendjExternalServerResourceModel
 	readOpendjExternalServerResponse(ctx, readResponse.OpendjExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -561,6 +579,7 @@ func (r *defaultOpendjExternalServerResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readOpendjExternalServerResponse(ctx, updateResponse.OpendjExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -593,7 +612,12 @@ func readOpendjExternalServer(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Opendj External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Opendj External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Opendj External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -603,6 +627,13 @@ func readOpendjExternalServer(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.OpendjExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Opendj External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readOpendjExternalServerResponse(ctx, readResponse.OpendjExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -659,6 +690,7 @@ func updateOpendjExternalServer(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readOpendjExternalServerResponse(ctx, updateResponse.OpendjExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -707,4 +739,5 @@ func (r *defaultOpendjExternalServerResource) ImportState(ctx context.Context, r
 func importOpendjExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
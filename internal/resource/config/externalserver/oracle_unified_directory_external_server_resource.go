@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &oracleUnifiedDirectoryExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &oracleUnifiedDirectoryExternalServerResource{}
 	_ resource.ResourceWithImportState = &oracleUnifiedDirectoryExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &oracleUnifiedDirectoryExternalServerResource{}
 	_ resource.Resource                = &defaultOracleUnifiedDirectoryExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultOracleUnifiedDirectoryExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultOracleUnifiedDirectoryExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultOracleUnifiedDirectoryExternalServerResource{}
 )
 
 // Create a Oracle Unified Directory External Server resource
@@ -270,6 +272,15 @@ func oracleUnifiedDirectoryExternalServerSchema(ctx context.Context, req resourc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *oracleUnifiedDirectoryExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultOracleUnifiedDirectoryExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalOracleUnifiedDirectoryExternalServerFields(ctx context.Context, addRequest *client.AddOracleUnifiedDirectoryExternalServerRequest, plan oracleUnifiedDirectoryExternalServerResourceModel) error {
 	if internaltypes.IsDefined(plan.ServerPort) {
@@ -497,6 +508,7 @@ func (r *oracleUnifiedDirectoryExternalServerResource) Create(ctx context.Contex
 	// Read the response into the state
 	var state oracleUnifiedDirectoryExternalServerResourceModel
 	readOracleUnifiedDirectoryExternalServerResponse(ctx, addResponse.OracleUnifiedDirectoryExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -535,6 +547,12 @@ func (r *defaultOracleUnifiedDirectoryExternalServerResource) Create(ctx context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.OracleUnifiedDirectoryExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Oracle Unified Directory External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state oracleUnifiedDirectoryExternalServerResourceModel
 	readOracleUnifiedDirectoryExternalServerResponse(ctx, readResponse.OracleUnifiedDirectoryExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -561,6 +579,7 @@ func (r *defaultOracleUnifiedDirectoryExternalServerResource) Create(ctx context
 
 		// Read the response
 		readOracleUnifiedDirectoryExternalServerResponse(ctx, updateResponse.OracleUnifiedDirectoryExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -593,7 +612,12 @@ func readOracleUnifiedDirectoryExternalServer(ctx context.Context, req resource.
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Oracle Unified Directory External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Oracle Unified Directory External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Oracle Unified Directory External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -603,6 +627,13 @@ func readOracleUnifiedDirectoryExternalServer(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.OracleUnifiedDirectoryExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Oracle Unified Directory External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readOracleUnifiedDirectoryExternalServerResponse(ctx, readResponse.OracleUnifiedDirectoryExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -659,6 +690,7 @@ func updateOracleUnifiedDirectoryExternalServer(ctx context.Context, req resourc
 
 		// Read the response
 		readOracleUnifiedDirectoryExternalServerResponse(ctx, updateResponse.OracleUnifiedDirectoryExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -707,4 +739,5 @@ func (r *defaultOracleUnifiedDirectoryExternalServerResource) ImportState(ctx co
 func importOracleUnifiedDirectoryExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
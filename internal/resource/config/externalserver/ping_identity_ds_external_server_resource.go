@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &pingIdentityDsExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &pingIdentityDsExternalServerResource{}
 	_ resource.ResourceWithImportState = &pingIdentityDsExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &pingIdentityDsExternalServerResource{}
 	_ resource.Resource                = &defaultPingIdentityDsExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultPingIdentityDsExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultPingIdentityDsExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPingIdentityDsExternalServerResource{}
 )
 
 // Create a Ping Identity Ds External Server resource
@@ -279,6 +281,15 @@ func pingIdentityDsExternalServerSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *pingIdentityDsExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPingIdentityDsExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPingIdentityDsExternalServerFields(ctx context.Context, addRequest *client.AddPingIdentityDsExternalServerRequest, plan pingIdentityDsExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -512,6 +523,7 @@ func (r *pingIdentityDsExternalServerResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state pingIdentityDsExternalServerResourceModel
 	readPingIdentityDsExternalServerResponse(ctx, addResponse.PingIdentityDsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -550,6 +562,12 @@ func (r *defaultPingIdentityDsExternalServerResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingIdentityDsExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ping Identity Ds External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state pingIdentityDsExternalServerResourceModel
 	readPingIdentityDsExternalServerResponse(ctx, readResponse.PingIdentityDsExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -576,6 +594,7 @@ func (r *defaultPingIdentityDsExternalServerResource) Create(ctx context.Context
 
 		// Read the response
 		readPingIdentityDsExternalServerResponse(ctx, updateResponse.PingIdentityDsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -608,7 +627,12 @@ func readPingIdentityDsExternalServer(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping Identity Ds External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ping Identity Ds External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping Identity Ds External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -618,6 +642,13 @@ func readPingIdentityDsExternalServer(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingIdentityDsExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ping Identity Ds External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPingIdentityDsExternalServerResponse(ctx, readResponse.PingIdentityDsExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -674,6 +705,7 @@ func updatePingIdentityDsExternalServer(ctx context.Context, req resource.Update
 
 		// Read the response
 		readPingIdentityDsExternalServerResponse(ctx, updateResponse.PingIdentityDsExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -722,4 +754,5 @@ func (r *defaultPingIdentityDsExternalServerResource) ImportState(ctx context.Co
 func importPingIdentityDsExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &pingIdentityProxyServerExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &pingIdentityProxyServerExternalServerResource{}
 	_ resource.ResourceWithImportState = &pingIdentityProxyServerExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &pingIdentityProxyServerExternalServerResource{}
 	_ resource.Resource                = &defaultPingIdentityProxyServerExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultPingIdentityProxyServerExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultPingIdentityProxyServerExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPingIdentityProxyServerExternalServerResource{}
 )
 
 // Create a Ping Identity Proxy Server External Server resource
@@ -279,6 +281,15 @@ func pingIdentityProxyServerExternalServerSchema(ctx context.Context, req resour
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *pingIdentityProxyServerExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPingIdentityProxyServerExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPingIdentityProxyServerExternalServerFields(ctx context.Context, addRequest *client.AddPingIdentityProxyServerExternalServerRequest, plan pingIdentityProxyServerExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -512,6 +523,7 @@ func (r *pingIdentityProxyServerExternalServerResource) Create(ctx context.Conte
 	// Read the response into the state
 	var state pingIdentityProxyServerExternalServerResourceModel
 	readPingIdentityProxyServerExternalServerResponse(ctx, addResponse.PingIdentityProxyServerExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -550,6 +562,12 @@ func (r *defaultPingIdentityProxyServerExternalServerResource) Create(ctx contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingIdentityProxyServerExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ping Identity Proxy Server External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state pingIdentityProxyServerExternalServerResourceModel
 	readPingIdentityProxyServerExternalServerResponse(ctx, readResponse.PingIdentityProxyServerExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -576,6 +594,7 @@ func (r *defaultPingIdentityProxyServerExternalServerResource) Create(ctx contex
 
 		// Read the response
 		readPingIdentityProxyServerExternalServerResponse(ctx, updateResponse.PingIdentityProxyServerExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -608,7 +627,12 @@ func readPingIdentityProxyServerExternalServer(ctx context.Context, req resource
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping Identity Proxy Server External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ping Identity Proxy Server External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping Identity Proxy Server External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -618,6 +642,13 @@ func readPingIdentityProxyServerExternalServer(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingIdentityProxyServerExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ping Identity Proxy Server External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPingIdentityProxyServerExternalServerResponse(ctx, readResponse.PingIdentityProxyServerExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -674,6 +705,7 @@ func updatePingIdentityProxyServerExternalServer(ctx context.Context, req resour
 
 		// Read the response
 		readPingIdentityProxyServerExternalServerResponse(ctx, updateResponse.PingIdentityProxyServerExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -722,4 +754,5 @@ func (r *defaultPingIdentityProxyServerExternalServerResource) ImportState(ctx c
 func importPingIdentityProxyServerExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
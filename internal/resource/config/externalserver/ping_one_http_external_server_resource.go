@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &pingOneHttpExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &pingOneHttpExternalServerResource{}
 	_ resource.ResourceWithImportState = &pingOneHttpExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &pingOneHttpExternalServerResource{}
 	_ resource.Resource                = &defaultPingOneHttpExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultPingOneHttpExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultPingOneHttpExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPingOneHttpExternalServerResource{}
 )
 
 // Create a Ping One Http External Server resource
@@ -149,6 +151,15 @@ func pingOneHttpExternalServerSchema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *pingOneHttpExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPingOneHttpExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPingOneHttpExternalServerFields(ctx context.Context, addRequest *client.AddPingOneHttpExternalServerRequest, plan pingOneHttpExternalServerResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -251,6 +262,7 @@ func (r *pingOneHttpExternalServerResource) Create(ctx context.Context, req reso
 	// Read the response into the state
 	var state pingOneHttpExternalServerResourceModel
 	readPingOneHttpExternalServerResponse(ctx, addResponse.PingOneHttpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -289,6 +301,12 @@ func (r *defaultPingOneHttpExternalServerResource) Create(ctx context.Context, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingOneHttpExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ping One Http External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state pingOneHttpExternalServerResourceModel
 	readPingOneHttpExternalServerResponse(ctx, readResponse.PingOneHttpExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -315,6 +333,7 @@ func (r *defaultPingOneHttpExternalServerResource) Create(ctx context.Context, r
 
 		// Read the response
 		readPingOneHttpExternalServerResponse(ctx, updateResponse.PingOneHttpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -347,7 +366,12 @@ func readPingOneHttpExternalServer(ctx context.Context, req resource.ReadRequest
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping One Http External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ping One Http External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping One Http External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -357,6 +381,13 @@ func readPingOneHttpExternalServer(ctx context.Context, req resource.ReadRequest
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingOneHttpExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ping One Http External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPingOneHttpExternalServerResponse(ctx, readResponse.PingOneHttpExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -413,6 +444,7 @@ func updatePingOneHttpExternalServer(ctx context.Context, req resource.UpdateReq
 
 		// Read the response
 		readPingOneHttpExternalServerResponse(ctx, updateResponse.PingOneHttpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -461,4 +493,5 @@ func (r *defaultPingOneHttpExternalServerResource) ImportState(ctx context.Conte
 func importPingOneHttpExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &smtpExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &smtpExternalServerResource{}
 	_ resource.ResourceWithImportState = &smtpExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &smtpExternalServerResource{}
 	_ resource.Resource                = &defaultSmtpExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultSmtpExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultSmtpExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSmtpExternalServerResource{}
 )
 
 // Create a Smtp External Server resource
@@ -173,6 +175,15 @@ func smtpExternalServerSchema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *smtpExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSmtpExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSmtpExternalServerFields(ctx context.Context, addRequest *client.AddSmtpExternalServerRequest, plan smtpExternalServerResourceModel) error {
 	if internaltypes.IsDefined(plan.ServerPort) {
@@ -297,6 +308,7 @@ func (r *smtpExternalServerResource) Create(ctx context.Context, req resource.Cr
 	// Read the response into the state
 	var state smtpExternalServerResourceModel
 	readSmtpExternalServerResponse(ctx, addResponse.SmtpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -335,6 +347,12 @@ func (r *defaultSmtpExternalServerResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SmtpExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Smtp External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state smtpExternalServerResourceModel
 	readSmtpExternalServerResponse(ctx, readResponse.SmtpExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -361,6 +379,7 @@ func (r *defaultSmtpExternalServerResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readSmtpExternalServerResponse(ctx, updateResponse.SmtpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -393,7 +412,12 @@ func readSmtpExternalServer(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Smtp External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Smtp External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Smtp External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -403,6 +427,13 @@ func readSmtpExternalServer(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SmtpExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Smtp External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSmtpExternalServerResponse(ctx, readResponse.SmtpExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -459,6 +490,7 @@ func updateSmtpExternalServer(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readSmtpExternalServerResponse(ctx, updateResponse.SmtpExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -507,4 +539,5 @@ func (r *defaultSmtpExternalServerResource) ImportState(ctx context.Context, req
 func importSmtpExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &syslogExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &syslogExternalServerResource{}
 	_ resource.ResourceWithImportState = &syslogExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogExternalServerResource{}
 	_ resource.Resource                = &defaultSyslogExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogExternalServerResource{}
 )
 
 // Create a Syslog External Server resource
@@ -160,6 +162,15 @@ func syslogExternalServerSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogExternalServerFields(ctx context.Context, addRequest *client.AddSyslogExternalServerRequest, plan syslogExternalServerResourceModel) {
 	if internaltypes.IsDefined(plan.ServerPort) {
@@ -263,6 +274,7 @@ func (r *syslogExternalServerResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state syslogExternalServerResourceModel
 	readSyslogExternalServerResponse(ctx, addResponse.SyslogExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -301,6 +313,12 @@ func (r *defaultSyslogExternalServerResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogExternalServerResourceModel
 	readSyslogExternalServerResponse(ctx, readResponse.SyslogExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -327,6 +345,7 @@ func (r *defaultSyslogExternalServerResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readSyslogExternalServerResponse(ctx, updateResponse.SyslogExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -359,7 +378,12 @@ func readSyslogExternalServer(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -369,6 +393,13 @@ func readSyslogExternalServer(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogExternalServerResponse(ctx, readResponse.SyslogExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -425,6 +456,7 @@ func updateSyslogExternalServer(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readSyslogExternalServerResponse(ctx, updateResponse.SyslogExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -473,4 +505,5 @@ func (r *defaultSyslogExternalServerResource) ImportState(ctx context.Context, r
 func importSyslogExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
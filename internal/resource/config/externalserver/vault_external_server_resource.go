@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &vaultExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &vaultExternalServerResource{}
 	_ resource.ResourceWithImportState = &vaultExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &vaultExternalServerResource{}
 	_ resource.Resource                = &defaultVaultExternalServerResource{}
 	_ resource.ResourceWithConfigure   = &defaultVaultExternalServerResource{}
 	_ resource.ResourceWithImportState = &defaultVaultExternalServerResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultVaultExternalServerResource{}
 )
 
 // Create a Vault External Server resource
@@ -144,6 +146,15 @@ func vaultExternalServerSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *vaultExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultVaultExternalServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalVaultExternalServerFields(ctx context.Context, addRequest *client.AddVaultExternalServerRequest, plan vaultExternalServerResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -235,6 +246,7 @@ func (r *vaultExternalServerResource) Create(ctx context.Context, req resource.C
 	// Read the response into the state
 	var state vaultExternalServerResourceModel
 	readVaultExternalServerResponse(ctx, addResponse.VaultExternalServerResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -273,6 +285,12 @@ func (r *defaultVaultExternalServerResource) Create(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.VaultExternalServerResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Vault External Server", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state vaultExternalServerResourceModel
 	readVaultExternalServerResponse(ctx, readResponse.VaultExternalServerResponse, &state, &state, &resp.Diagnostics)
@@ -299,6 +317,7 @@ func (r *defaultVaultExternalServerResource) Create(ctx context.Context, req res
 
 		// Read the response
 		readVaultExternalServerResponse(ctx, updateResponse.VaultExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -331,7 +350,12 @@ func readVaultExternalServer(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.ExternalServerApi.GetExternalServer(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Vault External Server", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Vault External Server", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Vault External Server", err, httpResp)
+		}
 		return
 	}
 
@@ -341,6 +365,13 @@ func readVaultExternalServer(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.VaultExternalServerResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Vault External Server", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readVaultExternalServerResponse(ctx, readResponse.VaultExternalServerResponse, &state, &state, &resp.Diagnostics)
 
@@ -397,6 +428,7 @@ func updateVaultExternalServer(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readVaultExternalServerResponse(ctx, updateResponse.VaultExternalServerResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -445,4 +477,5 @@ func (r *defaultVaultExternalServerResource) ImportState(ctx context.Context, re
 func importVaultExternalServer(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &indicatorGaugeResource{}
 	_ resource.ResourceWithConfigure   = &indicatorGaugeResource{}
 	_ resource.ResourceWithImportState = &indicatorGaugeResource{}
+	_ resource.ResourceWithModifyPlan  = &indicatorGaugeResource{}
 	_ resource.Resource                = &defaultIndicatorGaugeResource{}
 	_ resource.ResourceWithConfigure   = &defaultIndicatorGaugeResource{}
 	_ resource.ResourceWithImportState = &defaultIndicatorGaugeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultIndicatorGaugeResource{}
 )
 
 // Create a Indicator Gauge resource
@@ -236,6 +238,15 @@ func indicatorGaugeSchema(ctx context.Context, req resource.SchemaRequest, resp
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *indicatorGaugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultIndicatorGaugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalIndicatorGaugeFields(ctx context.Context, addRequest *client.AddIndicatorGaugeRequest, plan indicatorGaugeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -412,6 +423,7 @@ func (r *indicatorGaugeResource) Create(ctx context.Context, req resource.Create
 	// Read the response into the state
 	var state indicatorGaugeResourceModel
 	readIndicatorGaugeResponse(ctx, addResponse.IndicatorGaugeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -450,6 +462,12 @@ func (r *defaultIndicatorGaugeResource) Create(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.IndicatorGaugeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Indicator Gauge", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state indicatorGaugeResourceModel
 	readIndicatorGaugeResponse(ctx, readResponse.IndicatorGaugeResponse, &state, &state, &resp.Diagnostics)
@@ -476,6 +494,7 @@ func (r *defaultIndicatorGaugeResource) Create(ctx context.Context, req resource
 
 		// Read the response
 		readIndicatorGaugeResponse(ctx, updateResponse.IndicatorGaugeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -508,7 +527,12 @@ func readIndicatorGauge(ctx context.Context, req resource.ReadRequest, resp *res
 	readResponse, httpResp, err := apiClient.GaugeApi.GetGauge(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Indicator Gauge", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Indicator Gauge", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Indicator Gauge", err, httpResp)
+		}
 		return
 	}
 
@@ -518,6 +542,13 @@ func readIndicatorGauge(ctx context.Context, req resource.ReadRequest, resp *res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.IndicatorGaugeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Indicator Gauge", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readIndicatorGaugeResponse(ctx, readResponse.IndicatorGaugeResponse, &state, &state, &resp.Diagnostics)
 
@@ -574,6 +605,7 @@ func updateIndicatorGauge(ctx context.Context, req resource.UpdateRequest, resp
 
 		// Read the response
 		readIndicatorGaugeResponse(ctx, updateResponse.IndicatorGaugeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -622,4 +654,5 @@ func (r *defaultIndicatorGaugeResource) ImportState(ctx context.Context, req res
 func importIndicatorGauge(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
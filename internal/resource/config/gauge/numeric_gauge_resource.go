@@ -27,9 +27,11 @@ var (
 	_ resource.Resource                = &numericGaugeResource{}
 	_ resource.ResourceWithConfigure   = &numericGaugeResource{}
 	_ resource.ResourceWithImportState = &numericGaugeResource{}
+	_ resource.ResourceWithModifyPlan  = &numericGaugeResource{}
 	_ resource.Resource                = &defaultNumericGaugeResource{}
 	_ resource.ResourceWithConfigure   = &defaultNumericGaugeResource{}
 	_ resource.ResourceWithImportState = &defaultNumericGaugeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultNumericGaugeResource{}
 )
 
 // Create a Numeric Gauge resource
@@ -273,6 +275,15 @@ func numericGaugeSchema(ctx context.Context, req resource.SchemaRequest, resp *r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *numericGaugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultNumericGaugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalNumericGaugeFields(ctx context.Context, addRequest *client.AddNumericGaugeRequest, plan numericGaugeResourceModel) error {
 	if internaltypes.IsDefined(plan.CriticalValue) {
@@ -469,6 +480,7 @@ func (r *numericGaugeResource) Create(ctx context.Context, req resource.CreateRe
 	// Read the response into the state
 	var state numericGaugeResourceModel
 	readNumericGaugeResponse(ctx, addResponse.NumericGaugeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -507,6 +519,12 @@ func (r *defaultNumericGaugeResource) Create(ctx context.Context, req resource.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.NumericGaugeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Numeric Gauge", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state numericGaugeResourceModel
 	readNumericGaugeResponse(ctx, readResponse.NumericGaugeResponse, &state, &state, &resp.Diagnostics)
@@ -533,6 +551,7 @@ func (r *defaultNumericGaugeResource) Create(ctx context.Context, req resource.C
 
 		// Read the response
 		readNumericGaugeResponse(ctx, updateResponse.NumericGaugeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -565,7 +584,12 @@ func readNumericGauge(ctx context.Context, req resource.ReadRequest, resp *resou
 	readResponse, httpResp, err := apiClient.GaugeApi.GetGauge(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Numeric Gauge", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Numeric Gauge", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Numeric Gauge", err, httpResp)
+		}
 		return
 	}
 
@@ -575,6 +599,13 @@ func readNumericGauge(ctx context.Context, req resource.ReadRequest, resp *resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.NumericGaugeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Numeric Gauge", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readNumericGaugeResponse(ctx, readResponse.NumericGaugeResponse, &state, &state, &resp.Diagnostics)
 
@@ -631,6 +662,7 @@ func updateNumericGauge(ctx context.Context, req resource.UpdateRequest, resp *r
 
 		// Read the response
 		readNumericGaugeResponse(ctx, updateResponse.NumericGaugeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -679,4 +711,5 @@ func (r *defaultNumericGaugeResource) ImportState(ctx context.Context, req resou
 func importNumericGauge(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -1192,6 +1192,7 @@ func (r *globalConfigurationResource) Create(ctx context.Context, req resource.C
 
 		// Read the response
 		readGlobalConfigurationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -1216,7 +1217,12 @@ func (r *globalConfigurationResource) Read(ctx context.Context, req resource.Rea
 	readResponse, httpResp, err := r.apiClient.GlobalConfigurationApi.GetGlobalConfiguration(
 		ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Global Configuration", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Global Configuration", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Global Configuration", err, httpResp)
+		}
 		return
 	}
 
@@ -1274,6 +1280,7 @@ func (r *globalConfigurationResource) Update(ctx context.Context, req resource.U
 
 		// Read the response
 		readGlobalConfigurationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
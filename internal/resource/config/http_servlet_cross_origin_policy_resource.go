@@ -274,6 +274,7 @@ func (r *httpServletCrossOriginPolicyResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state httpServletCrossOriginPolicyResourceModel
 	readHttpServletCrossOriginPolicyResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -338,6 +339,7 @@ func (r *defaultHttpServletCrossOriginPolicyResource) Create(ctx context.Context
 
 		// Read the response
 		readHttpServletCrossOriginPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -370,7 +372,12 @@ func readHttpServletCrossOriginPolicy(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.HttpServletCrossOriginPolicyApi.GetHttpServletCrossOriginPolicy(
 		ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Servlet Cross Origin Policy", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Http Servlet Cross Origin Policy", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Http Servlet Cross Origin Policy", err, httpResp)
+		}
 		return
 	}
 
@@ -436,6 +443,7 @@ func updateHttpServletCrossOriginPolicy(ctx context.Context, req resource.Update
 
 		// Read the response
 		readHttpServletCrossOriginPolicyResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
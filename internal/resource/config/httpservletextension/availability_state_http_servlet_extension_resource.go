@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &availabilityStateHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &availabilityStateHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &availabilityStateHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &availabilityStateHttpServletExtensionResource{}
 	_ resource.Resource                = &defaultAvailabilityStateHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultAvailabilityStateHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultAvailabilityStateHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAvailabilityStateHttpServletExtensionResource{}
 )
 
 // Create a Availability State Http Servlet Extension resource
@@ -182,6 +184,15 @@ func availabilityStateHttpServletExtensionSchema(ctx context.Context, req resour
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *availabilityStateHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAvailabilityStateHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAvailabilityStateHttpServletExtensionFields(ctx context.Context, addRequest *client.AddAvailabilityStateHttpServletExtensionRequest, plan availabilityStateHttpServletExtensionResourceModel) {
 	if internaltypes.IsDefined(plan.OverrideStatusCode) {
@@ -295,6 +306,7 @@ func (r *availabilityStateHttpServletExtensionResource) Create(ctx context.Conte
 	// Read the response into the state
 	var state availabilityStateHttpServletExtensionResourceModel
 	readAvailabilityStateHttpServletExtensionResponse(ctx, addResponse.AvailabilityStateHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -333,6 +345,12 @@ func (r *defaultAvailabilityStateHttpServletExtensionResource) Create(ctx contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AvailabilityStateHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Availability State Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state availabilityStateHttpServletExtensionResourceModel
 	readAvailabilityStateHttpServletExtensionResponse(ctx, readResponse.AvailabilityStateHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -359,6 +377,7 @@ func (r *defaultAvailabilityStateHttpServletExtensionResource) Create(ctx contex
 
 		// Read the response
 		readAvailabilityStateHttpServletExtensionResponse(ctx, updateResponse.AvailabilityStateHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -391,7 +410,12 @@ func readAvailabilityStateHttpServletExtension(ctx context.Context, req resource
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Availability State Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Availability State Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Availability State Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -401,6 +425,13 @@ func readAvailabilityStateHttpServletExtension(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AvailabilityStateHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Availability State Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAvailabilityStateHttpServletExtensionResponse(ctx, readResponse.AvailabilityStateHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -457,6 +488,7 @@ func updateAvailabilityStateHttpServletExtension(ctx context.Context, req resour
 
 		// Read the response
 		readAvailabilityStateHttpServletExtensionResponse(ctx, updateResponse.AvailabilityStateHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -505,4 +537,5 @@ func (r *defaultAvailabilityStateHttpServletExtensionResource) ImportState(ctx c
 func importAvailabilityStateHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &configHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &configHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &configHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &configHttpServletExtensionResource{}
 )
 
 // Create a Config Http Servlet Extension resource
@@ -117,6 +118,11 @@ func (r *configHttpServletExtensionResource) Schema(ctx context.Context, req res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *configHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ConfigHttpServletExtensionResponse object into the model struct
 func readConfigHttpServletExtensionResponse(ctx context.Context, r *client.ConfigHttpServletExtensionResponse, state *configHttpServletExtensionResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -165,6 +171,12 @@ func (r *configHttpServletExtensionResource) Create(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConfigHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Config Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state configHttpServletExtensionResourceModel
 	readConfigHttpServletExtensionResponse(ctx, readResponse.ConfigHttpServletExtensionResponse, &state, &resp.Diagnostics)
@@ -191,6 +203,7 @@ func (r *configHttpServletExtensionResource) Create(ctx context.Context, req res
 
 		// Read the response
 		readConfigHttpServletExtensionResponse(ctx, updateResponse.ConfigHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -215,7 +228,12 @@ func (r *configHttpServletExtensionResource) Read(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Config Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Config Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Config Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -225,6 +243,13 @@ func (r *configHttpServletExtensionResource) Read(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConfigHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Config Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConfigHttpServletExtensionResponse(ctx, readResponse.ConfigHttpServletExtensionResponse, &state, &resp.Diagnostics)
 
@@ -273,6 +298,7 @@ func (r *configHttpServletExtensionResource) Update(ctx context.Context, req res
 
 		// Read the response
 		readConfigHttpServletExtensionResponse(ctx, updateResponse.ConfigHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -296,4 +322,5 @@ func (r *configHttpServletExtensionResource) Delete(ctx context.Context, req res
 func (r *configHttpServletExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
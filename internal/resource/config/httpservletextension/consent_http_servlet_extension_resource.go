@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &consentHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &consentHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &consentHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &consentHttpServletExtensionResource{}
 )
 
 // Create a Consent Http Servlet Extension resource
@@ -146,6 +147,11 @@ func (r *consentHttpServletExtensionResource) Schema(ctx context.Context, req re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *consentHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ConsentHttpServletExtensionResponse object into the model struct
 func readConsentHttpServletExtensionResponse(ctx context.Context, r *client.ConsentHttpServletExtensionResponse, state *consentHttpServletExtensionResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -200,6 +206,12 @@ func (r *consentHttpServletExtensionResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsentHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Consent Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state consentHttpServletExtensionResourceModel
 	readConsentHttpServletExtensionResponse(ctx, readResponse.ConsentHttpServletExtensionResponse, &state, &resp.Diagnostics)
@@ -226,6 +238,7 @@ func (r *consentHttpServletExtensionResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readConsentHttpServletExtensionResponse(ctx, updateResponse.ConsentHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -250,7 +263,12 @@ func (r *consentHttpServletExtensionResource) Read(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Consent Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Consent Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -260,6 +278,13 @@ func (r *consentHttpServletExtensionResource) Read(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsentHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Consent Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConsentHttpServletExtensionResponse(ctx, readResponse.ConsentHttpServletExtensionResponse, &state, &resp.Diagnostics)
 
@@ -308,6 +333,7 @@ func (r *consentHttpServletExtensionResource) Update(ctx context.Context, req re
 
 		// Read the response
 		readConsentHttpServletExtensionResponse(ctx, updateResponse.ConsentHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -331,4 +357,5 @@ func (r *consentHttpServletExtensionResource) Delete(ctx context.Context, req re
 func (r *consentHttpServletExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
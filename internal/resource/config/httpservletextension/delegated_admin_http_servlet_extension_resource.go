@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &delegatedAdminHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &delegatedAdminHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &delegatedAdminHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &delegatedAdminHttpServletExtensionResource{}
 )
 
 // Create a Delegated Admin Http Servlet Extension resource
@@ -155,6 +156,11 @@ func (r *delegatedAdminHttpServletExtensionResource) Schema(ctx context.Context,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *delegatedAdminHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a DelegatedAdminHttpServletExtensionResponse object into the model struct
 func readDelegatedAdminHttpServletExtensionResponse(ctx context.Context, r *client.DelegatedAdminHttpServletExtensionResponse, state *delegatedAdminHttpServletExtensionResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -211,6 +217,12 @@ func (r *delegatedAdminHttpServletExtensionResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DelegatedAdminHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Delegated Admin Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state delegatedAdminHttpServletExtensionResourceModel
 	readDelegatedAdminHttpServletExtensionResponse(ctx, readResponse.DelegatedAdminHttpServletExtensionResponse, &state, &resp.Diagnostics)
@@ -237,6 +249,7 @@ func (r *delegatedAdminHttpServletExtensionResource) Create(ctx context.Context,
 
 		// Read the response
 		readDelegatedAdminHttpServletExtensionResponse(ctx, updateResponse.DelegatedAdminHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -261,7 +274,12 @@ func (r *delegatedAdminHttpServletExtensionResource) Read(ctx context.Context, r
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Delegated Admin Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delegated Admin Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -271,6 +289,13 @@ func (r *delegatedAdminHttpServletExtensionResource) Read(ctx context.Context, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DelegatedAdminHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Delegated Admin Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDelegatedAdminHttpServletExtensionResponse(ctx, readResponse.DelegatedAdminHttpServletExtensionResponse, &state, &resp.Diagnostics)
 
@@ -319,6 +344,7 @@ func (r *delegatedAdminHttpServletExtensionResource) Update(ctx context.Context,
 
 		// Read the response
 		readDelegatedAdminHttpServletExtensionResponse(ctx, updateResponse.DelegatedAdminHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -342,4 +368,5 @@ func (r *delegatedAdminHttpServletExtensionResource) Delete(ctx context.Context,
 func (r *delegatedAdminHttpServletExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
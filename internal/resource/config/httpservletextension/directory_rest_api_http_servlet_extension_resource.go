@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &directoryRestApiHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &directoryRestApiHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &directoryRestApiHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &directoryRestApiHttpServletExtensionResource{}
 )
 
 // Create a Directory Rest Api Http Servlet Extension resource
@@ -205,6 +206,11 @@ func (r *directoryRestApiHttpServletExtensionResource) Schema(ctx context.Contex
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *directoryRestApiHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a DirectoryRestApiHttpServletExtensionResponse object into the model struct
 func readDirectoryRestApiHttpServletExtensionResponse(ctx context.Context, r *client.DirectoryRestApiHttpServletExtensionResponse, state *directoryRestApiHttpServletExtensionResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -272,6 +278,12 @@ func (r *directoryRestApiHttpServletExtensionResource) Create(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DirectoryRestApiHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Directory Rest Api Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state directoryRestApiHttpServletExtensionResourceModel
 	readDirectoryRestApiHttpServletExtensionResponse(ctx, readResponse.DirectoryRestApiHttpServletExtensionResponse, &state, &resp.Diagnostics)
@@ -298,6 +310,7 @@ func (r *directoryRestApiHttpServletExtensionResource) Create(ctx context.Contex
 
 		// Read the response
 		readDirectoryRestApiHttpServletExtensionResponse(ctx, updateResponse.DirectoryRestApiHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -322,7 +335,12 @@ func (r *directoryRestApiHttpServletExtensionResource) Read(ctx context.Context,
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Directory Rest Api Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Directory Rest Api Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Directory Rest Api Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -332,6 +350,13 @@ func (r *directoryRestApiHttpServletExtensionResource) Read(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DirectoryRestApiHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Directory Rest Api Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDirectoryRestApiHttpServletExtensionResponse(ctx, readResponse.DirectoryRestApiHttpServletExtensionResponse, &state, &resp.Diagnostics)
 
@@ -380,6 +405,7 @@ func (r *directoryRestApiHttpServletExtensionResource) Update(ctx context.Contex
 
 		// Read the response
 		readDirectoryRestApiHttpServletExtensionResponse(ctx, updateResponse.DirectoryRestApiHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -403,4 +429,5 @@ func (r *directoryRestApiHttpServletExtensionResource) Delete(ctx context.Contex
 func (r *directoryRestApiHttpServletExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
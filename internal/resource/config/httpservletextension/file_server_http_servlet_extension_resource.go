@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &fileServerHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &fileServerHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &fileServerHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &fileServerHttpServletExtensionResource{}
 	_ resource.Resource                = &defaultFileServerHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileServerHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultFileServerHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileServerHttpServletExtensionResource{}
 )
 
 // Create a File Server Http Servlet Extension resource
@@ -257,6 +259,15 @@ func fileServerHttpServletExtensionSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileServerHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileServerHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileServerHttpServletExtensionFields(ctx context.Context, addRequest *client.AddFileServerHttpServletExtensionRequest, plan fileServerHttpServletExtensionResourceModel) error {
 	if internaltypes.IsDefined(plan.EnableDirectoryIndexing) {
@@ -433,6 +444,7 @@ func (r *fileServerHttpServletExtensionResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state fileServerHttpServletExtensionResourceModel
 	readFileServerHttpServletExtensionResponse(ctx, addResponse.FileServerHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -471,6 +483,12 @@ func (r *defaultFileServerHttpServletExtensionResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileServerHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Server Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileServerHttpServletExtensionResourceModel
 	readFileServerHttpServletExtensionResponse(ctx, readResponse.FileServerHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -497,6 +515,7 @@ func (r *defaultFileServerHttpServletExtensionResource) Create(ctx context.Conte
 
 		// Read the response
 		readFileServerHttpServletExtensionResponse(ctx, updateResponse.FileServerHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -529,7 +548,12 @@ func readFileServerHttpServletExtension(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Server Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Server Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Server Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -539,6 +563,13 @@ func readFileServerHttpServletExtension(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileServerHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Server Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileServerHttpServletExtensionResponse(ctx, readResponse.FileServerHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -595,6 +626,7 @@ func updateFileServerHttpServletExtension(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readFileServerHttpServletExtensionResponse(ctx, updateResponse.FileServerHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -643,4 +675,5 @@ func (r *defaultFileServerHttpServletExtensionResource) ImportState(ctx context.
 func importFileServerHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &groovyScriptedHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedHttpServletExtensionResource{}
 	_ resource.Resource                = &defaultGroovyScriptedHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedHttpServletExtensionResource{}
 )
 
 // Create a Groovy Scripted Http Servlet Extension resource
@@ -157,6 +159,15 @@ func groovyScriptedHttpServletExtensionSchema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedHttpServletExtensionFields(ctx context.Context, addRequest *client.AddGroovyScriptedHttpServletExtensionRequest, plan groovyScriptedHttpServletExtensionResourceModel) {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -249,6 +260,7 @@ func (r *groovyScriptedHttpServletExtensionResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state groovyScriptedHttpServletExtensionResourceModel
 	readGroovyScriptedHttpServletExtensionResponse(ctx, addResponse.GroovyScriptedHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -287,6 +299,12 @@ func (r *defaultGroovyScriptedHttpServletExtensionResource) Create(ctx context.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedHttpServletExtensionResourceModel
 	readGroovyScriptedHttpServletExtensionResponse(ctx, readResponse.GroovyScriptedHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -313,6 +331,7 @@ func (r *defaultGroovyScriptedHttpServletExtensionResource) Create(ctx context.C
 
 		// Read the response
 		readGroovyScriptedHttpServletExtensionResponse(ctx, updateResponse.GroovyScriptedHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -345,7 +364,12 @@ func readGroovyScriptedHttpServletExtension(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -355,6 +379,13 @@ func readGroovyScriptedHttpServletExtension(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedHttpServletExtensionResponse(ctx, readResponse.GroovyScriptedHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -411,6 +442,7 @@ func updateGroovyScriptedHttpServletExtension(ctx context.Context, req resource.
 
 		// Read the response
 		readGroovyScriptedHttpServletExtensionResponse(ctx, updateResponse.GroovyScriptedHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -459,4 +491,5 @@ func (r *defaultGroovyScriptedHttpServletExtensionResource) ImportState(ctx cont
 func importGroovyScriptedHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
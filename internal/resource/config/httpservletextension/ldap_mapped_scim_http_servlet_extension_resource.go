@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &ldapMappedScimHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &ldapMappedScimHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &ldapMappedScimHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &ldapMappedScimHttpServletExtensionResource{}
 	_ resource.Resource                = &defaultLdapMappedScimHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultLdapMappedScimHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultLdapMappedScimHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLdapMappedScimHttpServletExtensionResource{}
 )
 
 // Create a Ldap Mapped Scim Http Servlet Extension resource
@@ -321,6 +323,15 @@ func ldapMappedScimHttpServletExtensionSchema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *ldapMappedScimHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultLdapMappedScimHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalLdapMappedScimHttpServletExtensionFields(ctx context.Context, addRequest *client.AddLdapMappedScimHttpServletExtensionRequest, plan ldapMappedScimHttpServletExtensionResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -556,6 +567,7 @@ func (r *ldapMappedScimHttpServletExtensionResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state ldapMappedScimHttpServletExtensionResourceModel
 	readLdapMappedScimHttpServletExtensionResponse(ctx, addResponse.LdapMappedScimHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -594,6 +606,12 @@ func (r *defaultLdapMappedScimHttpServletExtensionResource) Create(ctx context.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapMappedScimHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldap Mapped Scim Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldapMappedScimHttpServletExtensionResourceModel
 	readLdapMappedScimHttpServletExtensionResponse(ctx, readResponse.LdapMappedScimHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -620,6 +638,7 @@ func (r *defaultLdapMappedScimHttpServletExtensionResource) Create(ctx context.C
 
 		// Read the response
 		readLdapMappedScimHttpServletExtensionResponse(ctx, updateResponse.LdapMappedScimHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -652,7 +671,12 @@ func readLdapMappedScimHttpServletExtension(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Mapped Scim Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldap Mapped Scim Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Mapped Scim Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -662,6 +686,13 @@ func readLdapMappedScimHttpServletExtension(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapMappedScimHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldap Mapped Scim Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdapMappedScimHttpServletExtensionResponse(ctx, readResponse.LdapMappedScimHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -718,6 +749,7 @@ func updateLdapMappedScimHttpServletExtension(ctx context.Context, req resource.
 
 		// Read the response
 		readLdapMappedScimHttpServletExtensionResponse(ctx, updateResponse.LdapMappedScimHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -766,4 +798,5 @@ func (r *defaultLdapMappedScimHttpServletExtensionResource) ImportState(ctx cont
 func importLdapMappedScimHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
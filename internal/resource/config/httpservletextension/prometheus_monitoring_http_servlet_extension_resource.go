@@ -217,12 +217,15 @@ func prometheusMonitoringHttpServletExtensionSchema(ctx context.Context, req res
 	resp.Schema = schema
 }
 
-// Validate that any version restrictions are met in the plan
+// Plan a replacement if the config object has been replaced with one of a different type, and validate
+// that any version restrictions are met in the plan
 func (r *prometheusMonitoringHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
 	modifyPlanPrometheusMonitoringHttpServletExtension(ctx, req, resp, r.apiClient, r.providerConfig, "pingdirectory_prometheus_monitoring_http_servlet_extension")
 }
 
 func (r *defaultPrometheusMonitoringHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
 	modifyPlanPrometheusMonitoringHttpServletExtension(ctx, req, resp, r.apiClient, r.providerConfig, "pingdirectory_default_prometheus_monitoring_http_servlet_extension")
 }
 
@@ -363,6 +366,7 @@ func (r *prometheusMonitoringHttpServletExtensionResource) Create(ctx context.Co
 	// Read the response into the state
 	var state prometheusMonitoringHttpServletExtensionResourceModel
 	readPrometheusMonitoringHttpServletExtensionResponse(ctx, addResponse.PrometheusMonitoringHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -401,6 +405,12 @@ func (r *defaultPrometheusMonitoringHttpServletExtensionResource) Create(ctx con
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PrometheusMonitoringHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Prometheus Monitoring Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state prometheusMonitoringHttpServletExtensionResourceModel
 	readPrometheusMonitoringHttpServletExtensionResponse(ctx, readResponse.PrometheusMonitoringHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -427,6 +437,7 @@ func (r *defaultPrometheusMonitoringHttpServletExtensionResource) Create(ctx con
 
 		// Read the response
 		readPrometheusMonitoringHttpServletExtensionResponse(ctx, updateResponse.PrometheusMonitoringHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -459,7 +470,12 @@ func readPrometheusMonitoringHttpServletExtension(ctx context.Context, req resou
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Prometheus Monitoring Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Prometheus Monitoring Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Prometheus Monitoring Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -469,6 +485,13 @@ func readPrometheusMonitoringHttpServletExtension(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PrometheusMonitoringHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Prometheus Monitoring Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPrometheusMonitoringHttpServletExtensionResponse(ctx, readResponse.PrometheusMonitoringHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -525,6 +548,7 @@ func updatePrometheusMonitoringHttpServletExtension(ctx context.Context, req res
 
 		// Read the response
 		readPrometheusMonitoringHttpServletExtensionResponse(ctx, updateResponse.PrometheusMonitoringHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -573,4 +597,5 @@ func (r *defaultPrometheusMonitoringHttpServletExtensionResource) ImportState(ct
 func importPrometheusMonitoringHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
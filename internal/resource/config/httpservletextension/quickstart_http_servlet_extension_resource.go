@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &quickstartHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &quickstartHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &quickstartHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &quickstartHttpServletExtensionResource{}
 	_ resource.Resource                = &defaultQuickstartHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultQuickstartHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultQuickstartHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultQuickstartHttpServletExtensionResource{}
 )
 
 // Create a Quickstart Http Servlet Extension resource
@@ -147,6 +149,15 @@ func quickstartHttpServletExtensionSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *quickstartHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultQuickstartHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalQuickstartHttpServletExtensionFields(ctx context.Context, addRequest *client.AddQuickstartHttpServletExtensionRequest, plan quickstartHttpServletExtensionResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -236,6 +247,7 @@ func (r *quickstartHttpServletExtensionResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state quickstartHttpServletExtensionResourceModel
 	readQuickstartHttpServletExtensionResponse(ctx, addResponse.QuickstartHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -274,6 +286,12 @@ func (r *defaultQuickstartHttpServletExtensionResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.QuickstartHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Quickstart Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state quickstartHttpServletExtensionResourceModel
 	readQuickstartHttpServletExtensionResponse(ctx, readResponse.QuickstartHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -300,6 +318,7 @@ func (r *defaultQuickstartHttpServletExtensionResource) Create(ctx context.Conte
 
 		// Read the response
 		readQuickstartHttpServletExtensionResponse(ctx, updateResponse.QuickstartHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -332,7 +351,12 @@ func readQuickstartHttpServletExtension(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Quickstart Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Quickstart Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Quickstart Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -342,6 +366,13 @@ func readQuickstartHttpServletExtension(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.QuickstartHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Quickstart Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readQuickstartHttpServletExtensionResponse(ctx, readResponse.QuickstartHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -398,6 +429,7 @@ func updateQuickstartHttpServletExtension(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readQuickstartHttpServletExtensionResponse(ctx, updateResponse.QuickstartHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -446,4 +478,5 @@ func (r *defaultQuickstartHttpServletExtensionResource) ImportState(ctx context.
 func importQuickstartHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &scim2HttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &scim2HttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &scim2HttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &scim2HttpServletExtensionResource{}
 )
 
 // Create a Scim2 Http Servlet Extension resource
@@ -183,6 +184,11 @@ func (r *scim2HttpServletExtensionResource) Schema(ctx context.Context, req reso
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *scim2HttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a Scim2HttpServletExtensionResponse object into the model struct
 func readScim2HttpServletExtensionResponse(ctx context.Context, r *client.Scim2HttpServletExtensionResponse, state *scim2HttpServletExtensionResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -247,6 +253,12 @@ func (r *scim2HttpServletExtensionResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.Scim2HttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Scim2 Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state scim2HttpServletExtensionResourceModel
 	readScim2HttpServletExtensionResponse(ctx, readResponse.Scim2HttpServletExtensionResponse, &state, &resp.Diagnostics)
@@ -273,6 +285,7 @@ func (r *scim2HttpServletExtensionResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readScim2HttpServletExtensionResponse(ctx, updateResponse.Scim2HttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -297,7 +310,12 @@ func (r *scim2HttpServletExtensionResource) Read(ctx context.Context, req resour
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim2 Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Scim2 Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Scim2 Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -307,6 +325,13 @@ func (r *scim2HttpServletExtensionResource) Read(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.Scim2HttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Scim2 Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readScim2HttpServletExtensionResponse(ctx, readResponse.Scim2HttpServletExtensionResponse, &state, &resp.Diagnostics)
 
@@ -355,6 +380,7 @@ func (r *scim2HttpServletExtensionResource) Update(ctx context.Context, req reso
 
 		// Read the response
 		readScim2HttpServletExtensionResponse(ctx, updateResponse.Scim2HttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -378,4 +404,5 @@ func (r *scim2HttpServletExtensionResource) Delete(ctx context.Context, req reso
 func (r *scim2HttpServletExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &thirdPartyHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyHttpServletExtensionResource{}
 	_ resource.Resource                = &defaultThirdPartyHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyHttpServletExtensionResource{}
 )
 
 // Create a Third Party Http Servlet Extension resource
@@ -157,6 +159,15 @@ func thirdPartyHttpServletExtensionSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyHttpServletExtensionFields(ctx context.Context, addRequest *client.AddThirdPartyHttpServletExtensionRequest, plan thirdPartyHttpServletExtensionResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -249,6 +260,7 @@ func (r *thirdPartyHttpServletExtensionResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state thirdPartyHttpServletExtensionResourceModel
 	readThirdPartyHttpServletExtensionResponse(ctx, addResponse.ThirdPartyHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -287,6 +299,12 @@ func (r *defaultThirdPartyHttpServletExtensionResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyHttpServletExtensionResourceModel
 	readThirdPartyHttpServletExtensionResponse(ctx, readResponse.ThirdPartyHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
@@ -313,6 +331,7 @@ func (r *defaultThirdPartyHttpServletExtensionResource) Create(ctx context.Conte
 
 		// Read the response
 		readThirdPartyHttpServletExtensionResponse(ctx, updateResponse.ThirdPartyHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -345,7 +364,12 @@ func readThirdPartyHttpServletExtension(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -355,6 +379,13 @@ func readThirdPartyHttpServletExtension(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyHttpServletExtensionResponse(ctx, readResponse.ThirdPartyHttpServletExtensionResponse, &state, &state, &resp.Diagnostics)
 
@@ -411,6 +442,7 @@ func updateThirdPartyHttpServletExtension(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readThirdPartyHttpServletExtensionResponse(ctx, updateResponse.ThirdPartyHttpServletExtensionResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -459,4 +491,5 @@ func (r *defaultThirdPartyHttpServletExtensionResource) ImportState(ctx context.
 func importThirdPartyHttpServletExtension(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &velocityHttpServletExtensionResource{}
 	_ resource.ResourceWithConfigure   = &velocityHttpServletExtensionResource{}
 	_ resource.ResourceWithImportState = &velocityHttpServletExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &velocityHttpServletExtensionResource{}
 )
 
 // Create a Velocity Http Servlet Extension resource
@@ -246,6 +247,11 @@ func (r *velocityHttpServletExtensionResource) Schema(ctx context.Context, req r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *velocityHttpServletExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a VelocityHttpServletExtensionResponse object into the model struct
 func readVelocityHttpServletExtensionResponse(ctx context.Context, r *client.VelocityHttpServletExtensionResponse, state *velocityHttpServletExtensionResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -322,6 +328,12 @@ func (r *velocityHttpServletExtensionResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.VelocityHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Velocity Http Servlet Extension", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state velocityHttpServletExtensionResourceModel
 	readVelocityHttpServletExtensionResponse(ctx, readResponse.VelocityHttpServletExtensionResponse, &state, &resp.Diagnostics)
@@ -348,6 +360,7 @@ func (r *velocityHttpServletExtensionResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readVelocityHttpServletExtensionResponse(ctx, updateResponse.VelocityHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -372,7 +385,12 @@ func (r *velocityHttpServletExtensionResource) Read(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.HttpServletExtensionApi.GetHttpServletExtension(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Velocity Http Servlet Extension", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Velocity Http Servlet Extension", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Velocity Http Servlet Extension", err, httpResp)
+		}
 		return
 	}
 
@@ -382,6 +400,13 @@ func (r *velocityHttpServletExtensionResource) Read(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.VelocityHttpServletExtensionResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Velocity Http Servlet Extension", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readVelocityHttpServletExtensionResponse(ctx, readResponse.VelocityHttpServletExtensionResponse, &state, &resp.Diagnostics)
 
@@ -430,6 +455,7 @@ func (r *velocityHttpServletExtensionResource) Update(ctx context.Context, req r
 
 		// Read the response
 		readVelocityHttpServletExtensionResponse(ctx, updateResponse.VelocityHttpServletExtensionResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -453,4 +479,5 @@ func (r *velocityHttpServletExtensionResource) Delete(ctx context.Context, req r
 func (r *velocityHttpServletExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
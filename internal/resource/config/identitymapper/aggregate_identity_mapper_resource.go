@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &aggregateIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &aggregateIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &aggregateIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &aggregateIdentityMapperResource{}
 	_ resource.Resource                = &defaultAggregateIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultAggregateIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &defaultAggregateIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAggregateIdentityMapperResource{}
 )
 
 // Create a Aggregate Identity Mapper resource
@@ -138,6 +140,15 @@ func aggregateIdentityMapperSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *aggregateIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAggregateIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAggregateIdentityMapperFields(ctx context.Context, addRequest *client.AddAggregateIdentityMapperRequest, plan aggregateIdentityMapperResourceModel) {
 	if internaltypes.IsDefined(plan.AllIncludedIdentityMapper) {
@@ -216,6 +227,7 @@ func (r *aggregateIdentityMapperResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state aggregateIdentityMapperResourceModel
 	readAggregateIdentityMapperResponse(ctx, addResponse.AggregateIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -254,6 +266,12 @@ func (r *defaultAggregateIdentityMapperResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AggregateIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Aggregate Identity Mapper", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state aggregateIdentityMapperResourceModel
 	readAggregateIdentityMapperResponse(ctx, readResponse.AggregateIdentityMapperResponse, &state, &state, &resp.Diagnostics)
@@ -280,6 +298,7 @@ func (r *defaultAggregateIdentityMapperResource) Create(ctx context.Context, req
 
 		// Read the response
 		readAggregateIdentityMapperResponse(ctx, updateResponse.AggregateIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -312,7 +331,12 @@ func readAggregateIdentityMapper(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.IdentityMapperApi.GetIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Aggregate Identity Mapper", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Aggregate Identity Mapper", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Aggregate Identity Mapper", err, httpResp)
+		}
 		return
 	}
 
@@ -322,6 +346,13 @@ func readAggregateIdentityMapper(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AggregateIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Aggregate Identity Mapper", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAggregateIdentityMapperResponse(ctx, readResponse.AggregateIdentityMapperResponse, &state, &state, &resp.Diagnostics)
 
@@ -378,6 +409,7 @@ func updateAggregateIdentityMapper(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readAggregateIdentityMapperResponse(ctx, updateResponse.AggregateIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -426,4 +458,5 @@ func (r *defaultAggregateIdentityMapperResource) ImportState(ctx context.Context
 func importAggregateIdentityMapper(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
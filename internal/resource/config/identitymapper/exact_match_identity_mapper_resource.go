@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &exactMatchIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &exactMatchIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &exactMatchIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &exactMatchIdentityMapperResource{}
 	_ resource.Resource                = &defaultExactMatchIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultExactMatchIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &defaultExactMatchIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultExactMatchIdentityMapperResource{}
 )
 
 // Create a Exact Match Identity Mapper resource
@@ -143,6 +145,15 @@ func exactMatchIdentityMapperSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *exactMatchIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultExactMatchIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalExactMatchIdentityMapperFields(ctx context.Context, addRequest *client.AddExactMatchIdentityMapperRequest, plan exactMatchIdentityMapperResourceModel) {
 	if internaltypes.IsDefined(plan.MatchAttribute) {
@@ -228,6 +239,7 @@ func (r *exactMatchIdentityMapperResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state exactMatchIdentityMapperResourceModel
 	readExactMatchIdentityMapperResponse(ctx, addResponse.ExactMatchIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -266,6 +278,12 @@ func (r *defaultExactMatchIdentityMapperResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ExactMatchIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Exact Match Identity Mapper", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state exactMatchIdentityMapperResourceModel
 	readExactMatchIdentityMapperResponse(ctx, readResponse.ExactMatchIdentityMapperResponse, &state, &state, &resp.Diagnostics)
@@ -292,6 +310,7 @@ func (r *defaultExactMatchIdentityMapperResource) Create(ctx context.Context, re
 
 		// Read the response
 		readExactMatchIdentityMapperResponse(ctx, updateResponse.ExactMatchIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -324,7 +343,12 @@ func readExactMatchIdentityMapper(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.IdentityMapperApi.GetIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Exact Match Identity Mapper", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Exact Match Identity Mapper", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Exact Match Identity Mapper", err, httpResp)
+		}
 		return
 	}
 
@@ -334,6 +358,13 @@ func readExactMatchIdentityMapper(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ExactMatchIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Exact Match Identity Mapper", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readExactMatchIdentityMapperResponse(ctx, readResponse.ExactMatchIdentityMapperResponse, &state, &state, &resp.Diagnostics)
 
@@ -390,6 +421,7 @@ func updateExactMatchIdentityMapper(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readExactMatchIdentityMapperResponse(ctx, updateResponse.ExactMatchIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -438,4 +470,5 @@ func (r *defaultExactMatchIdentityMapperResource) ImportState(ctx context.Contex
 func importExactMatchIdentityMapper(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
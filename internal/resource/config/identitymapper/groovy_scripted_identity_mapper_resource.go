@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &groovyScriptedIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedIdentityMapperResource{}
 	_ resource.Resource                = &defaultGroovyScriptedIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedIdentityMapperResource{}
 )
 
 // Create a Groovy Scripted Identity Mapper resource
@@ -133,6 +135,15 @@ func groovyScriptedIdentityMapperSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedIdentityMapperFields(ctx context.Context, addRequest *client.AddGroovyScriptedIdentityMapperRequest, plan groovyScriptedIdentityMapperResourceModel) {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -207,6 +218,7 @@ func (r *groovyScriptedIdentityMapperResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state groovyScriptedIdentityMapperResourceModel
 	readGroovyScriptedIdentityMapperResponse(ctx, addResponse.GroovyScriptedIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -245,6 +257,12 @@ func (r *defaultGroovyScriptedIdentityMapperResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Identity Mapper", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedIdentityMapperResourceModel
 	readGroovyScriptedIdentityMapperResponse(ctx, readResponse.GroovyScriptedIdentityMapperResponse, &state, &state, &resp.Diagnostics)
@@ -271,6 +289,7 @@ func (r *defaultGroovyScriptedIdentityMapperResource) Create(ctx context.Context
 
 		// Read the response
 		readGroovyScriptedIdentityMapperResponse(ctx, updateResponse.GroovyScriptedIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -303,7 +322,12 @@ func readGroovyScriptedIdentityMapper(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.IdentityMapperApi.GetIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Identity Mapper", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Identity Mapper", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Identity Mapper", err, httpResp)
+		}
 		return
 	}
 
@@ -313,6 +337,13 @@ func readGroovyScriptedIdentityMapper(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Identity Mapper", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedIdentityMapperResponse(ctx, readResponse.GroovyScriptedIdentityMapperResponse, &state, &state, &resp.Diagnostics)
 
@@ -369,6 +400,7 @@ func updateGroovyScriptedIdentityMapper(ctx context.Context, req resource.Update
 
 		// Read the response
 		readGroovyScriptedIdentityMapperResponse(ctx, updateResponse.GroovyScriptedIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -417,4 +449,5 @@ func (r *defaultGroovyScriptedIdentityMapperResource) ImportState(ctx context.Co
 func importGroovyScriptedIdentityMapper(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &regularExpressionIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &regularExpressionIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &regularExpressionIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &regularExpressionIdentityMapperResource{}
 	_ resource.Resource                = &defaultRegularExpressionIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultRegularExpressionIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &defaultRegularExpressionIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultRegularExpressionIdentityMapperResource{}
 )
 
 // Create a Regular Expression Identity Mapper resource
@@ -158,6 +160,15 @@ func regularExpressionIdentityMapperSchema(ctx context.Context, req resource.Sch
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *regularExpressionIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultRegularExpressionIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalRegularExpressionIdentityMapperFields(ctx context.Context, addRequest *client.AddRegularExpressionIdentityMapperRequest, plan regularExpressionIdentityMapperResourceModel) {
 	if internaltypes.IsDefined(plan.MatchAttribute) {
@@ -253,6 +264,7 @@ func (r *regularExpressionIdentityMapperResource) Create(ctx context.Context, re
 	// Read the response into the state
 	var state regularExpressionIdentityMapperResourceModel
 	readRegularExpressionIdentityMapperResponse(ctx, addResponse.RegularExpressionIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -291,6 +303,12 @@ func (r *defaultRegularExpressionIdentityMapperResource) Create(ctx context.Cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.RegularExpressionIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Regular Expression Identity Mapper", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state regularExpressionIdentityMapperResourceModel
 	readRegularExpressionIdentityMapperResponse(ctx, readResponse.RegularExpressionIdentityMapperResponse, &state, &state, &resp.Diagnostics)
@@ -317,6 +335,7 @@ func (r *defaultRegularExpressionIdentityMapperResource) Create(ctx context.Cont
 
 		// Read the response
 		readRegularExpressionIdentityMapperResponse(ctx, updateResponse.RegularExpressionIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -349,7 +368,12 @@ func readRegularExpressionIdentityMapper(ctx context.Context, req resource.ReadR
 	readResponse, httpResp, err := apiClient.IdentityMapperApi.GetIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Regular Expression Identity Mapper", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Regular Expression Identity Mapper", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Regular Expression Identity Mapper", err, httpResp)
+		}
 		return
 	}
 
@@ -359,6 +383,13 @@ func readRegularExpressionIdentityMapper(ctx context.Context, req resource.ReadR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.RegularExpressionIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Regular Expression Identity Mapper", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readRegularExpressionIdentityMapperResponse(ctx, readResponse.RegularExpressionIdentityMapperResponse, &state, &state, &resp.Diagnostics)
 
@@ -415,6 +446,7 @@ func updateRegularExpressionIdentityMapper(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readRegularExpressionIdentityMapperResponse(ctx, updateResponse.RegularExpressionIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -463,4 +495,5 @@ func (r *defaultRegularExpressionIdentityMapperResource) ImportState(ctx context
 func importRegularExpressionIdentityMapper(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
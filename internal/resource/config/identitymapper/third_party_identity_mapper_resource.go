@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &thirdPartyIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &thirdPartyIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyIdentityMapperResource{}
 	_ resource.Resource                = &defaultThirdPartyIdentityMapperResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyIdentityMapperResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyIdentityMapperResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyIdentityMapperResource{}
 )
 
 // Create a Third Party Identity Mapper resource
@@ -133,6 +135,15 @@ func thirdPartyIdentityMapperSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyIdentityMapperResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyIdentityMapperFields(ctx context.Context, addRequest *client.AddThirdPartyIdentityMapperRequest, plan thirdPartyIdentityMapperResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -207,6 +218,7 @@ func (r *thirdPartyIdentityMapperResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state thirdPartyIdentityMapperResourceModel
 	readThirdPartyIdentityMapperResponse(ctx, addResponse.ThirdPartyIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -245,6 +257,12 @@ func (r *defaultThirdPartyIdentityMapperResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Identity Mapper", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyIdentityMapperResourceModel
 	readThirdPartyIdentityMapperResponse(ctx, readResponse.ThirdPartyIdentityMapperResponse, &state, &state, &resp.Diagnostics)
@@ -271,6 +289,7 @@ func (r *defaultThirdPartyIdentityMapperResource) Create(ctx context.Context, re
 
 		// Read the response
 		readThirdPartyIdentityMapperResponse(ctx, updateResponse.ThirdPartyIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -303,7 +322,12 @@ func readThirdPartyIdentityMapper(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.IdentityMapperApi.GetIdentityMapper(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Identity Mapper", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Identity Mapper", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Identity Mapper", err, httpResp)
+		}
 		return
 	}
 
@@ -313,6 +337,13 @@ func readThirdPartyIdentityMapper(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyIdentityMapperResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Identity Mapper", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyIdentityMapperResponse(ctx, readResponse.ThirdPartyIdentityMapperResponse, &state, &state, &resp.Diagnostics)
 
@@ -369,6 +400,7 @@ func updateThirdPartyIdentityMapper(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readThirdPartyIdentityMapperResponse(ctx, updateResponse.ThirdPartyIdentityMapperResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -417,4 +449,5 @@ func (r *defaultThirdPartyIdentityMapperResource) ImportState(ctx context.Contex
 func importThirdPartyIdentityMapper(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -169,6 +169,7 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 	// Read the response into the state
 	var state locationResourceModel
 	readLocationResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -233,6 +234,7 @@ func (r *defaultLocationResource) Create(ctx context.Context, req resource.Creat
 
 		// Read the response
 		readLocationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -265,7 +267,12 @@ func readLocation(ctx context.Context, req resource.ReadRequest, resp *resource.
 	readResponse, httpResp, err := apiClient.LocationApi.GetLocation(
 		ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Location", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Location", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Location", err, httpResp)
+		}
 		return
 	}
 
@@ -331,6 +338,7 @@ func updateLocation(ctx context.Context, req resource.UpdateRequest, resp *resou
 
 		// Read the response
 		readLocationResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
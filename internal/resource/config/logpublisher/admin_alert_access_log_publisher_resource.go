@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &adminAlertAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &adminAlertAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &adminAlertAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &adminAlertAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultAdminAlertAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultAdminAlertAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultAdminAlertAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAdminAlertAccessLogPublisherResource{}
 )
 
 // Create a Admin Alert Access Log Publisher resource
@@ -483,6 +485,15 @@ func adminAlertAccessLogPublisherSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *adminAlertAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAdminAlertAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAdminAlertAccessLogPublisherFields(ctx context.Context, addRequest *client.AddAdminAlertAccessLogPublisherRequest, plan adminAlertAccessLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.LogConnects) {
@@ -821,6 +832,7 @@ func (r *adminAlertAccessLogPublisherResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state adminAlertAccessLogPublisherResourceModel
 	readAdminAlertAccessLogPublisherResponse(ctx, addResponse.AdminAlertAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -859,6 +871,12 @@ func (r *defaultAdminAlertAccessLogPublisherResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AdminAlertAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Admin Alert Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state adminAlertAccessLogPublisherResourceModel
 	readAdminAlertAccessLogPublisherResponse(ctx, readResponse.AdminAlertAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -885,6 +903,7 @@ func (r *defaultAdminAlertAccessLogPublisherResource) Create(ctx context.Context
 
 		// Read the response
 		readAdminAlertAccessLogPublisherResponse(ctx, updateResponse.AdminAlertAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -917,7 +936,12 @@ func readAdminAlertAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Admin Alert Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Admin Alert Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Admin Alert Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -927,6 +951,13 @@ func readAdminAlertAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AdminAlertAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Admin Alert Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAdminAlertAccessLogPublisherResponse(ctx, readResponse.AdminAlertAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -983,6 +1014,7 @@ func updateAdminAlertAccessLogPublisher(ctx context.Context, req resource.Update
 
 		// Read the response
 		readAdminAlertAccessLogPublisherResponse(ctx, updateResponse.AdminAlertAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1031,4 +1063,5 @@ func (r *defaultAdminAlertAccessLogPublisherResource) ImportState(ctx context.Co
 func importAdminAlertAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
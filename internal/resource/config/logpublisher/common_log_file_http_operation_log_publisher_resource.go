@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &commonLogFileHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &commonLogFileHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &commonLogFileHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &commonLogFileHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultCommonLogFileHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultCommonLogFileHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultCommonLogFileHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCommonLogFileHttpOperationLogPublisherResource{}
 )
 
 // Create a Common Log File Http Operation Log Publisher resource
@@ -260,6 +262,15 @@ func commonLogFileHttpOperationLogPublisherSchema(ctx context.Context, req resou
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *commonLogFileHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultCommonLogFileHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalCommonLogFileHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddCommonLogFileHttpOperationLogPublisherRequest, plan commonLogFileHttpOperationLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -443,6 +454,7 @@ func (r *commonLogFileHttpOperationLogPublisherResource) Create(ctx context.Cont
 	// Read the response into the state
 	var state commonLogFileHttpOperationLogPublisherResourceModel
 	readCommonLogFileHttpOperationLogPublisherResponse(ctx, addResponse.CommonLogFileHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -481,6 +493,12 @@ func (r *defaultCommonLogFileHttpOperationLogPublisherResource) Create(ctx conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CommonLogFileHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Common Log File Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state commonLogFileHttpOperationLogPublisherResourceModel
 	readCommonLogFileHttpOperationLogPublisherResponse(ctx, readResponse.CommonLogFileHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -507,6 +525,7 @@ func (r *defaultCommonLogFileHttpOperationLogPublisherResource) Create(ctx conte
 
 		// Read the response
 		readCommonLogFileHttpOperationLogPublisherResponse(ctx, updateResponse.CommonLogFileHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -539,7 +558,12 @@ func readCommonLogFileHttpOperationLogPublisher(ctx context.Context, req resourc
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Common Log File Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Common Log File Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Common Log File Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -549,6 +573,13 @@ func readCommonLogFileHttpOperationLogPublisher(ctx context.Context, req resourc
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CommonLogFileHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Common Log File Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCommonLogFileHttpOperationLogPublisherResponse(ctx, readResponse.CommonLogFileHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -605,6 +636,7 @@ func updateCommonLogFileHttpOperationLogPublisher(ctx context.Context, req resou
 
 		// Read the response
 		readCommonLogFileHttpOperationLogPublisherResponse(ctx, updateResponse.CommonLogFileHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -653,4 +685,5 @@ func (r *defaultCommonLogFileHttpOperationLogPublisherResource) ImportState(ctx
 func importCommonLogFileHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
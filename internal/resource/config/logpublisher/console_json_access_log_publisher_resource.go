@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &consoleJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &consoleJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &consoleJsonAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &consoleJsonAccessLogPublisherResource{}
 )
 
 // Create a Console Json Access Log Publisher resource
@@ -459,6 +460,11 @@ func (r *consoleJsonAccessLogPublisherResource) Schema(ctx context.Context, req
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *consoleJsonAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ConsoleJsonAccessLogPublisherResponse object into the model struct
 func readConsoleJsonAccessLogPublisherResponse(ctx context.Context, r *client.ConsoleJsonAccessLogPublisherResponse, state *consoleJsonAccessLogPublisherResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -585,6 +591,12 @@ func (r *consoleJsonAccessLogPublisherResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Console Json Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state consoleJsonAccessLogPublisherResourceModel
 	readConsoleJsonAccessLogPublisherResponse(ctx, readResponse.ConsoleJsonAccessLogPublisherResponse, &state, &resp.Diagnostics)
@@ -611,6 +623,7 @@ func (r *consoleJsonAccessLogPublisherResource) Create(ctx context.Context, req
 
 		// Read the response
 		readConsoleJsonAccessLogPublisherResponse(ctx, updateResponse.ConsoleJsonAccessLogPublisherResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -635,7 +648,12 @@ func (r *consoleJsonAccessLogPublisherResource) Read(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Console Json Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -645,6 +663,13 @@ func (r *consoleJsonAccessLogPublisherResource) Read(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Console Json Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConsoleJsonAccessLogPublisherResponse(ctx, readResponse.ConsoleJsonAccessLogPublisherResponse, &state, &resp.Diagnostics)
 
@@ -693,6 +718,7 @@ func (r *consoleJsonAccessLogPublisherResource) Update(ctx context.Context, req
 
 		// Read the response
 		readConsoleJsonAccessLogPublisherResponse(ctx, updateResponse.ConsoleJsonAccessLogPublisherResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -716,4 +742,5 @@ func (r *consoleJsonAccessLogPublisherResource) Delete(ctx context.Context, req
 func (r *consoleJsonAccessLogPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &consoleJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &consoleJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &consoleJsonAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &consoleJsonAuditLogPublisherResource{}
 	_ resource.Resource                = &defaultConsoleJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultConsoleJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultConsoleJsonAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultConsoleJsonAuditLogPublisherResource{}
 )
 
 // Create a Console Json Audit Log Publisher resource
@@ -326,6 +328,15 @@ func consoleJsonAuditLogPublisherSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *consoleJsonAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultConsoleJsonAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalConsoleJsonAuditLogPublisherFields(ctx context.Context, addRequest *client.AddConsoleJsonAuditLogPublisherRequest, plan consoleJsonAuditLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -559,6 +570,7 @@ func (r *consoleJsonAuditLogPublisherResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state consoleJsonAuditLogPublisherResourceModel
 	readConsoleJsonAuditLogPublisherResponse(ctx, addResponse.ConsoleJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -597,6 +609,12 @@ func (r *defaultConsoleJsonAuditLogPublisherResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Console Json Audit Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state consoleJsonAuditLogPublisherResourceModel
 	readConsoleJsonAuditLogPublisherResponse(ctx, readResponse.ConsoleJsonAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -623,6 +641,7 @@ func (r *defaultConsoleJsonAuditLogPublisherResource) Create(ctx context.Context
 
 		// Read the response
 		readConsoleJsonAuditLogPublisherResponse(ctx, updateResponse.ConsoleJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -655,7 +674,12 @@ func readConsoleJsonAuditLogPublisher(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Audit Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Console Json Audit Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Audit Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -665,6 +689,13 @@ func readConsoleJsonAuditLogPublisher(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Console Json Audit Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConsoleJsonAuditLogPublisherResponse(ctx, readResponse.ConsoleJsonAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -721,6 +752,7 @@ func updateConsoleJsonAuditLogPublisher(ctx context.Context, req resource.Update
 
 		// Read the response
 		readConsoleJsonAuditLogPublisherResponse(ctx, updateResponse.ConsoleJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -769,4 +801,5 @@ func (r *defaultConsoleJsonAuditLogPublisherResource) ImportState(ctx context.Co
 func importConsoleJsonAuditLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &consoleJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &consoleJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &consoleJsonErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &consoleJsonErrorLogPublisherResource{}
 )
 
 // Create a Console Json Error Log Publisher resource
@@ -182,6 +183,11 @@ func (r *consoleJsonErrorLogPublisherResource) Schema(ctx context.Context, req r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *consoleJsonErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ConsoleJsonErrorLogPublisherResponse object into the model struct
 func readConsoleJsonErrorLogPublisherResponse(ctx context.Context, r *client.ConsoleJsonErrorLogPublisherResponse, state *consoleJsonErrorLogPublisherResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -247,6 +253,12 @@ func (r *consoleJsonErrorLogPublisherResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Console Json Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state consoleJsonErrorLogPublisherResourceModel
 	readConsoleJsonErrorLogPublisherResponse(ctx, readResponse.ConsoleJsonErrorLogPublisherResponse, &state, &resp.Diagnostics)
@@ -273,6 +285,7 @@ func (r *consoleJsonErrorLogPublisherResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readConsoleJsonErrorLogPublisherResponse(ctx, updateResponse.ConsoleJsonErrorLogPublisherResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -297,7 +310,12 @@ func (r *consoleJsonErrorLogPublisherResource) Read(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Console Json Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -307,6 +325,13 @@ func (r *consoleJsonErrorLogPublisherResource) Read(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Console Json Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConsoleJsonErrorLogPublisherResponse(ctx, readResponse.ConsoleJsonErrorLogPublisherResponse, &state, &resp.Diagnostics)
 
@@ -355,6 +380,7 @@ func (r *consoleJsonErrorLogPublisherResource) Update(ctx context.Context, req r
 
 		// Read the response
 		readConsoleJsonErrorLogPublisherResponse(ctx, updateResponse.ConsoleJsonErrorLogPublisherResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -378,4 +404,5 @@ func (r *consoleJsonErrorLogPublisherResource) Delete(ctx context.Context, req r
 func (r *consoleJsonErrorLogPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &consoleJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &consoleJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &consoleJsonHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &consoleJsonHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultConsoleJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultConsoleJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultConsoleJsonHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultConsoleJsonHttpOperationLogPublisherResource{}
 )
 
 // Create a Console Json Http Operation Log Publisher resource
@@ -312,6 +314,15 @@ func consoleJsonHttpOperationLogPublisherSchema(ctx context.Context, req resourc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *consoleJsonHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultConsoleJsonHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalConsoleJsonHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddConsoleJsonHttpOperationLogPublisherRequest, plan consoleJsonHttpOperationLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -535,6 +546,7 @@ func (r *consoleJsonHttpOperationLogPublisherResource) Create(ctx context.Contex
 	// Read the response into the state
 	var state consoleJsonHttpOperationLogPublisherResourceModel
 	readConsoleJsonHttpOperationLogPublisherResponse(ctx, addResponse.ConsoleJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -573,6 +585,12 @@ func (r *defaultConsoleJsonHttpOperationLogPublisherResource) Create(ctx context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Console Json Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state consoleJsonHttpOperationLogPublisherResourceModel
 	readConsoleJsonHttpOperationLogPublisherResponse(ctx, readResponse.ConsoleJsonHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -599,6 +617,7 @@ func (r *defaultConsoleJsonHttpOperationLogPublisherResource) Create(ctx context
 
 		// Read the response
 		readConsoleJsonHttpOperationLogPublisherResponse(ctx, updateResponse.ConsoleJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -631,7 +650,12 @@ func readConsoleJsonHttpOperationLogPublisher(ctx context.Context, req resource.
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Console Json Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Console Json Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -641,6 +665,13 @@ func readConsoleJsonHttpOperationLogPublisher(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConsoleJsonHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Console Json Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConsoleJsonHttpOperationLogPublisherResponse(ctx, readResponse.ConsoleJsonHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -697,6 +728,7 @@ func updateConsoleJsonHttpOperationLogPublisher(ctx context.Context, req resourc
 
 		// Read the response
 		readConsoleJsonHttpOperationLogPublisherResponse(ctx, updateResponse.ConsoleJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -745,4 +777,5 @@ func (r *defaultConsoleJsonHttpOperationLogPublisherResource) ImportState(ctx co
 func importConsoleJsonHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &debugAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &debugAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &debugAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &debugAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultDebugAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultDebugAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultDebugAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDebugAccessLogPublisherResource{}
 )
 
 // Create a Debug Access Log Publisher resource
@@ -430,6 +432,15 @@ func debugAccessLogPublisherSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *debugAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultDebugAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalDebugAccessLogPublisherFields(ctx context.Context, addRequest *client.AddDebugAccessLogPublisherRequest, plan debugAccessLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.SuppressReplicationOperations) {
@@ -745,6 +756,7 @@ func (r *debugAccessLogPublisherResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state debugAccessLogPublisherResourceModel
 	readDebugAccessLogPublisherResponse(ctx, addResponse.DebugAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -783,6 +795,12 @@ func (r *defaultDebugAccessLogPublisherResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DebugAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Debug Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state debugAccessLogPublisherResourceModel
 	readDebugAccessLogPublisherResponse(ctx, readResponse.DebugAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -809,6 +827,7 @@ func (r *defaultDebugAccessLogPublisherResource) Create(ctx context.Context, req
 
 		// Read the response
 		readDebugAccessLogPublisherResponse(ctx, updateResponse.DebugAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -841,7 +860,12 @@ func readDebugAccessLogPublisher(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Debug Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Debug Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Debug Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -851,6 +875,13 @@ func readDebugAccessLogPublisher(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DebugAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Debug Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDebugAccessLogPublisherResponse(ctx, readResponse.DebugAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -907,6 +938,7 @@ func updateDebugAccessLogPublisher(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readDebugAccessLogPublisherResponse(ctx, updateResponse.DebugAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -955,4 +987,5 @@ func (r *defaultDebugAccessLogPublisherResource) ImportState(ctx context.Context
 func importDebugAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
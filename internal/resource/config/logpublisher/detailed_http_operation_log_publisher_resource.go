@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &detailedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &detailedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &detailedHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &detailedHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultDetailedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultDetailedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultDetailedHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDetailedHttpOperationLogPublisherResource{}
 )
 
 // Create a Detailed Http Operation Log Publisher resource
@@ -434,6 +436,15 @@ func detailedHttpOperationLogPublisherSchema(ctx context.Context, req resource.S
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *detailedHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultDetailedHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalDetailedHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddDetailedHttpOperationLogPublisherRequest, plan detailedHttpOperationLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -749,6 +760,7 @@ func (r *detailedHttpOperationLogPublisherResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state detailedHttpOperationLogPublisherResourceModel
 	readDetailedHttpOperationLogPublisherResponse(ctx, addResponse.DetailedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -787,6 +799,12 @@ func (r *defaultDetailedHttpOperationLogPublisherResource) Create(ctx context.Co
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DetailedHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Detailed Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state detailedHttpOperationLogPublisherResourceModel
 	readDetailedHttpOperationLogPublisherResponse(ctx, readResponse.DetailedHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -813,6 +831,7 @@ func (r *defaultDetailedHttpOperationLogPublisherResource) Create(ctx context.Co
 
 		// Read the response
 		readDetailedHttpOperationLogPublisherResponse(ctx, updateResponse.DetailedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -845,7 +864,12 @@ func readDetailedHttpOperationLogPublisher(ctx context.Context, req resource.Rea
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Detailed Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Detailed Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Detailed Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -855,6 +879,13 @@ func readDetailedHttpOperationLogPublisher(ctx context.Context, req resource.Rea
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DetailedHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Detailed Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDetailedHttpOperationLogPublisherResponse(ctx, readResponse.DetailedHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -911,6 +942,7 @@ func updateDetailedHttpOperationLogPublisher(ctx context.Context, req resource.U
 
 		// Read the response
 		readDetailedHttpOperationLogPublisherResponse(ctx, updateResponse.DetailedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -959,4 +991,5 @@ func (r *defaultDetailedHttpOperationLogPublisherResource) ImportState(ctx conte
 func importDetailedHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedAccessLogPublisherResource{}
 )
 
 // Create a File Based Access Log Publisher resource
@@ -596,6 +598,15 @@ func fileBasedAccessLogPublisherSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedAccessLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedAccessLogPublisherRequest, plan fileBasedAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -1030,6 +1041,7 @@ func (r *fileBasedAccessLogPublisherResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state fileBasedAccessLogPublisherResourceModel
 	readFileBasedAccessLogPublisherResponse(ctx, addResponse.FileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -1068,6 +1080,12 @@ func (r *defaultFileBasedAccessLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedAccessLogPublisherResourceModel
 	readFileBasedAccessLogPublisherResponse(ctx, readResponse.FileBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -1094,6 +1112,7 @@ func (r *defaultFileBasedAccessLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readFileBasedAccessLogPublisherResponse(ctx, updateResponse.FileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -1126,7 +1145,12 @@ func readFileBasedAccessLogPublisher(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -1136,6 +1160,13 @@ func readFileBasedAccessLogPublisher(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedAccessLogPublisherResponse(ctx, readResponse.FileBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -1192,6 +1223,7 @@ func updateFileBasedAccessLogPublisher(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readFileBasedAccessLogPublisherResponse(ctx, updateResponse.FileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1240,4 +1272,5 @@ func (r *defaultFileBasedAccessLogPublisherResource) ImportState(ctx context.Con
 func importFileBasedAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
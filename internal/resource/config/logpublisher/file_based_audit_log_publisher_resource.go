@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedAuditLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedAuditLogPublisherResource{}
 )
 
 // Create a File Based Audit Log Publisher resource
@@ -448,6 +450,15 @@ func fileBasedAuditLogPublisherSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedAuditLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedAuditLogPublisherRequest, plan fileBasedAuditLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.SuppressInternalOperations) {
@@ -778,6 +789,7 @@ func (r *fileBasedAuditLogPublisherResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state fileBasedAuditLogPublisherResourceModel
 	readFileBasedAuditLogPublisherResponse(ctx, addResponse.FileBasedAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -816,6 +828,12 @@ func (r *defaultFileBasedAuditLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Audit Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedAuditLogPublisherResourceModel
 	readFileBasedAuditLogPublisherResponse(ctx, readResponse.FileBasedAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -842,6 +860,7 @@ func (r *defaultFileBasedAuditLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readFileBasedAuditLogPublisherResponse(ctx, updateResponse.FileBasedAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -874,7 +893,12 @@ func readFileBasedAuditLogPublisher(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Audit Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Audit Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Audit Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -884,6 +908,13 @@ func readFileBasedAuditLogPublisher(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Audit Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedAuditLogPublisherResponse(ctx, readResponse.FileBasedAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -940,6 +971,7 @@ func updateFileBasedAuditLogPublisher(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readFileBasedAuditLogPublisherResponse(ctx, updateResponse.FileBasedAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -988,4 +1020,5 @@ func (r *defaultFileBasedAuditLogPublisherResource) ImportState(ctx context.Cont
 func importFileBasedAuditLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
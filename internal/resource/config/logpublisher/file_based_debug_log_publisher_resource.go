@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedDebugLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedDebugLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedDebugLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedDebugLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedDebugLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedDebugLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedDebugLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedDebugLogPublisherResource{}
 )
 
 // Create a File Based Debug Log Publisher resource
@@ -324,6 +326,15 @@ func fileBasedDebugLogPublisherSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedDebugLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedDebugLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedDebugLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedDebugLogPublisherRequest, plan fileBasedDebugLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -568,6 +579,7 @@ func (r *fileBasedDebugLogPublisherResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state fileBasedDebugLogPublisherResourceModel
 	readFileBasedDebugLogPublisherResponse(ctx, addResponse.FileBasedDebugLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -606,6 +618,12 @@ func (r *defaultFileBasedDebugLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedDebugLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Debug Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedDebugLogPublisherResourceModel
 	readFileBasedDebugLogPublisherResponse(ctx, readResponse.FileBasedDebugLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -632,6 +650,7 @@ func (r *defaultFileBasedDebugLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readFileBasedDebugLogPublisherResponse(ctx, updateResponse.FileBasedDebugLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -664,7 +683,12 @@ func readFileBasedDebugLogPublisher(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Debug Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Debug Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Debug Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -674,6 +698,13 @@ func readFileBasedDebugLogPublisher(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedDebugLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Debug Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedDebugLogPublisherResponse(ctx, readResponse.FileBasedDebugLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -730,6 +761,7 @@ func updateFileBasedDebugLogPublisher(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readFileBasedDebugLogPublisherResponse(ctx, updateResponse.FileBasedDebugLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -778,4 +810,5 @@ func (r *defaultFileBasedDebugLogPublisherResource) ImportState(ctx context.Cont
 func importFileBasedDebugLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedErrorLogPublisherResource{}
 )
 
 // Create a File Based Error Log Publisher resource
@@ -334,6 +336,15 @@ func fileBasedErrorLogPublisherSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedErrorLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedErrorLogPublisherRequest, plan fileBasedErrorLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -581,6 +592,7 @@ func (r *fileBasedErrorLogPublisherResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state fileBasedErrorLogPublisherResourceModel
 	readFileBasedErrorLogPublisherResponse(ctx, addResponse.FileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -619,6 +631,12 @@ func (r *defaultFileBasedErrorLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedErrorLogPublisherResourceModel
 	readFileBasedErrorLogPublisherResponse(ctx, readResponse.FileBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -645,6 +663,7 @@ func (r *defaultFileBasedErrorLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readFileBasedErrorLogPublisherResponse(ctx, updateResponse.FileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -677,7 +696,12 @@ func readFileBasedErrorLogPublisher(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -687,6 +711,13 @@ func readFileBasedErrorLogPublisher(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedErrorLogPublisherResponse(ctx, readResponse.FileBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -743,6 +774,7 @@ func updateFileBasedErrorLogPublisher(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readFileBasedErrorLogPublisherResponse(ctx, updateResponse.FileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -791,4 +823,5 @@ func (r *defaultFileBasedErrorLogPublisherResource) ImportState(ctx context.Cont
 func importFileBasedErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedJsonAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedJsonAuditLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedJsonAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedJsonAuditLogPublisherResource{}
 )
 
 // Create a File Based Json Audit Log Publisher resource
@@ -448,6 +450,15 @@ func fileBasedJsonAuditLogPublisherSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedJsonAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedJsonAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedJsonAuditLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedJsonAuditLogPublisherRequest, plan fileBasedJsonAuditLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -773,6 +784,7 @@ func (r *fileBasedJsonAuditLogPublisherResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state fileBasedJsonAuditLogPublisherResourceModel
 	readFileBasedJsonAuditLogPublisherResponse(ctx, addResponse.FileBasedJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -811,6 +823,12 @@ func (r *defaultFileBasedJsonAuditLogPublisherResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedJsonAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Json Audit Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedJsonAuditLogPublisherResourceModel
 	readFileBasedJsonAuditLogPublisherResponse(ctx, readResponse.FileBasedJsonAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -837,6 +855,7 @@ func (r *defaultFileBasedJsonAuditLogPublisherResource) Create(ctx context.Conte
 
 		// Read the response
 		readFileBasedJsonAuditLogPublisherResponse(ctx, updateResponse.FileBasedJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -869,7 +888,12 @@ func readFileBasedJsonAuditLogPublisher(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Json Audit Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Json Audit Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Json Audit Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -879,6 +903,13 @@ func readFileBasedJsonAuditLogPublisher(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedJsonAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Json Audit Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedJsonAuditLogPublisherResponse(ctx, readResponse.FileBasedJsonAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -935,6 +966,7 @@ func updateFileBasedJsonAuditLogPublisher(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readFileBasedJsonAuditLogPublisherResponse(ctx, updateResponse.FileBasedJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -983,4 +1015,5 @@ func (r *defaultFileBasedJsonAuditLogPublisherResource) ImportState(ctx context.
 func importFileBasedJsonAuditLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
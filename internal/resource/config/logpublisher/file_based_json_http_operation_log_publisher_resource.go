@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedJsonHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedJsonHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedJsonHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedJsonHttpOperationLogPublisherResource{}
 )
 
 // Create a File Based Json Http Operation Log Publisher resource
@@ -434,6 +436,15 @@ func fileBasedJsonHttpOperationLogPublisherSchema(ctx context.Context, req resou
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedJsonHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedJsonHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedJsonHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedJsonHttpOperationLogPublisherRequest, plan fileBasedJsonHttpOperationLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -749,6 +760,7 @@ func (r *fileBasedJsonHttpOperationLogPublisherResource) Create(ctx context.Cont
 	// Read the response into the state
 	var state fileBasedJsonHttpOperationLogPublisherResourceModel
 	readFileBasedJsonHttpOperationLogPublisherResponse(ctx, addResponse.FileBasedJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -787,6 +799,12 @@ func (r *defaultFileBasedJsonHttpOperationLogPublisherResource) Create(ctx conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedJsonHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Json Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedJsonHttpOperationLogPublisherResourceModel
 	readFileBasedJsonHttpOperationLogPublisherResponse(ctx, readResponse.FileBasedJsonHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -813,6 +831,7 @@ func (r *defaultFileBasedJsonHttpOperationLogPublisherResource) Create(ctx conte
 
 		// Read the response
 		readFileBasedJsonHttpOperationLogPublisherResponse(ctx, updateResponse.FileBasedJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -845,7 +864,12 @@ func readFileBasedJsonHttpOperationLogPublisher(ctx context.Context, req resourc
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Json Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Json Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Json Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -855,6 +879,13 @@ func readFileBasedJsonHttpOperationLogPublisher(ctx context.Context, req resourc
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedJsonHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Json Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedJsonHttpOperationLogPublisherResponse(ctx, readResponse.FileBasedJsonHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -911,6 +942,7 @@ func updateFileBasedJsonHttpOperationLogPublisher(ctx context.Context, req resou
 
 		// Read the response
 		readFileBasedJsonHttpOperationLogPublisherResponse(ctx, updateResponse.FileBasedJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -959,4 +991,5 @@ func (r *defaultFileBasedJsonHttpOperationLogPublisherResource) ImportState(ctx
 func importFileBasedJsonHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &fileBasedTraceLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedTraceLogPublisherResource{}
 	_ resource.ResourceWithImportState = &fileBasedTraceLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedTraceLogPublisherResource{}
 	_ resource.Resource                = &defaultFileBasedTraceLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedTraceLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedTraceLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedTraceLogPublisherResource{}
 )
 
 // Create a File Based Trace Log Publisher resource
@@ -360,6 +362,15 @@ func fileBasedTraceLogPublisherSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedTraceLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedTraceLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedTraceLogPublisherFields(ctx context.Context, addRequest *client.AddFileBasedTraceLogPublisherRequest, plan fileBasedTraceLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -685,6 +696,7 @@ func (r *fileBasedTraceLogPublisherResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state fileBasedTraceLogPublisherResourceModel
 	readFileBasedTraceLogPublisherResponse(ctx, addResponse.FileBasedTraceLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -723,6 +735,12 @@ func (r *defaultFileBasedTraceLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedTraceLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Trace Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedTraceLogPublisherResourceModel
 	readFileBasedTraceLogPublisherResponse(ctx, readResponse.FileBasedTraceLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -749,6 +767,7 @@ func (r *defaultFileBasedTraceLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readFileBasedTraceLogPublisherResponse(ctx, updateResponse.FileBasedTraceLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -781,7 +800,12 @@ func readFileBasedTraceLogPublisher(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Trace Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Trace Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Trace Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -791,6 +815,13 @@ func readFileBasedTraceLogPublisher(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedTraceLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Trace Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedTraceLogPublisherResponse(ctx, readResponse.FileBasedTraceLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -847,6 +878,7 @@ func updateFileBasedTraceLogPublisher(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readFileBasedTraceLogPublisherResponse(ctx, updateResponse.FileBasedTraceLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -895,4 +927,5 @@ func (r *defaultFileBasedTraceLogPublisherResource) ImportState(ctx context.Cont
 func importFileBasedTraceLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
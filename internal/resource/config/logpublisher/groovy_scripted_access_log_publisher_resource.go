@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &groovyScriptedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultGroovyScriptedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedAccessLogPublisherResource{}
 )
 
 // Create a Groovy Scripted Access Log Publisher resource
@@ -277,6 +279,15 @@ func groovyScriptedAccessLogPublisherSchema(ctx context.Context, req resource.Sc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedAccessLogPublisherFields(ctx context.Context, addRequest *client.AddGroovyScriptedAccessLogPublisherRequest, plan groovyScriptedAccessLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -474,6 +485,7 @@ func (r *groovyScriptedAccessLogPublisherResource) Create(ctx context.Context, r
 	// Read the response into the state
 	var state groovyScriptedAccessLogPublisherResourceModel
 	readGroovyScriptedAccessLogPublisherResponse(ctx, addResponse.GroovyScriptedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -512,6 +524,12 @@ func (r *defaultGroovyScriptedAccessLogPublisherResource) Create(ctx context.Con
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedAccessLogPublisherResourceModel
 	readGroovyScriptedAccessLogPublisherResponse(ctx, readResponse.GroovyScriptedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -538,6 +556,7 @@ func (r *defaultGroovyScriptedAccessLogPublisherResource) Create(ctx context.Con
 
 		// Read the response
 		readGroovyScriptedAccessLogPublisherResponse(ctx, updateResponse.GroovyScriptedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -570,7 +589,12 @@ func readGroovyScriptedAccessLogPublisher(ctx context.Context, req resource.Read
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -580,6 +604,13 @@ func readGroovyScriptedAccessLogPublisher(ctx context.Context, req resource.Read
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedAccessLogPublisherResponse(ctx, readResponse.GroovyScriptedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -636,6 +667,7 @@ func updateGroovyScriptedAccessLogPublisher(ctx context.Context, req resource.Up
 
 		// Read the response
 		readGroovyScriptedAccessLogPublisherResponse(ctx, updateResponse.GroovyScriptedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -684,4 +716,5 @@ func (r *defaultGroovyScriptedAccessLogPublisherResource) ImportState(ctx contex
 func importGroovyScriptedAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
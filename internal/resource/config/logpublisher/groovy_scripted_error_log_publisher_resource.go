@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &groovyScriptedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultGroovyScriptedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedErrorLogPublisherResource{}
 )
 
 // Create a Groovy Scripted Error Log Publisher resource
@@ -163,6 +165,15 @@ func groovyScriptedErrorLogPublisherSchema(ctx context.Context, req resource.Sch
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedErrorLogPublisherFields(ctx context.Context, addRequest *client.AddGroovyScriptedErrorLogPublisherRequest, plan groovyScriptedErrorLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -276,6 +287,7 @@ func (r *groovyScriptedErrorLogPublisherResource) Create(ctx context.Context, re
 	// Read the response into the state
 	var state groovyScriptedErrorLogPublisherResourceModel
 	readGroovyScriptedErrorLogPublisherResponse(ctx, addResponse.GroovyScriptedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -314,6 +326,12 @@ func (r *defaultGroovyScriptedErrorLogPublisherResource) Create(ctx context.Cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedErrorLogPublisherResourceModel
 	readGroovyScriptedErrorLogPublisherResponse(ctx, readResponse.GroovyScriptedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -340,6 +358,7 @@ func (r *defaultGroovyScriptedErrorLogPublisherResource) Create(ctx context.Cont
 
 		// Read the response
 		readGroovyScriptedErrorLogPublisherResponse(ctx, updateResponse.GroovyScriptedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -372,7 +391,12 @@ func readGroovyScriptedErrorLogPublisher(ctx context.Context, req resource.ReadR
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -382,6 +406,13 @@ func readGroovyScriptedErrorLogPublisher(ctx context.Context, req resource.ReadR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedErrorLogPublisherResponse(ctx, readResponse.GroovyScriptedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -438,6 +469,7 @@ func updateGroovyScriptedErrorLogPublisher(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readGroovyScriptedErrorLogPublisherResponse(ctx, updateResponse.GroovyScriptedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -486,4 +518,5 @@ func (r *defaultGroovyScriptedErrorLogPublisherResource) ImportState(ctx context
 func importGroovyScriptedErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &groovyScriptedFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedFileBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedFileBasedAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultGroovyScriptedFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedFileBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedFileBasedAccessLogPublisherResource{}
 )
 
 // Create a Groovy Scripted File Based Access Log Publisher resource
@@ -408,6 +410,15 @@ func groovyScriptedFileBasedAccessLogPublisherSchema(ctx context.Context, req re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedFileBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedFileBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedFileBasedAccessLogPublisherFields(ctx context.Context, addRequest *client.AddGroovyScriptedFileBasedAccessLogPublisherRequest, plan groovyScriptedFileBasedAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -708,6 +719,7 @@ func (r *groovyScriptedFileBasedAccessLogPublisherResource) Create(ctx context.C
 	// Read the response into the state
 	var state groovyScriptedFileBasedAccessLogPublisherResourceModel
 	readGroovyScriptedFileBasedAccessLogPublisherResponse(ctx, addResponse.GroovyScriptedFileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -746,6 +758,12 @@ func (r *defaultGroovyScriptedFileBasedAccessLogPublisherResource) Create(ctx co
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedFileBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted File Based Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedFileBasedAccessLogPublisherResourceModel
 	readGroovyScriptedFileBasedAccessLogPublisherResponse(ctx, readResponse.GroovyScriptedFileBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -772,6 +790,7 @@ func (r *defaultGroovyScriptedFileBasedAccessLogPublisherResource) Create(ctx co
 
 		// Read the response
 		readGroovyScriptedFileBasedAccessLogPublisherResponse(ctx, updateResponse.GroovyScriptedFileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -804,7 +823,12 @@ func readGroovyScriptedFileBasedAccessLogPublisher(ctx context.Context, req reso
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted File Based Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted File Based Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted File Based Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -814,6 +838,13 @@ func readGroovyScriptedFileBasedAccessLogPublisher(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedFileBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted File Based Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedFileBasedAccessLogPublisherResponse(ctx, readResponse.GroovyScriptedFileBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -870,6 +901,7 @@ func updateGroovyScriptedFileBasedAccessLogPublisher(ctx context.Context, req re
 
 		// Read the response
 		readGroovyScriptedFileBasedAccessLogPublisherResponse(ctx, updateResponse.GroovyScriptedFileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -918,4 +950,5 @@ func (r *defaultGroovyScriptedFileBasedAccessLogPublisherResource) ImportState(c
 func importGroovyScriptedFileBasedAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &groovyScriptedFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedFileBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedFileBasedErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultGroovyScriptedFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedFileBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedFileBasedErrorLogPublisherResource{}
 )
 
 // Create a Groovy Scripted File Based Error Log Publisher resource
@@ -295,6 +297,15 @@ func groovyScriptedFileBasedErrorLogPublisherSchema(ctx context.Context, req res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedFileBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedFileBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedFileBasedErrorLogPublisherFields(ctx context.Context, addRequest *client.AddGroovyScriptedFileBasedErrorLogPublisherRequest, plan groovyScriptedFileBasedErrorLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -511,6 +522,7 @@ func (r *groovyScriptedFileBasedErrorLogPublisherResource) Create(ctx context.Co
 	// Read the response into the state
 	var state groovyScriptedFileBasedErrorLogPublisherResourceModel
 	readGroovyScriptedFileBasedErrorLogPublisherResponse(ctx, addResponse.GroovyScriptedFileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -549,6 +561,12 @@ func (r *defaultGroovyScriptedFileBasedErrorLogPublisherResource) Create(ctx con
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedFileBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted File Based Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedFileBasedErrorLogPublisherResourceModel
 	readGroovyScriptedFileBasedErrorLogPublisherResponse(ctx, readResponse.GroovyScriptedFileBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -575,6 +593,7 @@ func (r *defaultGroovyScriptedFileBasedErrorLogPublisherResource) Create(ctx con
 
 		// Read the response
 		readGroovyScriptedFileBasedErrorLogPublisherResponse(ctx, updateResponse.GroovyScriptedFileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -607,7 +626,12 @@ func readGroovyScriptedFileBasedErrorLogPublisher(ctx context.Context, req resou
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted File Based Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted File Based Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted File Based Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -617,6 +641,13 @@ func readGroovyScriptedFileBasedErrorLogPublisher(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedFileBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted File Based Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedFileBasedErrorLogPublisherResponse(ctx, readResponse.GroovyScriptedFileBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -673,6 +704,7 @@ func updateGroovyScriptedFileBasedErrorLogPublisher(ctx context.Context, req res
 
 		// Read the response
 		readGroovyScriptedFileBasedErrorLogPublisherResponse(ctx, updateResponse.GroovyScriptedFileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -721,4 +753,5 @@ func (r *defaultGroovyScriptedFileBasedErrorLogPublisherResource) ImportState(ct
 func importGroovyScriptedFileBasedErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
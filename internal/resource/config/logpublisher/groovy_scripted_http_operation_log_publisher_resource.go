@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &groovyScriptedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultGroovyScriptedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedHttpOperationLogPublisherResource{}
 )
 
 // Create a Groovy Scripted Http Operation Log Publisher resource
@@ -143,6 +145,15 @@ func groovyScriptedHttpOperationLogPublisherSchema(ctx context.Context, req reso
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddGroovyScriptedHttpOperationLogPublisherRequest, plan groovyScriptedHttpOperationLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -233,6 +244,7 @@ func (r *groovyScriptedHttpOperationLogPublisherResource) Create(ctx context.Con
 	// Read the response into the state
 	var state groovyScriptedHttpOperationLogPublisherResourceModel
 	readGroovyScriptedHttpOperationLogPublisherResponse(ctx, addResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -271,6 +283,12 @@ func (r *defaultGroovyScriptedHttpOperationLogPublisherResource) Create(ctx cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedHttpOperationLogPublisherResourceModel
 	readGroovyScriptedHttpOperationLogPublisherResponse(ctx, readResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -297,6 +315,7 @@ func (r *defaultGroovyScriptedHttpOperationLogPublisherResource) Create(ctx cont
 
 		// Read the response
 		readGroovyScriptedHttpOperationLogPublisherResponse(ctx, updateResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -329,7 +348,12 @@ func readGroovyScriptedHttpOperationLogPublisher(ctx context.Context, req resour
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -339,6 +363,13 @@ func readGroovyScriptedHttpOperationLogPublisher(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedHttpOperationLogPublisherResponse(ctx, readResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -395,6 +426,7 @@ func updateGroovyScriptedHttpOperationLogPublisher(ctx context.Context, req reso
 
 		// Read the response
 		readGroovyScriptedHttpOperationLogPublisherResponse(ctx, updateResponse.GroovyScriptedHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -443,4 +475,5 @@ func (r *defaultGroovyScriptedHttpOperationLogPublisherResource) ImportState(ctx
 func importGroovyScriptedHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &jdbcBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &jdbcBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &jdbcBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &jdbcBasedAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultJdbcBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultJdbcBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultJdbcBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJdbcBasedAccessLogPublisherResource{}
 )
 
 // Create a Jdbc Based Access Log Publisher resource
@@ -290,6 +292,15 @@ func jdbcBasedAccessLogPublisherSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jdbcBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJdbcBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJdbcBasedAccessLogPublisherFields(ctx context.Context, addRequest *client.AddJdbcBasedAccessLogPublisherRequest, plan jdbcBasedAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -496,6 +507,7 @@ func (r *jdbcBasedAccessLogPublisherResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state jdbcBasedAccessLogPublisherResourceModel
 	readJdbcBasedAccessLogPublisherResponse(ctx, addResponse.JdbcBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -534,6 +546,12 @@ func (r *defaultJdbcBasedAccessLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JdbcBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jdbc Based Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jdbcBasedAccessLogPublisherResourceModel
 	readJdbcBasedAccessLogPublisherResponse(ctx, readResponse.JdbcBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -560,6 +578,7 @@ func (r *defaultJdbcBasedAccessLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readJdbcBasedAccessLogPublisherResponse(ctx, updateResponse.JdbcBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -592,7 +611,12 @@ func readJdbcBasedAccessLogPublisher(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jdbc Based Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Jdbc Based Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jdbc Based Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -602,6 +626,13 @@ func readJdbcBasedAccessLogPublisher(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JdbcBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Jdbc Based Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJdbcBasedAccessLogPublisherResponse(ctx, readResponse.JdbcBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -658,6 +689,7 @@ func updateJdbcBasedAccessLogPublisher(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readJdbcBasedAccessLogPublisherResponse(ctx, updateResponse.JdbcBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -706,4 +738,5 @@ func (r *defaultJdbcBasedAccessLogPublisherResource) ImportState(ctx context.Con
 func importJdbcBasedAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
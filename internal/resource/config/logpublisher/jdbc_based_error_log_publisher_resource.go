@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &jdbcBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &jdbcBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &jdbcBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &jdbcBasedErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultJdbcBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultJdbcBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultJdbcBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJdbcBasedErrorLogPublisherResource{}
 )
 
 // Create a Jdbc Based Error Log Publisher resource
@@ -177,6 +179,15 @@ func jdbcBasedErrorLogPublisherSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jdbcBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJdbcBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJdbcBasedErrorLogPublisherFields(ctx context.Context, addRequest *client.AddJdbcBasedErrorLogPublisherRequest, plan jdbcBasedErrorLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -299,6 +310,7 @@ func (r *jdbcBasedErrorLogPublisherResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state jdbcBasedErrorLogPublisherResourceModel
 	readJdbcBasedErrorLogPublisherResponse(ctx, addResponse.JdbcBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -337,6 +349,12 @@ func (r *defaultJdbcBasedErrorLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JdbcBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jdbc Based Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jdbcBasedErrorLogPublisherResourceModel
 	readJdbcBasedErrorLogPublisherResponse(ctx, readResponse.JdbcBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -363,6 +381,7 @@ func (r *defaultJdbcBasedErrorLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readJdbcBasedErrorLogPublisherResponse(ctx, updateResponse.JdbcBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -395,7 +414,12 @@ func readJdbcBasedErrorLogPublisher(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jdbc Based Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Jdbc Based Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jdbc Based Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -405,6 +429,13 @@ func readJdbcBasedErrorLogPublisher(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JdbcBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Jdbc Based Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJdbcBasedErrorLogPublisherResponse(ctx, readResponse.JdbcBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -461,6 +492,7 @@ func updateJdbcBasedErrorLogPublisher(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readJdbcBasedErrorLogPublisherResponse(ctx, updateResponse.JdbcBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -509,4 +541,5 @@ func (r *defaultJdbcBasedErrorLogPublisherResource) ImportState(ctx context.Cont
 func importJdbcBasedErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
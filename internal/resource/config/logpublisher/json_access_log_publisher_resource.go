@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &jsonAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &jsonAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &jsonAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &jsonAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultJsonAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJsonAccessLogPublisherResource{}
 )
 
 // Create a Json Access Log Publisher resource
@@ -596,6 +598,15 @@ func jsonAccessLogPublisherSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jsonAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJsonAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJsonAccessLogPublisherFields(ctx context.Context, addRequest *client.AddJsonAccessLogPublisherRequest, plan jsonAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -1025,6 +1036,7 @@ func (r *jsonAccessLogPublisherResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state jsonAccessLogPublisherResourceModel
 	readJsonAccessLogPublisherResponse(ctx, addResponse.JsonAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -1063,6 +1075,12 @@ func (r *defaultJsonAccessLogPublisherResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JsonAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Json Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jsonAccessLogPublisherResourceModel
 	readJsonAccessLogPublisherResponse(ctx, readResponse.JsonAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -1089,6 +1107,7 @@ func (r *defaultJsonAccessLogPublisherResource) Create(ctx context.Context, req
 
 		// Read the response
 		readJsonAccessLogPublisherResponse(ctx, updateResponse.JsonAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -1121,7 +1140,12 @@ func readJsonAccessLogPublisher(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Json Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Json Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Json Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -1131,6 +1155,13 @@ func readJsonAccessLogPublisher(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JsonAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Json Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJsonAccessLogPublisherResponse(ctx, readResponse.JsonAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -1187,6 +1218,7 @@ func updateJsonAccessLogPublisher(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readJsonAccessLogPublisherResponse(ctx, updateResponse.JsonAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1235,4 +1267,5 @@ func (r *defaultJsonAccessLogPublisherResource) ImportState(ctx context.Context,
 func importJsonAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &jsonErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &jsonErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &jsonErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &jsonErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultJsonErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJsonErrorLogPublisherResource{}
 )
 
 // Create a Json Error Log Publisher resource
@@ -334,6 +336,15 @@ func jsonErrorLogPublisherSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jsonErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJsonErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJsonErrorLogPublisherFields(ctx context.Context, addRequest *client.AddJsonErrorLogPublisherRequest, plan jsonErrorLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -576,6 +587,7 @@ func (r *jsonErrorLogPublisherResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state jsonErrorLogPublisherResourceModel
 	readJsonErrorLogPublisherResponse(ctx, addResponse.JsonErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -614,6 +626,12 @@ func (r *defaultJsonErrorLogPublisherResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JsonErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Json Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jsonErrorLogPublisherResourceModel
 	readJsonErrorLogPublisherResponse(ctx, readResponse.JsonErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -640,6 +658,7 @@ func (r *defaultJsonErrorLogPublisherResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readJsonErrorLogPublisherResponse(ctx, updateResponse.JsonErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -672,7 +691,12 @@ func readJsonErrorLogPublisher(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Json Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Json Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Json Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -682,6 +706,13 @@ func readJsonErrorLogPublisher(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JsonErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Json Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJsonErrorLogPublisherResponse(ctx, readResponse.JsonErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -738,6 +769,7 @@ func updateJsonErrorLogPublisher(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readJsonErrorLogPublisherResponse(ctx, updateResponse.JsonErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -786,4 +818,5 @@ func (r *defaultJsonErrorLogPublisherResource) ImportState(ctx context.Context,
 func importJsonErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &operationTimingAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &operationTimingAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &operationTimingAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &operationTimingAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultOperationTimingAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultOperationTimingAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultOperationTimingAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultOperationTimingAccessLogPublisherResource{}
 )
 
 // Create a Operation Timing Access Log Publisher resource
@@ -388,6 +390,15 @@ func operationTimingAccessLogPublisherSchema(ctx context.Context, req resource.S
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *operationTimingAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultOperationTimingAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalOperationTimingAccessLogPublisherFields(ctx context.Context, addRequest *client.AddOperationTimingAccessLogPublisherRequest, plan operationTimingAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -673,6 +684,7 @@ func (r *operationTimingAccessLogPublisherResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state operationTimingAccessLogPublisherResourceModel
 	readOperationTimingAccessLogPublisherResponse(ctx, addResponse.OperationTimingAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -711,6 +723,12 @@ func (r *defaultOperationTimingAccessLogPublisherResource) Create(ctx context.Co
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.OperationTimingAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Operation Timing Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state operationTimingAccessLogPublisherResourceModel
 	readOperationTimingAccessLogPublisherResponse(ctx, readResponse.OperationTimingAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -737,6 +755,7 @@ func (r *defaultOperationTimingAccessLogPublisherResource) Create(ctx context.Co
 
 		// Read the response
 		readOperationTimingAccessLogPublisherResponse(ctx, updateResponse.OperationTimingAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -769,7 +788,12 @@ func readOperationTimingAccessLogPublisher(ctx context.Context, req resource.Rea
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Operation Timing Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Operation Timing Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Operation Timing Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -779,6 +803,13 @@ func readOperationTimingAccessLogPublisher(ctx context.Context, req resource.Rea
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.OperationTimingAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Operation Timing Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readOperationTimingAccessLogPublisherResponse(ctx, readResponse.OperationTimingAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -835,6 +866,7 @@ func updateOperationTimingAccessLogPublisher(ctx context.Context, req resource.U
 
 		// Read the response
 		readOperationTimingAccessLogPublisherResponse(ctx, updateResponse.OperationTimingAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -883,4 +915,5 @@ func (r *defaultOperationTimingAccessLogPublisherResource) ImportState(ctx conte
 func importOperationTimingAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
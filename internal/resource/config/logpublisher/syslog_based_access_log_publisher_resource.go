@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &syslogBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogBasedAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogBasedAccessLogPublisherResource{}
 )
 
 // Create a Syslog Based Access Log Publisher resource
@@ -510,6 +512,15 @@ func syslogBasedAccessLogPublisherSchema(ctx context.Context, req resource.Schem
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogBasedAccessLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogBasedAccessLogPublisherRequest, plan syslogBasedAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -867,6 +878,7 @@ func (r *syslogBasedAccessLogPublisherResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state syslogBasedAccessLogPublisherResourceModel
 	readSyslogBasedAccessLogPublisherResponse(ctx, addResponse.SyslogBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -905,6 +917,12 @@ func (r *defaultSyslogBasedAccessLogPublisherResource) Create(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Based Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogBasedAccessLogPublisherResourceModel
 	readSyslogBasedAccessLogPublisherResponse(ctx, readResponse.SyslogBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -931,6 +949,7 @@ func (r *defaultSyslogBasedAccessLogPublisherResource) Create(ctx context.Contex
 
 		// Read the response
 		readSyslogBasedAccessLogPublisherResponse(ctx, updateResponse.SyslogBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -963,7 +982,12 @@ func readSyslogBasedAccessLogPublisher(ctx context.Context, req resource.ReadReq
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Based Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Based Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Based Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -973,6 +997,13 @@ func readSyslogBasedAccessLogPublisher(ctx context.Context, req resource.ReadReq
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Based Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogBasedAccessLogPublisherResponse(ctx, readResponse.SyslogBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -1029,6 +1060,7 @@ func updateSyslogBasedAccessLogPublisher(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readSyslogBasedAccessLogPublisherResponse(ctx, updateResponse.SyslogBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1077,4 +1109,5 @@ func (r *defaultSyslogBasedAccessLogPublisherResource) ImportState(ctx context.C
 func importSyslogBasedAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &syslogBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogBasedErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogBasedErrorLogPublisherResource{}
 )
 
 // Create a Syslog Based Error Log Publisher resource
@@ -204,6 +206,15 @@ func syslogBasedErrorLogPublisherSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogBasedErrorLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogBasedErrorLogPublisherRequest, plan syslogBasedErrorLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -344,6 +355,7 @@ func (r *syslogBasedErrorLogPublisherResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state syslogBasedErrorLogPublisherResourceModel
 	readSyslogBasedErrorLogPublisherResponse(ctx, addResponse.SyslogBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -382,6 +394,12 @@ func (r *defaultSyslogBasedErrorLogPublisherResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Based Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogBasedErrorLogPublisherResourceModel
 	readSyslogBasedErrorLogPublisherResponse(ctx, readResponse.SyslogBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -408,6 +426,7 @@ func (r *defaultSyslogBasedErrorLogPublisherResource) Create(ctx context.Context
 
 		// Read the response
 		readSyslogBasedErrorLogPublisherResponse(ctx, updateResponse.SyslogBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -440,7 +459,12 @@ func readSyslogBasedErrorLogPublisher(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Based Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Based Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Based Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -450,6 +474,13 @@ func readSyslogBasedErrorLogPublisher(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Based Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogBasedErrorLogPublisherResponse(ctx, readResponse.SyslogBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -506,6 +537,7 @@ func updateSyslogBasedErrorLogPublisher(ctx context.Context, req resource.Update
 
 		// Read the response
 		readSyslogBasedErrorLogPublisherResponse(ctx, updateResponse.SyslogBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -554,4 +586,5 @@ func (r *defaultSyslogBasedErrorLogPublisherResource) ImportState(ctx context.Co
 func importSyslogBasedErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
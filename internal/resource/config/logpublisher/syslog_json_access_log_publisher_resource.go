@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &syslogJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogJsonAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogJsonAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogJsonAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogJsonAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogJsonAccessLogPublisherResource{}
 )
 
 // Create a Syslog Json Access Log Publisher resource
@@ -507,6 +509,15 @@ func syslogJsonAccessLogPublisherSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogJsonAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogJsonAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogJsonAccessLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogJsonAccessLogPublisherRequest, plan syslogJsonAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -872,6 +883,7 @@ func (r *syslogJsonAccessLogPublisherResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state syslogJsonAccessLogPublisherResourceModel
 	readSyslogJsonAccessLogPublisherResponse(ctx, addResponse.SyslogJsonAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -910,6 +922,12 @@ func (r *defaultSyslogJsonAccessLogPublisherResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Json Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogJsonAccessLogPublisherResourceModel
 	readSyslogJsonAccessLogPublisherResponse(ctx, readResponse.SyslogJsonAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -936,6 +954,7 @@ func (r *defaultSyslogJsonAccessLogPublisherResource) Create(ctx context.Context
 
 		// Read the response
 		readSyslogJsonAccessLogPublisherResponse(ctx, updateResponse.SyslogJsonAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -968,7 +987,12 @@ func readSyslogJsonAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Json Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -978,6 +1002,13 @@ func readSyslogJsonAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Json Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogJsonAccessLogPublisherResponse(ctx, readResponse.SyslogJsonAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -1034,6 +1065,7 @@ func updateSyslogJsonAccessLogPublisher(ctx context.Context, req resource.Update
 
 		// Read the response
 		readSyslogJsonAccessLogPublisherResponse(ctx, updateResponse.SyslogJsonAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1082,4 +1114,5 @@ func (r *defaultSyslogJsonAccessLogPublisherResource) ImportState(ctx context.Co
 func importSyslogJsonAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
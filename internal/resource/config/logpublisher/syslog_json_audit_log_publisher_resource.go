@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &syslogJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogJsonAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogJsonAuditLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogJsonAuditLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogJsonAuditLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogJsonAuditLogPublisherResource{}
 )
 
 // Create a Syslog Json Audit Log Publisher resource
@@ -369,6 +371,15 @@ func syslogJsonAuditLogPublisherSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogJsonAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogJsonAuditLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogJsonAuditLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogJsonAuditLogPublisherRequest, plan syslogJsonAuditLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -636,6 +647,7 @@ func (r *syslogJsonAuditLogPublisherResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state syslogJsonAuditLogPublisherResourceModel
 	readSyslogJsonAuditLogPublisherResponse(ctx, addResponse.SyslogJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -674,6 +686,12 @@ func (r *defaultSyslogJsonAuditLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Json Audit Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogJsonAuditLogPublisherResourceModel
 	readSyslogJsonAuditLogPublisherResponse(ctx, readResponse.SyslogJsonAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -700,6 +718,7 @@ func (r *defaultSyslogJsonAuditLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readSyslogJsonAuditLogPublisherResponse(ctx, updateResponse.SyslogJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -732,7 +751,12 @@ func readSyslogJsonAuditLogPublisher(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Audit Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Json Audit Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Audit Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -742,6 +766,13 @@ func readSyslogJsonAuditLogPublisher(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonAuditLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Json Audit Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogJsonAuditLogPublisherResponse(ctx, readResponse.SyslogJsonAuditLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -798,6 +829,7 @@ func updateSyslogJsonAuditLogPublisher(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readSyslogJsonAuditLogPublisherResponse(ctx, updateResponse.SyslogJsonAuditLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -846,4 +878,5 @@ func (r *defaultSyslogJsonAuditLogPublisherResource) ImportState(ctx context.Con
 func importSyslogJsonAuditLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &syslogJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogJsonErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogJsonErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogJsonErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogJsonErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogJsonErrorLogPublisherResource{}
 )
 
 // Create a Syslog Json Error Log Publisher resource
@@ -246,6 +248,15 @@ func syslogJsonErrorLogPublisherSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogJsonErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogJsonErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogJsonErrorLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogJsonErrorLogPublisherRequest, plan syslogJsonErrorLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.DefaultSeverity) {
@@ -425,6 +436,7 @@ func (r *syslogJsonErrorLogPublisherResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state syslogJsonErrorLogPublisherResourceModel
 	readSyslogJsonErrorLogPublisherResponse(ctx, addResponse.SyslogJsonErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -463,6 +475,12 @@ func (r *defaultSyslogJsonErrorLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Json Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogJsonErrorLogPublisherResourceModel
 	readSyslogJsonErrorLogPublisherResponse(ctx, readResponse.SyslogJsonErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -489,6 +507,7 @@ func (r *defaultSyslogJsonErrorLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readSyslogJsonErrorLogPublisherResponse(ctx, updateResponse.SyslogJsonErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -521,7 +540,12 @@ func readSyslogJsonErrorLogPublisher(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Json Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -531,6 +555,13 @@ func readSyslogJsonErrorLogPublisher(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Json Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogJsonErrorLogPublisherResponse(ctx, readResponse.SyslogJsonErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -587,6 +618,7 @@ func updateSyslogJsonErrorLogPublisher(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readSyslogJsonErrorLogPublisherResponse(ctx, updateResponse.SyslogJsonErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -635,4 +667,5 @@ func (r *defaultSyslogJsonErrorLogPublisherResource) ImportState(ctx context.Con
 func importSyslogJsonErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &syslogJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogJsonHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogJsonHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogJsonHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogJsonHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogJsonHttpOperationLogPublisherResource{}
 )
 
 // Create a Syslog Json Http Operation Log Publisher resource
@@ -355,6 +357,15 @@ func syslogJsonHttpOperationLogPublisherSchema(ctx context.Context, req resource
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogJsonHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogJsonHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogJsonHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogJsonHttpOperationLogPublisherRequest, plan syslogJsonHttpOperationLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -612,6 +623,7 @@ func (r *syslogJsonHttpOperationLogPublisherResource) Create(ctx context.Context
 	// Read the response into the state
 	var state syslogJsonHttpOperationLogPublisherResourceModel
 	readSyslogJsonHttpOperationLogPublisherResponse(ctx, addResponse.SyslogJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -650,6 +662,12 @@ func (r *defaultSyslogJsonHttpOperationLogPublisherResource) Create(ctx context.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Json Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogJsonHttpOperationLogPublisherResourceModel
 	readSyslogJsonHttpOperationLogPublisherResponse(ctx, readResponse.SyslogJsonHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -676,6 +694,7 @@ func (r *defaultSyslogJsonHttpOperationLogPublisherResource) Create(ctx context.
 
 		// Read the response
 		readSyslogJsonHttpOperationLogPublisherResponse(ctx, updateResponse.SyslogJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -708,7 +727,12 @@ func readSyslogJsonHttpOperationLogPublisher(ctx context.Context, req resource.R
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Json Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Json Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -718,6 +742,13 @@ func readSyslogJsonHttpOperationLogPublisher(ctx context.Context, req resource.R
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogJsonHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Json Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogJsonHttpOperationLogPublisherResponse(ctx, readResponse.SyslogJsonHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -774,6 +805,7 @@ func updateSyslogJsonHttpOperationLogPublisher(ctx context.Context, req resource
 
 		// Read the response
 		readSyslogJsonHttpOperationLogPublisherResponse(ctx, updateResponse.SyslogJsonHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -822,4 +854,5 @@ func (r *defaultSyslogJsonHttpOperationLogPublisherResource) ImportState(ctx con
 func importSyslogJsonHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &syslogTextAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogTextAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogTextAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogTextAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogTextAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogTextAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogTextAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogTextAccessLogPublisherResource{}
 )
 
 // Create a Syslog Text Access Log Publisher resource
@@ -534,6 +536,15 @@ func syslogTextAccessLogPublisherSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogTextAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogTextAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogTextAccessLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogTextAccessLogPublisherRequest, plan syslogTextAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -922,6 +933,7 @@ func (r *syslogTextAccessLogPublisherResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state syslogTextAccessLogPublisherResourceModel
 	readSyslogTextAccessLogPublisherResponse(ctx, addResponse.SyslogTextAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -960,6 +972,12 @@ func (r *defaultSyslogTextAccessLogPublisherResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogTextAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Text Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogTextAccessLogPublisherResourceModel
 	readSyslogTextAccessLogPublisherResponse(ctx, readResponse.SyslogTextAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -986,6 +1004,7 @@ func (r *defaultSyslogTextAccessLogPublisherResource) Create(ctx context.Context
 
 		// Read the response
 		readSyslogTextAccessLogPublisherResponse(ctx, updateResponse.SyslogTextAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -1018,7 +1037,12 @@ func readSyslogTextAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Text Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Text Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Text Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -1028,6 +1052,13 @@ func readSyslogTextAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogTextAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Text Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogTextAccessLogPublisherResponse(ctx, readResponse.SyslogTextAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -1084,6 +1115,7 @@ func updateSyslogTextAccessLogPublisher(ctx context.Context, req resource.Update
 
 		// Read the response
 		readSyslogTextAccessLogPublisherResponse(ctx, updateResponse.SyslogTextAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -1132,4 +1164,5 @@ func (r *defaultSyslogTextAccessLogPublisherResource) ImportState(ctx context.Co
 func importSyslogTextAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &syslogTextErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &syslogTextErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &syslogTextErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &syslogTextErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultSyslogTextErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultSyslogTextErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultSyslogTextErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSyslogTextErrorLogPublisherResource{}
 )
 
 // Create a Syslog Text Error Log Publisher resource
@@ -255,6 +257,15 @@ func syslogTextErrorLogPublisherSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syslogTextErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSyslogTextErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSyslogTextErrorLogPublisherFields(ctx context.Context, addRequest *client.AddSyslogTextErrorLogPublisherRequest, plan syslogTextErrorLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.DefaultSeverity) {
@@ -445,6 +456,7 @@ func (r *syslogTextErrorLogPublisherResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state syslogTextErrorLogPublisherResourceModel
 	readSyslogTextErrorLogPublisherResponse(ctx, addResponse.SyslogTextErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -483,6 +495,12 @@ func (r *defaultSyslogTextErrorLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogTextErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Syslog Text Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syslogTextErrorLogPublisherResourceModel
 	readSyslogTextErrorLogPublisherResponse(ctx, readResponse.SyslogTextErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -509,6 +527,7 @@ func (r *defaultSyslogTextErrorLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readSyslogTextErrorLogPublisherResponse(ctx, updateResponse.SyslogTextErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -541,7 +560,12 @@ func readSyslogTextErrorLogPublisher(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Text Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Syslog Text Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Syslog Text Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -551,6 +575,13 @@ func readSyslogTextErrorLogPublisher(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyslogTextErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Syslog Text Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyslogTextErrorLogPublisherResponse(ctx, readResponse.SyslogTextErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -607,6 +638,7 @@ func updateSyslogTextErrorLogPublisher(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readSyslogTextErrorLogPublisherResponse(ctx, updateResponse.SyslogTextErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -655,4 +687,5 @@ func (r *defaultSyslogTextErrorLogPublisherResource) ImportState(ctx context.Con
 func importSyslogTextErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &thirdPartyAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &thirdPartyAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultThirdPartyAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyAccessLogPublisherResource{}
 )
 
 // Create a Third Party Access Log Publisher resource
@@ -277,6 +279,15 @@ func thirdPartyAccessLogPublisherSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyAccessLogPublisherFields(ctx context.Context, addRequest *client.AddThirdPartyAccessLogPublisherRequest, plan thirdPartyAccessLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -474,6 +485,7 @@ func (r *thirdPartyAccessLogPublisherResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state thirdPartyAccessLogPublisherResourceModel
 	readThirdPartyAccessLogPublisherResponse(ctx, addResponse.ThirdPartyAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -512,6 +524,12 @@ func (r *defaultThirdPartyAccessLogPublisherResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyAccessLogPublisherResourceModel
 	readThirdPartyAccessLogPublisherResponse(ctx, readResponse.ThirdPartyAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -538,6 +556,7 @@ func (r *defaultThirdPartyAccessLogPublisherResource) Create(ctx context.Context
 
 		// Read the response
 		readThirdPartyAccessLogPublisherResponse(ctx, updateResponse.ThirdPartyAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -570,7 +589,12 @@ func readThirdPartyAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -580,6 +604,13 @@ func readThirdPartyAccessLogPublisher(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyAccessLogPublisherResponse(ctx, readResponse.ThirdPartyAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -636,6 +667,7 @@ func updateThirdPartyAccessLogPublisher(ctx context.Context, req resource.Update
 
 		// Read the response
 		readThirdPartyAccessLogPublisherResponse(ctx, updateResponse.ThirdPartyAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -684,4 +716,5 @@ func (r *defaultThirdPartyAccessLogPublisherResource) ImportState(ctx context.Co
 func importThirdPartyAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
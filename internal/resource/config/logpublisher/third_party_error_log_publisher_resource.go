@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &thirdPartyErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultThirdPartyErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyErrorLogPublisherResource{}
 )
 
 // Create a Third Party Error Log Publisher resource
@@ -163,6 +165,15 @@ func thirdPartyErrorLogPublisherSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyErrorLogPublisherFields(ctx context.Context, addRequest *client.AddThirdPartyErrorLogPublisherRequest, plan thirdPartyErrorLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -276,6 +287,7 @@ func (r *thirdPartyErrorLogPublisherResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state thirdPartyErrorLogPublisherResourceModel
 	readThirdPartyErrorLogPublisherResponse(ctx, addResponse.ThirdPartyErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -314,6 +326,12 @@ func (r *defaultThirdPartyErrorLogPublisherResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyErrorLogPublisherResourceModel
 	readThirdPartyErrorLogPublisherResponse(ctx, readResponse.ThirdPartyErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -340,6 +358,7 @@ func (r *defaultThirdPartyErrorLogPublisherResource) Create(ctx context.Context,
 
 		// Read the response
 		readThirdPartyErrorLogPublisherResponse(ctx, updateResponse.ThirdPartyErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -372,7 +391,12 @@ func readThirdPartyErrorLogPublisher(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -382,6 +406,13 @@ func readThirdPartyErrorLogPublisher(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyErrorLogPublisherResponse(ctx, readResponse.ThirdPartyErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -438,6 +469,7 @@ func updateThirdPartyErrorLogPublisher(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readThirdPartyErrorLogPublisherResponse(ctx, updateResponse.ThirdPartyErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -486,4 +518,5 @@ func (r *defaultThirdPartyErrorLogPublisherResource) ImportState(ctx context.Con
 func importThirdPartyErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
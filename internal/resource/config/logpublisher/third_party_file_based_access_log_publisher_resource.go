@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &thirdPartyFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &thirdPartyFileBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyFileBasedAccessLogPublisherResource{}
 	_ resource.Resource                = &defaultThirdPartyFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyFileBasedAccessLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyFileBasedAccessLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyFileBasedAccessLogPublisherResource{}
 )
 
 // Create a Third Party File Based Access Log Publisher resource
@@ -408,6 +410,15 @@ func thirdPartyFileBasedAccessLogPublisherSchema(ctx context.Context, req resour
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyFileBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyFileBasedAccessLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyFileBasedAccessLogPublisherFields(ctx context.Context, addRequest *client.AddThirdPartyFileBasedAccessLogPublisherRequest, plan thirdPartyFileBasedAccessLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -708,6 +719,7 @@ func (r *thirdPartyFileBasedAccessLogPublisherResource) Create(ctx context.Conte
 	// Read the response into the state
 	var state thirdPartyFileBasedAccessLogPublisherResourceModel
 	readThirdPartyFileBasedAccessLogPublisherResponse(ctx, addResponse.ThirdPartyFileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -746,6 +758,12 @@ func (r *defaultThirdPartyFileBasedAccessLogPublisherResource) Create(ctx contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyFileBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party File Based Access Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyFileBasedAccessLogPublisherResourceModel
 	readThirdPartyFileBasedAccessLogPublisherResponse(ctx, readResponse.ThirdPartyFileBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -772,6 +790,7 @@ func (r *defaultThirdPartyFileBasedAccessLogPublisherResource) Create(ctx contex
 
 		// Read the response
 		readThirdPartyFileBasedAccessLogPublisherResponse(ctx, updateResponse.ThirdPartyFileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -804,7 +823,12 @@ func readThirdPartyFileBasedAccessLogPublisher(ctx context.Context, req resource
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party File Based Access Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party File Based Access Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party File Based Access Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -814,6 +838,13 @@ func readThirdPartyFileBasedAccessLogPublisher(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyFileBasedAccessLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party File Based Access Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyFileBasedAccessLogPublisherResponse(ctx, readResponse.ThirdPartyFileBasedAccessLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -870,6 +901,7 @@ func updateThirdPartyFileBasedAccessLogPublisher(ctx context.Context, req resour
 
 		// Read the response
 		readThirdPartyFileBasedAccessLogPublisherResponse(ctx, updateResponse.ThirdPartyFileBasedAccessLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -918,4 +950,5 @@ func (r *defaultThirdPartyFileBasedAccessLogPublisherResource) ImportState(ctx c
 func importThirdPartyFileBasedAccessLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &thirdPartyFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &thirdPartyFileBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyFileBasedErrorLogPublisherResource{}
 	_ resource.Resource                = &defaultThirdPartyFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyFileBasedErrorLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyFileBasedErrorLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyFileBasedErrorLogPublisherResource{}
 )
 
 // Create a Third Party File Based Error Log Publisher resource
@@ -295,6 +297,15 @@ func thirdPartyFileBasedErrorLogPublisherSchema(ctx context.Context, req resourc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyFileBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyFileBasedErrorLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyFileBasedErrorLogPublisherFields(ctx context.Context, addRequest *client.AddThirdPartyFileBasedErrorLogPublisherRequest, plan thirdPartyFileBasedErrorLogPublisherResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -511,6 +522,7 @@ func (r *thirdPartyFileBasedErrorLogPublisherResource) Create(ctx context.Contex
 	// Read the response into the state
 	var state thirdPartyFileBasedErrorLogPublisherResourceModel
 	readThirdPartyFileBasedErrorLogPublisherResponse(ctx, addResponse.ThirdPartyFileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -549,6 +561,12 @@ func (r *defaultThirdPartyFileBasedErrorLogPublisherResource) Create(ctx context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyFileBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party File Based Error Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyFileBasedErrorLogPublisherResourceModel
 	readThirdPartyFileBasedErrorLogPublisherResponse(ctx, readResponse.ThirdPartyFileBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -575,6 +593,7 @@ func (r *defaultThirdPartyFileBasedErrorLogPublisherResource) Create(ctx context
 
 		// Read the response
 		readThirdPartyFileBasedErrorLogPublisherResponse(ctx, updateResponse.ThirdPartyFileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -607,7 +626,12 @@ func readThirdPartyFileBasedErrorLogPublisher(ctx context.Context, req resource.
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party File Based Error Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party File Based Error Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party File Based Error Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -617,6 +641,13 @@ func readThirdPartyFileBasedErrorLogPublisher(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyFileBasedErrorLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party File Based Error Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyFileBasedErrorLogPublisherResponse(ctx, readResponse.ThirdPartyFileBasedErrorLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -673,6 +704,7 @@ func updateThirdPartyFileBasedErrorLogPublisher(ctx context.Context, req resourc
 
 		// Read the response
 		readThirdPartyFileBasedErrorLogPublisherResponse(ctx, updateResponse.ThirdPartyFileBasedErrorLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -721,4 +753,5 @@ func (r *defaultThirdPartyFileBasedErrorLogPublisherResource) ImportState(ctx co
 func importThirdPartyFileBasedErrorLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
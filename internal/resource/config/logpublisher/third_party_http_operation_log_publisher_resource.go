@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &thirdPartyHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyHttpOperationLogPublisherResource{}
 	_ resource.Resource                = &defaultThirdPartyHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyHttpOperationLogPublisherResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyHttpOperationLogPublisherResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyHttpOperationLogPublisherResource{}
 )
 
 // Create a Third Party Http Operation Log Publisher resource
@@ -143,6 +145,15 @@ func thirdPartyHttpOperationLogPublisherSchema(ctx context.Context, req resource
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyHttpOperationLogPublisherResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyHttpOperationLogPublisherFields(ctx context.Context, addRequest *client.AddThirdPartyHttpOperationLogPublisherRequest, plan thirdPartyHttpOperationLogPublisherResourceModel) error {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -233,6 +244,7 @@ func (r *thirdPartyHttpOperationLogPublisherResource) Create(ctx context.Context
 	// Read the response into the state
 	var state thirdPartyHttpOperationLogPublisherResourceModel
 	readThirdPartyHttpOperationLogPublisherResponse(ctx, addResponse.ThirdPartyHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -271,6 +283,12 @@ func (r *defaultThirdPartyHttpOperationLogPublisherResource) Create(ctx context.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Http Operation Log Publisher", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyHttpOperationLogPublisherResourceModel
 	readThirdPartyHttpOperationLogPublisherResponse(ctx, readResponse.ThirdPartyHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
@@ -297,6 +315,7 @@ func (r *defaultThirdPartyHttpOperationLogPublisherResource) Create(ctx context.
 
 		// Read the response
 		readThirdPartyHttpOperationLogPublisherResponse(ctx, updateResponse.ThirdPartyHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -329,7 +348,12 @@ func readThirdPartyHttpOperationLogPublisher(ctx context.Context, req resource.R
 	readResponse, httpResp, err := apiClient.LogPublisherApi.GetLogPublisher(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Http Operation Log Publisher", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Http Operation Log Publisher", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Http Operation Log Publisher", err, httpResp)
+		}
 		return
 	}
 
@@ -339,6 +363,13 @@ func readThirdPartyHttpOperationLogPublisher(ctx context.Context, req resource.R
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyHttpOperationLogPublisherResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Http Operation Log Publisher", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyHttpOperationLogPublisherResponse(ctx, readResponse.ThirdPartyHttpOperationLogPublisherResponse, &state, &state, &resp.Diagnostics)
 
@@ -395,6 +426,7 @@ func updateThirdPartyHttpOperationLogPublisher(ctx context.Context, req resource
 
 		// Read the response
 		readThirdPartyHttpOperationLogPublisherResponse(ctx, updateResponse.ThirdPartyHttpOperationLogPublisherResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -443,4 +475,5 @@ func (r *defaultThirdPartyHttpOperationLogPublisherResource) ImportState(ctx con
 func importThirdPartyHttpOperationLogPublisher(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,502 @@
+package passphraseprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &amazonSecretsManagerPassphraseProviderResource{}
+	_ resource.ResourceWithConfigure   = &amazonSecretsManagerPassphraseProviderResource{}
+	_ resource.ResourceWithImportState = &amazonSecretsManagerPassphraseProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &amazonSecretsManagerPassphraseProviderResource{}
+	_ resource.Resource                = &defaultAmazonSecretsManagerPassphraseProviderResource{}
+	_ resource.ResourceWithConfigure   = &defaultAmazonSecretsManagerPassphraseProviderResource{}
+	_ resource.ResourceWithImportState = &defaultAmazonSecretsManagerPassphraseProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAmazonSecretsManagerPassphraseProviderResource{}
+)
+
+// Create a Amazon Secrets Manager Passphrase Provider resource
+func NewAmazonSecretsManagerPassphraseProviderResource() resource.Resource {
+	return &amazonSecretsManagerPassphraseProviderResource{}
+}
+
+func NewDefaultAmazonSecretsManagerPassphraseProviderResource() resource.Resource {
+	return &defaultAmazonSecretsManagerPassphraseProviderResource{}
+}
+
+// amazonSecretsManagerPassphraseProviderResource is the resource implementation.
+type amazonSecretsManagerPassphraseProviderResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultAmazonSecretsManagerPassphraseProviderResource is the resource implementation.
+type defaultAmazonSecretsManagerPassphraseProviderResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *amazonSecretsManagerPassphraseProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_amazon_secrets_manager_passphrase_provider"
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_amazon_secrets_manager_passphrase_provider"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *amazonSecretsManagerPassphraseProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type amazonSecretsManagerPassphraseProviderResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
+	Notifications      types.Set    `tfsdk:"notifications"`
+	RequiredActions    types.Set    `tfsdk:"required_actions"`
+	AwsExternalServer  types.String `tfsdk:"aws_external_server"`
+	SecretID           types.String `tfsdk:"secret_id"`
+	SecretFieldName    types.String `tfsdk:"secret_field_name"`
+	SecretVersionID    types.String `tfsdk:"secret_version_id"`
+	SecretVersionStage types.String `tfsdk:"secret_version_stage"`
+	MaxCacheDuration   types.String `tfsdk:"max_cache_duration"`
+	Description        types.String `tfsdk:"description"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *amazonSecretsManagerPassphraseProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	amazonSecretsManagerPassphraseProviderSchema(ctx, req, resp, false)
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	amazonSecretsManagerPassphraseProviderSchema(ctx, req, resp, true)
+}
+
+func amazonSecretsManagerPassphraseProviderSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages an Amazon Secrets Manager Passphrase Provider.",
+		Attributes: map[string]schema.Attribute{
+			"aws_external_server": schema.StringAttribute{
+				Description: "The external server with information to use when interacting with the AWS Secrets Manager.",
+				Required:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The Amazon Resource Name (ARN) or the user-friendly name of the secret to be retrieved.",
+				Required:    true,
+			},
+			"secret_field_name": schema.StringAttribute{
+				Description: "The name of the JSON field whose value is the passphrase that will be retrieved.",
+				Required:    true,
+			},
+			"secret_version_id": schema.StringAttribute{
+				Description: "The unique identifier for the version of the secret to be retrieved.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_version_stage": schema.StringAttribute{
+				Description: "The staging label for the version of the secret to be retrieved.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_cache_duration": schema.StringAttribute{
+				Description: "The maximum length of time that the passphrase provider may cache the passphrase that has been read from Vault. A value of zero seconds indicates that the provider should always attempt to read the passphrase from Vault.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Passphrase Provider",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether this Passphrase Provider is enabled for use in the server.",
+				Required:    true,
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *amazonSecretsManagerPassphraseProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalAmazonSecretsManagerPassphraseProviderFields(ctx context.Context, addRequest *client.AddAmazonSecretsManagerPassphraseProviderRequest, plan amazonSecretsManagerPassphraseProviderResourceModel) {
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.SecretVersionID) {
+		stringVal := plan.SecretVersionID.ValueString()
+		addRequest.SecretVersionID = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.SecretVersionStage) {
+		stringVal := plan.SecretVersionStage.ValueString()
+		addRequest.SecretVersionStage = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.MaxCacheDuration) {
+		stringVal := plan.MaxCacheDuration.ValueString()
+		addRequest.MaxCacheDuration = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+}
+
+// Read a AmazonSecretsManagerPassphraseProviderResponse object into the model struct
+func readAmazonSecretsManagerPassphraseProviderResponse(ctx context.Context, r *client.AmazonSecretsManagerPassphraseProviderResponse, state *amazonSecretsManagerPassphraseProviderResourceModel, expectedValues *amazonSecretsManagerPassphraseProviderResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.AwsExternalServer = types.StringValue(r.AwsExternalServer)
+	state.SecretID = types.StringValue(r.SecretID)
+	state.SecretFieldName = types.StringValue(r.SecretFieldName)
+	state.SecretVersionID = internaltypes.StringTypeOrNil(r.SecretVersionID, internaltypes.IsEmptyString(expectedValues.SecretVersionID))
+	state.SecretVersionStage = internaltypes.StringTypeOrNil(r.SecretVersionStage, internaltypes.IsEmptyString(expectedValues.SecretVersionStage))
+	state.MaxCacheDuration = internaltypes.StringTypeOrNil(r.MaxCacheDuration, internaltypes.IsEmptyString(expectedValues.MaxCacheDuration))
+	config.CheckMismatchedPDFormattedAttributes("max_cache_duration",
+		expectedValues.MaxCacheDuration, state.MaxCacheDuration, diagnostics)
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createAmazonSecretsManagerPassphraseProviderOperations(plan amazonSecretsManagerPassphraseProviderResourceModel, state amazonSecretsManagerPassphraseProviderResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddStringOperationIfNecessary(&ops, plan.AwsExternalServer, state.AwsExternalServer, "aws-external-server")
+	operations.AddStringOperationIfNecessary(&ops, plan.SecretID, state.SecretID, "secret-id")
+	operations.AddStringOperationIfNecessary(&ops, plan.SecretFieldName, state.SecretFieldName, "secret-field-name")
+	operations.AddStringOperationIfNecessary(&ops, plan.SecretVersionID, state.SecretVersionID, "secret-version-id")
+	operations.AddStringOperationIfNecessary(&ops, plan.SecretVersionStage, state.SecretVersionStage, "secret-version-stage")
+	operations.AddStringOperationIfNecessary(&ops, plan.MaxCacheDuration, state.MaxCacheDuration, "max-cache-duration")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	return ops
+}
+
+// Create a new resource
+func (r *amazonSecretsManagerPassphraseProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan amazonSecretsManagerPassphraseProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := client.NewAddAmazonSecretsManagerPassphraseProviderRequest(plan.Id.ValueString(),
+		[]client.EnumamazonSecretsManagerPassphraseProviderSchemaUrn{client.ENUMAMAZONSECRETSMANAGERPASSPHRASEPROVIDERSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSPHRASE_PROVIDERAMAZON_SECRETS_MANAGER},
+		plan.AwsExternalServer.ValueString(),
+		plan.SecretID.ValueString(),
+		plan.SecretFieldName.ValueString(),
+		plan.Enabled.ValueBool())
+	addOptionalAmazonSecretsManagerPassphraseProviderFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PassphraseProviderApi.AddPassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPassphraseProviderRequest(
+		client.AddAmazonSecretsManagerPassphraseProviderRequestAsAddPassphraseProviderRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PassphraseProviderApi.AddPassphraseProviderExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Amazon Secrets Manager Passphrase Provider", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state amazonSecretsManagerPassphraseProviderResourceModel
+	readAmazonSecretsManagerPassphraseProviderResponse(ctx, addResponse.AmazonSecretsManagerPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan amazonSecretsManagerPassphraseProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PassphraseProviderApi.GetPassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Amazon Secrets Manager Passphrase Provider", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AmazonSecretsManagerPassphraseProviderResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Amazon Secrets Manager Passphrase Provider", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state amazonSecretsManagerPassphraseProviderResourceModel
+	readAmazonSecretsManagerPassphraseProviderResponse(ctx, readResponse.AmazonSecretsManagerPassphraseProviderResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PassphraseProviderApi.UpdatePassphraseProvider(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createAmazonSecretsManagerPassphraseProviderOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PassphraseProviderApi.UpdatePassphraseProviderExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Amazon Secrets Manager Passphrase Provider", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAmazonSecretsManagerPassphraseProviderResponse(ctx, updateResponse.AmazonSecretsManagerPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *amazonSecretsManagerPassphraseProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAmazonSecretsManagerPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAmazonSecretsManagerPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readAmazonSecretsManagerPassphraseProvider(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state amazonSecretsManagerPassphraseProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PassphraseProviderApi.GetPassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Amazon Secrets Manager Passphrase Provider", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Amazon Secrets Manager Passphrase Provider", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AmazonSecretsManagerPassphraseProviderResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Amazon Secrets Manager Passphrase Provider", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readAmazonSecretsManagerPassphraseProviderResponse(ctx, readResponse.AmazonSecretsManagerPassphraseProviderResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *amazonSecretsManagerPassphraseProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAmazonSecretsManagerPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAmazonSecretsManagerPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateAmazonSecretsManagerPassphraseProvider(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan amazonSecretsManagerPassphraseProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state amazonSecretsManagerPassphraseProviderResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PassphraseProviderApi.UpdatePassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createAmazonSecretsManagerPassphraseProviderOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PassphraseProviderApi.UpdatePassphraseProviderExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Amazon Secrets Manager Passphrase Provider", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAmazonSecretsManagerPassphraseProviderResponse(ctx, updateResponse.AmazonSecretsManagerPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *amazonSecretsManagerPassphraseProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state amazonSecretsManagerPassphraseProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PassphraseProviderApi.DeletePassphraseProviderExecute(r.apiClient.PassphraseProviderApi.DeletePassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Amazon Secrets Manager Passphrase Provider", err, httpResp)
+		return
+	}
+}
+
+func (r *amazonSecretsManagerPassphraseProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAmazonSecretsManagerPassphraseProvider(ctx, req, resp)
+}
+
+func (r *defaultAmazonSecretsManagerPassphraseProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAmazonSecretsManagerPassphraseProvider(ctx, req, resp)
+}
+
+func importAmazonSecretsManagerPassphraseProvider(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
@@ -0,0 +1,486 @@
+package passphraseprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &azureKeyVaultPassphraseProviderResource{}
+	_ resource.ResourceWithConfigure   = &azureKeyVaultPassphraseProviderResource{}
+	_ resource.ResourceWithImportState = &azureKeyVaultPassphraseProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &azureKeyVaultPassphraseProviderResource{}
+	_ resource.Resource                = &defaultAzureKeyVaultPassphraseProviderResource{}
+	_ resource.ResourceWithConfigure   = &defaultAzureKeyVaultPassphraseProviderResource{}
+	_ resource.ResourceWithImportState = &defaultAzureKeyVaultPassphraseProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAzureKeyVaultPassphraseProviderResource{}
+)
+
+// Create a Azure Key Vault Passphrase Provider resource
+func NewAzureKeyVaultPassphraseProviderResource() resource.Resource {
+	return &azureKeyVaultPassphraseProviderResource{}
+}
+
+func NewDefaultAzureKeyVaultPassphraseProviderResource() resource.Resource {
+	return &defaultAzureKeyVaultPassphraseProviderResource{}
+}
+
+// azureKeyVaultPassphraseProviderResource is the resource implementation.
+type azureKeyVaultPassphraseProviderResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultAzureKeyVaultPassphraseProviderResource is the resource implementation.
+type defaultAzureKeyVaultPassphraseProviderResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *azureKeyVaultPassphraseProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_key_vault_passphrase_provider"
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_azure_key_vault_passphrase_provider"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *azureKeyVaultPassphraseProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type azureKeyVaultPassphraseProviderResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	LastUpdated               types.String `tfsdk:"last_updated"`
+	Notifications             types.Set    `tfsdk:"notifications"`
+	RequiredActions           types.Set    `tfsdk:"required_actions"`
+	KeyVaultURI               types.String `tfsdk:"key_vault_uri"`
+	AzureAuthenticationMethod types.String `tfsdk:"azure_authentication_method"`
+	HttpProxyExternalServer   types.String `tfsdk:"http_proxy_external_server"`
+	SecretName                types.String `tfsdk:"secret_name"`
+	MaxCacheDuration          types.String `tfsdk:"max_cache_duration"`
+	Description               types.String `tfsdk:"description"`
+	Enabled                   types.Bool   `tfsdk:"enabled"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *azureKeyVaultPassphraseProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	azureKeyVaultPassphraseProviderSchema(ctx, req, resp, false)
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	azureKeyVaultPassphraseProviderSchema(ctx, req, resp, true)
+}
+
+func azureKeyVaultPassphraseProviderSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages an Azure Key Vault Passphrase Provider.",
+		Attributes: map[string]schema.Attribute{
+			"key_vault_uri": schema.StringAttribute{
+				Description: "The URI that identifies the Azure Key Vault from which the secret is to be retrieved.",
+				Required:    true,
+			},
+			"azure_authentication_method": schema.StringAttribute{
+				Description: "The mechanism used to authenticate to the Azure service.",
+				Required:    true,
+			},
+			"http_proxy_external_server": schema.StringAttribute{
+				Description: "A reference to an HTTP proxy server that should be used for requests sent to the Azure service.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the secret to retrieve.",
+				Required:    true,
+			},
+			"max_cache_duration": schema.StringAttribute{
+				Description: "The maximum length of time that the passphrase provider may cache the passphrase that has been read from Azure Key Vault. A value of zero seconds indicates that the provider should always attempt to read the passphrase from the Azure service.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Passphrase Provider",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether this Passphrase Provider is enabled for use in the server.",
+				Required:    true,
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *azureKeyVaultPassphraseProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalAzureKeyVaultPassphraseProviderFields(ctx context.Context, addRequest *client.AddAzureKeyVaultPassphraseProviderRequest, plan azureKeyVaultPassphraseProviderResourceModel) {
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.HttpProxyExternalServer) {
+		stringVal := plan.HttpProxyExternalServer.ValueString()
+		addRequest.HttpProxyExternalServer = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.MaxCacheDuration) {
+		stringVal := plan.MaxCacheDuration.ValueString()
+		addRequest.MaxCacheDuration = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+}
+
+// Read a AzureKeyVaultPassphraseProviderResponse object into the model struct
+func readAzureKeyVaultPassphraseProviderResponse(ctx context.Context, r *client.AzureKeyVaultPassphraseProviderResponse, state *azureKeyVaultPassphraseProviderResourceModel, expectedValues *azureKeyVaultPassphraseProviderResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.KeyVaultURI = types.StringValue(r.KeyVaultURI)
+	state.AzureAuthenticationMethod = types.StringValue(r.AzureAuthenticationMethod)
+	state.HttpProxyExternalServer = internaltypes.StringTypeOrNil(r.HttpProxyExternalServer, internaltypes.IsEmptyString(expectedValues.HttpProxyExternalServer))
+	state.SecretName = types.StringValue(r.SecretName)
+	state.MaxCacheDuration = internaltypes.StringTypeOrNil(r.MaxCacheDuration, internaltypes.IsEmptyString(expectedValues.MaxCacheDuration))
+	config.CheckMismatchedPDFormattedAttributes("max_cache_duration",
+		expectedValues.MaxCacheDuration, state.MaxCacheDuration, diagnostics)
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createAzureKeyVaultPassphraseProviderOperations(plan azureKeyVaultPassphraseProviderResourceModel, state azureKeyVaultPassphraseProviderResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddStringOperationIfNecessary(&ops, plan.KeyVaultURI, state.KeyVaultURI, "key-vault-uri")
+	operations.AddStringOperationIfNecessary(&ops, plan.AzureAuthenticationMethod, state.AzureAuthenticationMethod, "azure-authentication-method")
+	operations.AddStringOperationIfNecessary(&ops, plan.HttpProxyExternalServer, state.HttpProxyExternalServer, "http-proxy-external-server")
+	operations.AddStringOperationIfNecessary(&ops, plan.SecretName, state.SecretName, "secret-name")
+	operations.AddStringOperationIfNecessary(&ops, plan.MaxCacheDuration, state.MaxCacheDuration, "max-cache-duration")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	return ops
+}
+
+// Create a new resource
+func (r *azureKeyVaultPassphraseProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan azureKeyVaultPassphraseProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := client.NewAddAzureKeyVaultPassphraseProviderRequest(plan.Id.ValueString(),
+		[]client.EnumazureKeyVaultPassphraseProviderSchemaUrn{client.ENUMAZUREKEYVAULTPASSPHRASEPROVIDERSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSPHRASE_PROVIDERAZURE_KEY_VAULT},
+		plan.KeyVaultURI.ValueString(),
+		plan.AzureAuthenticationMethod.ValueString(),
+		plan.SecretName.ValueString(),
+		plan.Enabled.ValueBool())
+	addOptionalAzureKeyVaultPassphraseProviderFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PassphraseProviderApi.AddPassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPassphraseProviderRequest(
+		client.AddAzureKeyVaultPassphraseProviderRequestAsAddPassphraseProviderRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PassphraseProviderApi.AddPassphraseProviderExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Azure Key Vault Passphrase Provider", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state azureKeyVaultPassphraseProviderResourceModel
+	readAzureKeyVaultPassphraseProviderResponse(ctx, addResponse.AzureKeyVaultPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan azureKeyVaultPassphraseProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PassphraseProviderApi.GetPassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Azure Key Vault Passphrase Provider", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AzureKeyVaultPassphraseProviderResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Azure Key Vault Passphrase Provider", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state azureKeyVaultPassphraseProviderResourceModel
+	readAzureKeyVaultPassphraseProviderResponse(ctx, readResponse.AzureKeyVaultPassphraseProviderResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PassphraseProviderApi.UpdatePassphraseProvider(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createAzureKeyVaultPassphraseProviderOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PassphraseProviderApi.UpdatePassphraseProviderExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Azure Key Vault Passphrase Provider", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAzureKeyVaultPassphraseProviderResponse(ctx, updateResponse.AzureKeyVaultPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *azureKeyVaultPassphraseProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAzureKeyVaultPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAzureKeyVaultPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readAzureKeyVaultPassphraseProvider(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state azureKeyVaultPassphraseProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PassphraseProviderApi.GetPassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Azure Key Vault Passphrase Provider", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Azure Key Vault Passphrase Provider", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AzureKeyVaultPassphraseProviderResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Azure Key Vault Passphrase Provider", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readAzureKeyVaultPassphraseProviderResponse(ctx, readResponse.AzureKeyVaultPassphraseProviderResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *azureKeyVaultPassphraseProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAzureKeyVaultPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAzureKeyVaultPassphraseProvider(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateAzureKeyVaultPassphraseProvider(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan azureKeyVaultPassphraseProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state azureKeyVaultPassphraseProviderResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PassphraseProviderApi.UpdatePassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createAzureKeyVaultPassphraseProviderOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PassphraseProviderApi.UpdatePassphraseProviderExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Azure Key Vault Passphrase Provider", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAzureKeyVaultPassphraseProviderResponse(ctx, updateResponse.AzureKeyVaultPassphraseProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultAzureKeyVaultPassphraseProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *azureKeyVaultPassphraseProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state azureKeyVaultPassphraseProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PassphraseProviderApi.DeletePassphraseProviderExecute(r.apiClient.PassphraseProviderApi.DeletePassphraseProvider(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Azure Key Vault Passphrase Provider", err, httpResp)
+		return
+	}
+}
+
+func (r *azureKeyVaultPassphraseProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAzureKeyVaultPassphraseProvider(ctx, req, resp)
+}
+
+func (r *defaultAzureKeyVaultPassphraseProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAzureKeyVaultPassphraseProvider(ctx, req, resp)
+}
+
+func importAzureKeyVaultPassphraseProvider(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
@@ -0,0 +1,452 @@
+package passwordstoragescheme
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &amazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.ResourceWithConfigure   = &amazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.ResourceWithImportState = &amazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.ResourceWithModifyPlan  = &amazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.Resource                = &defaultAmazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.ResourceWithConfigure   = &defaultAmazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.ResourceWithImportState = &defaultAmazonSecretsManagerPasswordStorageSchemeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAmazonSecretsManagerPasswordStorageSchemeResource{}
+)
+
+// Create a Amazon Secrets Manager Password Storage Scheme resource
+func NewAmazonSecretsManagerPasswordStorageSchemeResource() resource.Resource {
+	return &amazonSecretsManagerPasswordStorageSchemeResource{}
+}
+
+func NewDefaultAmazonSecretsManagerPasswordStorageSchemeResource() resource.Resource {
+	return &defaultAmazonSecretsManagerPasswordStorageSchemeResource{}
+}
+
+// amazonSecretsManagerPasswordStorageSchemeResource is the resource implementation.
+type amazonSecretsManagerPasswordStorageSchemeResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultAmazonSecretsManagerPasswordStorageSchemeResource is the resource implementation.
+type defaultAmazonSecretsManagerPasswordStorageSchemeResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_amazon_secrets_manager_password_storage_scheme"
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_amazon_secrets_manager_password_storage_scheme"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type amazonSecretsManagerPasswordStorageSchemeResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	LastUpdated       types.String `tfsdk:"last_updated"`
+	Notifications     types.Set    `tfsdk:"notifications"`
+	RequiredActions   types.Set    `tfsdk:"required_actions"`
+	AwsExternalServer types.String `tfsdk:"aws_external_server"`
+	DefaultField      types.String `tfsdk:"default_field"`
+	Description       types.String `tfsdk:"description"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	amazonSecretsManagerPasswordStorageSchemeSchema(ctx, req, resp, false)
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	amazonSecretsManagerPasswordStorageSchemeSchema(ctx, req, resp, true)
+}
+
+func amazonSecretsManagerPasswordStorageSchemeSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages an Amazon Secrets Manager Password Storage Scheme.",
+		Attributes: map[string]schema.Attribute{
+			"aws_external_server": schema.StringAttribute{
+				Description: "The external server with information to use when interacting with the AWS Secrets Manager service.",
+				Required:    true,
+			},
+			"default_field": schema.StringAttribute{
+				Description: "The default name of the field in JSON objects contained in the AWS Secrets Manager service that contains the password for the target user.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Password Storage Scheme",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the Password Storage Scheme is enabled for use.",
+				Required:    true,
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalAmazonSecretsManagerPasswordStorageSchemeFields(ctx context.Context, addRequest *client.AddAmazonSecretsManagerPasswordStorageSchemeRequest, plan amazonSecretsManagerPasswordStorageSchemeResourceModel) {
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.DefaultField) {
+		stringVal := plan.DefaultField.ValueString()
+		addRequest.DefaultField = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+}
+
+// Read a AmazonSecretsManagerPasswordStorageSchemeResponse object into the model struct
+func readAmazonSecretsManagerPasswordStorageSchemeResponse(ctx context.Context, r *client.AmazonSecretsManagerPasswordStorageSchemeResponse, state *amazonSecretsManagerPasswordStorageSchemeResourceModel, expectedValues *amazonSecretsManagerPasswordStorageSchemeResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.AwsExternalServer = types.StringValue(r.AwsExternalServer)
+	state.DefaultField = internaltypes.StringTypeOrNil(r.DefaultField, internaltypes.IsEmptyString(expectedValues.DefaultField))
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createAmazonSecretsManagerPasswordStorageSchemeOperations(plan amazonSecretsManagerPasswordStorageSchemeResourceModel, state amazonSecretsManagerPasswordStorageSchemeResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddStringOperationIfNecessary(&ops, plan.AwsExternalServer, state.AwsExternalServer, "aws-external-server")
+	operations.AddStringOperationIfNecessary(&ops, plan.DefaultField, state.DefaultField, "default-field")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	return ops
+}
+
+// Create a new resource
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan amazonSecretsManagerPasswordStorageSchemeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := client.NewAddAmazonSecretsManagerPasswordStorageSchemeRequest(plan.Id.ValueString(),
+		[]client.EnumamazonSecretsManagerPasswordStorageSchemeSchemaUrn{client.ENUMAMAZONSECRETSMANAGERPASSWORDSTORAGESCHEMESCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSWORD_STORAGE_SCHEMEAMAZON_SECRETS_MANAGER},
+		plan.AwsExternalServer.ValueString(),
+		plan.Enabled.ValueBool())
+	addOptionalAmazonSecretsManagerPasswordStorageSchemeFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PasswordStorageSchemeApi.AddPasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPasswordStorageSchemeRequest(
+		client.AddAmazonSecretsManagerPasswordStorageSchemeRequestAsAddPasswordStorageSchemeRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeApi.AddPasswordStorageSchemeExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Amazon Secrets Manager Password Storage Scheme", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state amazonSecretsManagerPasswordStorageSchemeResourceModel
+	readAmazonSecretsManagerPasswordStorageSchemeResponse(ctx, addResponse.AmazonSecretsManagerPasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan amazonSecretsManagerPasswordStorageSchemeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PasswordStorageSchemeApi.GetPasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Amazon Secrets Manager Password Storage Scheme", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AmazonSecretsManagerPasswordStorageSchemeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Amazon Secrets Manager Password Storage Scheme", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state amazonSecretsManagerPasswordStorageSchemeResourceModel
+	readAmazonSecretsManagerPasswordStorageSchemeResponse(ctx, readResponse.AmazonSecretsManagerPasswordStorageSchemeResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageScheme(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createAmazonSecretsManagerPasswordStorageSchemeOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageSchemeExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Amazon Secrets Manager Password Storage Scheme", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAmazonSecretsManagerPasswordStorageSchemeResponse(ctx, updateResponse.AmazonSecretsManagerPasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAmazonSecretsManagerPasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAmazonSecretsManagerPasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readAmazonSecretsManagerPasswordStorageScheme(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state amazonSecretsManagerPasswordStorageSchemeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PasswordStorageSchemeApi.GetPasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Amazon Secrets Manager Password Storage Scheme", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Amazon Secrets Manager Password Storage Scheme", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AmazonSecretsManagerPasswordStorageSchemeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Amazon Secrets Manager Password Storage Scheme", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readAmazonSecretsManagerPasswordStorageSchemeResponse(ctx, readResponse.AmazonSecretsManagerPasswordStorageSchemeResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAmazonSecretsManagerPasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAmazonSecretsManagerPasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateAmazonSecretsManagerPasswordStorageScheme(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan amazonSecretsManagerPasswordStorageSchemeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state amazonSecretsManagerPasswordStorageSchemeResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createAmazonSecretsManagerPasswordStorageSchemeOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageSchemeExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Amazon Secrets Manager Password Storage Scheme", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAmazonSecretsManagerPasswordStorageSchemeResponse(ctx, updateResponse.AmazonSecretsManagerPasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state amazonSecretsManagerPasswordStorageSchemeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PasswordStorageSchemeApi.DeletePasswordStorageSchemeExecute(r.apiClient.PasswordStorageSchemeApi.DeletePasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Amazon Secrets Manager Password Storage Scheme", err, httpResp)
+		return
+	}
+}
+
+func (r *amazonSecretsManagerPasswordStorageSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAmazonSecretsManagerPasswordStorageScheme(ctx, req, resp)
+}
+
+func (r *defaultAmazonSecretsManagerPasswordStorageSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAmazonSecretsManagerPasswordStorageScheme(ctx, req, resp)
+}
+
+func importAmazonSecretsManagerPasswordStorageScheme(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
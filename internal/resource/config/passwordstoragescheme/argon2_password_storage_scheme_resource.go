@@ -0,0 +1,466 @@
+package passwordstoragescheme
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &argon2PasswordStorageSchemeResource{}
+	_ resource.ResourceWithConfigure   = &argon2PasswordStorageSchemeResource{}
+	_ resource.ResourceWithImportState = &argon2PasswordStorageSchemeResource{}
+	_ resource.ResourceWithModifyPlan  = &argon2PasswordStorageSchemeResource{}
+	_ resource.Resource                = &defaultArgon2PasswordStorageSchemeResource{}
+	_ resource.ResourceWithConfigure   = &defaultArgon2PasswordStorageSchemeResource{}
+	_ resource.ResourceWithImportState = &defaultArgon2PasswordStorageSchemeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultArgon2PasswordStorageSchemeResource{}
+)
+
+// Create a Argon2 Password Storage Scheme resource
+func NewArgon2PasswordStorageSchemeResource() resource.Resource {
+	return &argon2PasswordStorageSchemeResource{}
+}
+
+func NewDefaultArgon2PasswordStorageSchemeResource() resource.Resource {
+	return &defaultArgon2PasswordStorageSchemeResource{}
+}
+
+// argon2PasswordStorageSchemeResource is the resource implementation.
+type argon2PasswordStorageSchemeResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultArgon2PasswordStorageSchemeResource is the resource implementation.
+type defaultArgon2PasswordStorageSchemeResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *argon2PasswordStorageSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_argon2_password_storage_scheme"
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_argon2_password_storage_scheme"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *argon2PasswordStorageSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type argon2PasswordStorageSchemeResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	LastUpdated           types.String `tfsdk:"last_updated"`
+	Notifications         types.Set    `tfsdk:"notifications"`
+	RequiredActions       types.Set    `tfsdk:"required_actions"`
+	IterationCount        types.Int64  `tfsdk:"iteration_count"`
+	ParallelismFactor     types.Int64  `tfsdk:"parallelism_factor"`
+	MemoryUsageKb         types.Int64  `tfsdk:"memory_usage_kb"`
+	SaltLengthBytes       types.Int64  `tfsdk:"salt_length_bytes"`
+	DerivedKeyLengthBytes types.Int64  `tfsdk:"derived_key_length_bytes"`
+	Description           types.String `tfsdk:"description"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *argon2PasswordStorageSchemeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	argon2PasswordStorageSchemeSchema(ctx, req, resp, false)
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	argon2PasswordStorageSchemeSchema(ctx, req, resp, true)
+}
+
+func argon2PasswordStorageSchemeSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages an Argon2 Password Storage Scheme.",
+		Attributes: map[string]schema.Attribute{
+			"iteration_count": schema.Int64Attribute{
+				Description: "The number of rounds of cryptographic processing required in the course of encoding each password.",
+				Required:    true,
+			},
+			"parallelism_factor": schema.Int64Attribute{
+				Description: "The number of concurrent threads that will be used in the course of encoding each password.",
+				Required:    true,
+			},
+			"memory_usage_kb": schema.Int64Attribute{
+				Description: "The number of kilobytes of memory that must be used in the course of encoding each password.",
+				Required:    true,
+			},
+			"salt_length_bytes": schema.Int64Attribute{
+				Description: "The number of bytes to use for the generated salt.",
+				Required:    true,
+			},
+			"derived_key_length_bytes": schema.Int64Attribute{
+				Description: "The number of bytes to use for the derived key. The value must be greater than or equal to 8 and less than or equal to 512.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Password Storage Scheme",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the Password Storage Scheme is enabled for use.",
+				Required:    true,
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *argon2PasswordStorageSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalArgon2PasswordStorageSchemeFields(ctx context.Context, addRequest *client.AddArgon2PasswordStorageSchemeRequest, plan argon2PasswordStorageSchemeResourceModel) {
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+}
+
+// Read a Argon2PasswordStorageSchemeResponse object into the model struct
+func readArgon2PasswordStorageSchemeResponse(ctx context.Context, r *client.Argon2PasswordStorageSchemeResponse, state *argon2PasswordStorageSchemeResourceModel, expectedValues *argon2PasswordStorageSchemeResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.IterationCount = types.Int64Value(int64(r.IterationCount))
+	state.ParallelismFactor = types.Int64Value(int64(r.ParallelismFactor))
+	state.MemoryUsageKb = types.Int64Value(int64(r.MemoryUsageKb))
+	state.SaltLengthBytes = types.Int64Value(int64(r.SaltLengthBytes))
+	state.DerivedKeyLengthBytes = types.Int64Value(int64(r.DerivedKeyLengthBytes))
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createArgon2PasswordStorageSchemeOperations(plan argon2PasswordStorageSchemeResourceModel, state argon2PasswordStorageSchemeResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddInt64OperationIfNecessary(&ops, plan.IterationCount, state.IterationCount, "iteration-count")
+	operations.AddInt64OperationIfNecessary(&ops, plan.ParallelismFactor, state.ParallelismFactor, "parallelism-factor")
+	operations.AddInt64OperationIfNecessary(&ops, plan.MemoryUsageKb, state.MemoryUsageKb, "memory-usage-kb")
+	operations.AddInt64OperationIfNecessary(&ops, plan.SaltLengthBytes, state.SaltLengthBytes, "salt-length-bytes")
+	operations.AddInt64OperationIfNecessary(&ops, plan.DerivedKeyLengthBytes, state.DerivedKeyLengthBytes, "derived-key-length-bytes")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	return ops
+}
+
+// Create a new resource
+func (r *argon2PasswordStorageSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan argon2PasswordStorageSchemeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := client.NewAddArgon2PasswordStorageSchemeRequest(plan.Id.ValueString(),
+		[]client.Enumargon2PasswordStorageSchemeSchemaUrn{client.ENUMARGON2PASSWORDSTORAGESCHEMESCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSWORD_STORAGE_SCHEMEARGON2},
+		int32(plan.IterationCount.ValueInt64()),
+		int32(plan.ParallelismFactor.ValueInt64()),
+		int32(plan.MemoryUsageKb.ValueInt64()),
+		int32(plan.SaltLengthBytes.ValueInt64()),
+		int32(plan.DerivedKeyLengthBytes.ValueInt64()),
+		plan.Enabled.ValueBool())
+	addOptionalArgon2PasswordStorageSchemeFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PasswordStorageSchemeApi.AddPasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPasswordStorageSchemeRequest(
+		client.AddArgon2PasswordStorageSchemeRequestAsAddPasswordStorageSchemeRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PasswordStorageSchemeApi.AddPasswordStorageSchemeExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Argon2 Password Storage Scheme", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state argon2PasswordStorageSchemeResourceModel
+	readArgon2PasswordStorageSchemeResponse(ctx, addResponse.Argon2PasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultArgon2PasswordStorageSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan argon2PasswordStorageSchemeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PasswordStorageSchemeApi.GetPasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Argon2 Password Storage Scheme", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.Argon2PasswordStorageSchemeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Argon2 Password Storage Scheme", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state argon2PasswordStorageSchemeResourceModel
+	readArgon2PasswordStorageSchemeResponse(ctx, readResponse.Argon2PasswordStorageSchemeResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageScheme(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createArgon2PasswordStorageSchemeOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageSchemeExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Argon2 Password Storage Scheme", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readArgon2PasswordStorageSchemeResponse(ctx, updateResponse.Argon2PasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *argon2PasswordStorageSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readArgon2PasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readArgon2PasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readArgon2PasswordStorageScheme(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state argon2PasswordStorageSchemeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PasswordStorageSchemeApi.GetPasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Argon2 Password Storage Scheme", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Argon2 Password Storage Scheme", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.Argon2PasswordStorageSchemeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Argon2 Password Storage Scheme", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readArgon2PasswordStorageSchemeResponse(ctx, readResponse.Argon2PasswordStorageSchemeResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *argon2PasswordStorageSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateArgon2PasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateArgon2PasswordStorageScheme(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateArgon2PasswordStorageScheme(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan argon2PasswordStorageSchemeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state argon2PasswordStorageSchemeResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createArgon2PasswordStorageSchemeOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PasswordStorageSchemeApi.UpdatePasswordStorageSchemeExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Argon2 Password Storage Scheme", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readArgon2PasswordStorageSchemeResponse(ctx, updateResponse.Argon2PasswordStorageSchemeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultArgon2PasswordStorageSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *argon2PasswordStorageSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state argon2PasswordStorageSchemeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PasswordStorageSchemeApi.DeletePasswordStorageSchemeExecute(r.apiClient.PasswordStorageSchemeApi.DeletePasswordStorageScheme(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Argon2 Password Storage Scheme", err, httpResp)
+		return
+	}
+}
+
+func (r *argon2PasswordStorageSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importArgon2PasswordStorageScheme(ctx, req, resp)
+}
+
+func (r *defaultArgon2PasswordStorageSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importArgon2PasswordStorageScheme(ctx, req, resp)
+}
+
+func importArgon2PasswordStorageScheme(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
@@ -0,0 +1,533 @@
+package passwordvalidator
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &attributeValuePasswordValidatorResource{}
+	_ resource.ResourceWithConfigure   = &attributeValuePasswordValidatorResource{}
+	_ resource.ResourceWithImportState = &attributeValuePasswordValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &attributeValuePasswordValidatorResource{}
+	_ resource.Resource                = &defaultAttributeValuePasswordValidatorResource{}
+	_ resource.ResourceWithConfigure   = &defaultAttributeValuePasswordValidatorResource{}
+	_ resource.ResourceWithImportState = &defaultAttributeValuePasswordValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAttributeValuePasswordValidatorResource{}
+)
+
+// Create a Attribute Value Password Validator resource
+func NewAttributeValuePasswordValidatorResource() resource.Resource {
+	return &attributeValuePasswordValidatorResource{}
+}
+
+func NewDefaultAttributeValuePasswordValidatorResource() resource.Resource {
+	return &defaultAttributeValuePasswordValidatorResource{}
+}
+
+// attributeValuePasswordValidatorResource is the resource implementation.
+type attributeValuePasswordValidatorResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultAttributeValuePasswordValidatorResource is the resource implementation.
+type defaultAttributeValuePasswordValidatorResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *attributeValuePasswordValidatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attribute_value_password_validator"
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_attribute_value_password_validator"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *attributeValuePasswordValidatorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type attributeValuePasswordValidatorResourceModel struct {
+	Id                                             types.String `tfsdk:"id"`
+	LastUpdated                                    types.String `tfsdk:"last_updated"`
+	Notifications                                  types.Set    `tfsdk:"notifications"`
+	RequiredActions                                types.Set    `tfsdk:"required_actions"`
+	MatchAttribute                                 types.Set    `tfsdk:"match_attribute"`
+	TestPasswordSubstringOfAttributeValue          types.Bool   `tfsdk:"test_password_substring_of_attribute_value"`
+	TestAttributeValueSubstringOfPassword          types.Bool   `tfsdk:"test_attribute_value_substring_of_password"`
+	MinimumAttributeValueLengthForSubstringMatches types.Int64  `tfsdk:"minimum_attribute_value_length_for_substring_matches"`
+	TestReversedPassword                           types.Bool   `tfsdk:"test_reversed_password"`
+	Description                                    types.String `tfsdk:"description"`
+	Enabled                                        types.Bool   `tfsdk:"enabled"`
+	ValidatorRequirementDescription                types.String `tfsdk:"validator_requirement_description"`
+	ValidatorFailureMessage                        types.String `tfsdk:"validator_failure_message"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *attributeValuePasswordValidatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributeValuePasswordValidatorSchema(ctx, req, resp, false)
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributeValuePasswordValidatorSchema(ctx, req, resp, true)
+}
+
+func attributeValuePasswordValidatorSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages an Attribute Value Password Validator.",
+		Attributes: map[string]schema.Attribute{
+			"match_attribute": schema.SetAttribute{
+				Description: "Specifies the name(s) of the attribute(s) whose values should be checked to determine whether they match the provided password. If no values are provided, then the server checks if the proposed password matches the value of any user attribute in the target user's entry.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.StringType,
+			},
+			"test_password_substring_of_attribute_value": schema.BoolAttribute{
+				Description: "Indicates whether to reject any proposed password that is a substring of a value in one of the match attributes in the target user's entry.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_attribute_value_substring_of_password": schema.BoolAttribute{
+				Description: "Indicates whether to reject any proposed password in which a value in one of the match attributes in the target user's entry is a substring of that password.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_attribute_value_length_for_substring_matches": schema.Int64Attribute{
+				Description: "The minimum length that an attribute value must have for it to be considered when rejecting passwords that contain the value of another attribute as a substring.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_reversed_password": schema.BoolAttribute{
+				Description: "Indicates whether to perform matching against the reversed value of the provided password in addition to the order in which it was given.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Password Validator",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the password validator is enabled for use.",
+				Required:    true,
+			},
+			"validator_requirement_description": schema.StringAttribute{
+				Description: "Specifies a message that can be used to describe the requirements imposed by this password validator to end users. If a value is provided for this property, then it will override any description that may have otherwise been generated by the validator.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validator_failure_message": schema.StringAttribute{
+				Description: "Specifies a message that may be provided to the end user in the event that a proposed password is rejected by this validator. If a value is provided for this property, then it will override any failure message that may have otherwise been generated by the validator.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *attributeValuePasswordValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalAttributeValuePasswordValidatorFields(ctx context.Context, addRequest *client.AddAttributeValuePasswordValidatorRequest, plan attributeValuePasswordValidatorResourceModel) {
+	if internaltypes.IsDefined(plan.MatchAttribute) {
+		var slice []string
+		plan.MatchAttribute.ElementsAs(ctx, &slice, false)
+		addRequest.MatchAttribute = slice
+	}
+	if internaltypes.IsDefined(plan.TestPasswordSubstringOfAttributeValue) {
+		boolVal := plan.TestPasswordSubstringOfAttributeValue.ValueBool()
+		addRequest.TestPasswordSubstringOfAttributeValue = &boolVal
+	}
+	if internaltypes.IsDefined(plan.TestAttributeValueSubstringOfPassword) {
+		boolVal := plan.TestAttributeValueSubstringOfPassword.ValueBool()
+		addRequest.TestAttributeValueSubstringOfPassword = &boolVal
+	}
+	if internaltypes.IsDefined(plan.MinimumAttributeValueLengthForSubstringMatches) {
+		intVal := int32(plan.MinimumAttributeValueLengthForSubstringMatches.ValueInt64())
+		addRequest.MinimumAttributeValueLengthForSubstringMatches = &intVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.ValidatorRequirementDescription) {
+		stringVal := plan.ValidatorRequirementDescription.ValueString()
+		addRequest.ValidatorRequirementDescription = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.ValidatorFailureMessage) {
+		stringVal := plan.ValidatorFailureMessage.ValueString()
+		addRequest.ValidatorFailureMessage = &stringVal
+	}
+}
+
+// Read a AttributeValuePasswordValidatorResponse object into the model struct
+func readAttributeValuePasswordValidatorResponse(ctx context.Context, r *client.AttributeValuePasswordValidatorResponse, state *attributeValuePasswordValidatorResourceModel, expectedValues *attributeValuePasswordValidatorResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.MatchAttribute = internaltypes.GetStringSet(r.MatchAttribute)
+	state.TestPasswordSubstringOfAttributeValue = internaltypes.BoolTypeOrNil(r.TestPasswordSubstringOfAttributeValue)
+	state.TestAttributeValueSubstringOfPassword = internaltypes.BoolTypeOrNil(r.TestAttributeValueSubstringOfPassword)
+	state.MinimumAttributeValueLengthForSubstringMatches = internaltypes.Int64TypeOrNil(r.MinimumAttributeValueLengthForSubstringMatches)
+	state.TestReversedPassword = types.BoolValue(r.TestReversedPassword)
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.ValidatorRequirementDescription = internaltypes.StringTypeOrNil(r.ValidatorRequirementDescription, internaltypes.IsEmptyString(expectedValues.ValidatorRequirementDescription))
+	state.ValidatorFailureMessage = internaltypes.StringTypeOrNil(r.ValidatorFailureMessage, internaltypes.IsEmptyString(expectedValues.ValidatorFailureMessage))
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createAttributeValuePasswordValidatorOperations(plan attributeValuePasswordValidatorResourceModel, state attributeValuePasswordValidatorResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddStringSetOperationsIfNecessary(&ops, plan.MatchAttribute, state.MatchAttribute, "match-attribute")
+	operations.AddBoolOperationIfNecessary(&ops, plan.TestPasswordSubstringOfAttributeValue, state.TestPasswordSubstringOfAttributeValue, "test-password-substring-of-attribute-value")
+	operations.AddBoolOperationIfNecessary(&ops, plan.TestAttributeValueSubstringOfPassword, state.TestAttributeValueSubstringOfPassword, "test-attribute-value-substring-of-password")
+	operations.AddInt64OperationIfNecessary(&ops, plan.MinimumAttributeValueLengthForSubstringMatches, state.MinimumAttributeValueLengthForSubstringMatches, "minimum-attribute-value-length-for-substring-matches")
+	operations.AddBoolOperationIfNecessary(&ops, plan.TestReversedPassword, state.TestReversedPassword, "test-reversed-password")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	operations.AddStringOperationIfNecessary(&ops, plan.ValidatorRequirementDescription, state.ValidatorRequirementDescription, "validator-requirement-description")
+	operations.AddStringOperationIfNecessary(&ops, plan.ValidatorFailureMessage, state.ValidatorFailureMessage, "validator-failure-message")
+	return ops
+}
+
+// Create a new resource
+func (r *attributeValuePasswordValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan attributeValuePasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := client.NewAddAttributeValuePasswordValidatorRequest(plan.Id.ValueString(),
+		[]client.EnumattributeValuePasswordValidatorSchemaUrn{client.ENUMATTRIBUTEVALUEPASSWORDVALIDATORSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSWORD_VALIDATORATTRIBUTE_VALUE},
+		plan.TestReversedPassword.ValueBool(),
+		plan.Enabled.ValueBool())
+	addOptionalAttributeValuePasswordValidatorFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PasswordValidatorApi.AddPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPasswordValidatorRequest(
+		client.AddAttributeValuePasswordValidatorRequestAsAddPasswordValidatorRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PasswordValidatorApi.AddPasswordValidatorExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Attribute Value Password Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state attributeValuePasswordValidatorResourceModel
+	readAttributeValuePasswordValidatorResponse(ctx, addResponse.AttributeValuePasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultAttributeValuePasswordValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan attributeValuePasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PasswordValidatorApi.GetPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Attribute Value Password Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AttributeValuePasswordValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Attribute Value Password Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state attributeValuePasswordValidatorResourceModel
+	readAttributeValuePasswordValidatorResponse(ctx, readResponse.AttributeValuePasswordValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PasswordValidatorApi.UpdatePasswordValidator(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createAttributeValuePasswordValidatorOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PasswordValidatorApi.UpdatePasswordValidatorExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Attribute Value Password Validator", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAttributeValuePasswordValidatorResponse(ctx, updateResponse.AttributeValuePasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *attributeValuePasswordValidatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAttributeValuePasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAttributeValuePasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readAttributeValuePasswordValidator(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state attributeValuePasswordValidatorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PasswordValidatorApi.GetPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Attribute Value Password Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Attribute Value Password Validator", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.AttributeValuePasswordValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Attribute Value Password Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readAttributeValuePasswordValidatorResponse(ctx, readResponse.AttributeValuePasswordValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *attributeValuePasswordValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAttributeValuePasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAttributeValuePasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateAttributeValuePasswordValidator(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan attributeValuePasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state attributeValuePasswordValidatorResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PasswordValidatorApi.UpdatePasswordValidator(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createAttributeValuePasswordValidatorOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PasswordValidatorApi.UpdatePasswordValidatorExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Attribute Value Password Validator", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readAttributeValuePasswordValidatorResponse(ctx, updateResponse.AttributeValuePasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultAttributeValuePasswordValidatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *attributeValuePasswordValidatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state attributeValuePasswordValidatorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PasswordValidatorApi.DeletePasswordValidatorExecute(r.apiClient.PasswordValidatorApi.DeletePasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Attribute Value Password Validator", err, httpResp)
+		return
+	}
+}
+
+func (r *attributeValuePasswordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAttributeValuePasswordValidator(ctx, req, resp)
+}
+
+func (r *defaultAttributeValuePasswordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAttributeValuePasswordValidator(ctx, req, resp)
+}
+
+func importAttributeValuePasswordValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
@@ -0,0 +1,495 @@
+package passwordvalidator
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &characterSetPasswordValidatorResource{}
+	_ resource.ResourceWithConfigure   = &characterSetPasswordValidatorResource{}
+	_ resource.ResourceWithImportState = &characterSetPasswordValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &characterSetPasswordValidatorResource{}
+	_ resource.Resource                = &defaultCharacterSetPasswordValidatorResource{}
+	_ resource.ResourceWithConfigure   = &defaultCharacterSetPasswordValidatorResource{}
+	_ resource.ResourceWithImportState = &defaultCharacterSetPasswordValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCharacterSetPasswordValidatorResource{}
+)
+
+// Create a Character Set Password Validator resource
+func NewCharacterSetPasswordValidatorResource() resource.Resource {
+	return &characterSetPasswordValidatorResource{}
+}
+
+func NewDefaultCharacterSetPasswordValidatorResource() resource.Resource {
+	return &defaultCharacterSetPasswordValidatorResource{}
+}
+
+// characterSetPasswordValidatorResource is the resource implementation.
+type characterSetPasswordValidatorResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultCharacterSetPasswordValidatorResource is the resource implementation.
+type defaultCharacterSetPasswordValidatorResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *characterSetPasswordValidatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_character_set_password_validator"
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_character_set_password_validator"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *characterSetPasswordValidatorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type characterSetPasswordValidatorResourceModel struct {
+	Id                              types.String `tfsdk:"id"`
+	LastUpdated                     types.String `tfsdk:"last_updated"`
+	Notifications                   types.Set    `tfsdk:"notifications"`
+	RequiredActions                 types.Set    `tfsdk:"required_actions"`
+	CharacterSet                    types.Set    `tfsdk:"character_set"`
+	AllowUnclassifiedCharacters     types.Bool   `tfsdk:"allow_unclassified_characters"`
+	MinimumRequiredCharacterSets    types.Int64  `tfsdk:"minimum_required_character_sets"`
+	Description                     types.String `tfsdk:"description"`
+	Enabled                         types.Bool   `tfsdk:"enabled"`
+	ValidatorRequirementDescription types.String `tfsdk:"validator_requirement_description"`
+	ValidatorFailureMessage         types.String `tfsdk:"validator_failure_message"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *characterSetPasswordValidatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	characterSetPasswordValidatorSchema(ctx, req, resp, false)
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	characterSetPasswordValidatorSchema(ctx, req, resp, true)
+}
+
+func characterSetPasswordValidatorSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages a Character Set Password Validator.",
+		Attributes: map[string]schema.Attribute{
+			"character_set": schema.SetAttribute{
+				Description: "Specifies a character set containing characters that a password may contain and a value indicating the minimum number of characters required from that set.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"allow_unclassified_characters": schema.BoolAttribute{
+				Description: "Indicates whether this password validator allows passwords to contain characters outside of any of the user-defined character sets.",
+				Required:    true,
+			},
+			"minimum_required_character_sets": schema.Int64Attribute{
+				Description: "Specifies the minimum number of character sets that must be represented in a proposed password.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Password Validator",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the password validator is enabled for use.",
+				Required:    true,
+			},
+			"validator_requirement_description": schema.StringAttribute{
+				Description: "Specifies a message that can be used to describe the requirements imposed by this password validator to end users. If a value is provided for this property, then it will override any description that may have otherwise been generated by the validator.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validator_failure_message": schema.StringAttribute{
+				Description: "Specifies a message that may be provided to the end user in the event that a proposed password is rejected by this validator. If a value is provided for this property, then it will override any failure message that may have otherwise been generated by the validator.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *characterSetPasswordValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalCharacterSetPasswordValidatorFields(ctx context.Context, addRequest *client.AddCharacterSetPasswordValidatorRequest, plan characterSetPasswordValidatorResourceModel) {
+	if internaltypes.IsDefined(plan.MinimumRequiredCharacterSets) {
+		intVal := int32(plan.MinimumRequiredCharacterSets.ValueInt64())
+		addRequest.MinimumRequiredCharacterSets = &intVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.ValidatorRequirementDescription) {
+		stringVal := plan.ValidatorRequirementDescription.ValueString()
+		addRequest.ValidatorRequirementDescription = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.ValidatorFailureMessage) {
+		stringVal := plan.ValidatorFailureMessage.ValueString()
+		addRequest.ValidatorFailureMessage = &stringVal
+	}
+}
+
+// Read a CharacterSetPasswordValidatorResponse object into the model struct
+func readCharacterSetPasswordValidatorResponse(ctx context.Context, r *client.CharacterSetPasswordValidatorResponse, state *characterSetPasswordValidatorResourceModel, expectedValues *characterSetPasswordValidatorResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.CharacterSet = internaltypes.GetStringSet(r.CharacterSet)
+	state.AllowUnclassifiedCharacters = types.BoolValue(r.AllowUnclassifiedCharacters)
+	state.MinimumRequiredCharacterSets = internaltypes.Int64TypeOrNil(r.MinimumRequiredCharacterSets)
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.ValidatorRequirementDescription = internaltypes.StringTypeOrNil(r.ValidatorRequirementDescription, internaltypes.IsEmptyString(expectedValues.ValidatorRequirementDescription))
+	state.ValidatorFailureMessage = internaltypes.StringTypeOrNil(r.ValidatorFailureMessage, internaltypes.IsEmptyString(expectedValues.ValidatorFailureMessage))
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createCharacterSetPasswordValidatorOperations(plan characterSetPasswordValidatorResourceModel, state characterSetPasswordValidatorResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddStringSetOperationsIfNecessary(&ops, plan.CharacterSet, state.CharacterSet, "character-set")
+	operations.AddBoolOperationIfNecessary(&ops, plan.AllowUnclassifiedCharacters, state.AllowUnclassifiedCharacters, "allow-unclassified-characters")
+	operations.AddInt64OperationIfNecessary(&ops, plan.MinimumRequiredCharacterSets, state.MinimumRequiredCharacterSets, "minimum-required-character-sets")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	operations.AddStringOperationIfNecessary(&ops, plan.ValidatorRequirementDescription, state.ValidatorRequirementDescription, "validator-requirement-description")
+	operations.AddStringOperationIfNecessary(&ops, plan.ValidatorFailureMessage, state.ValidatorFailureMessage, "validator-failure-message")
+	return ops
+}
+
+// Create a new resource
+func (r *characterSetPasswordValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan characterSetPasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var CharacterSetSlice []string
+	plan.CharacterSet.ElementsAs(ctx, &CharacterSetSlice, false)
+	addRequest := client.NewAddCharacterSetPasswordValidatorRequest(plan.Id.ValueString(),
+		[]client.EnumcharacterSetPasswordValidatorSchemaUrn{client.ENUMCHARACTERSETPASSWORDVALIDATORSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSWORD_VALIDATORCHARACTER_SET},
+		CharacterSetSlice,
+		plan.AllowUnclassifiedCharacters.ValueBool(),
+		plan.Enabled.ValueBool())
+	addOptionalCharacterSetPasswordValidatorFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PasswordValidatorApi.AddPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPasswordValidatorRequest(
+		client.AddCharacterSetPasswordValidatorRequestAsAddPasswordValidatorRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PasswordValidatorApi.AddPasswordValidatorExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Character Set Password Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state characterSetPasswordValidatorResourceModel
+	readCharacterSetPasswordValidatorResponse(ctx, addResponse.CharacterSetPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultCharacterSetPasswordValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan characterSetPasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PasswordValidatorApi.GetPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Character Set Password Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.CharacterSetPasswordValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Character Set Password Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state characterSetPasswordValidatorResourceModel
+	readCharacterSetPasswordValidatorResponse(ctx, readResponse.CharacterSetPasswordValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PasswordValidatorApi.UpdatePasswordValidator(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createCharacterSetPasswordValidatorOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PasswordValidatorApi.UpdatePasswordValidatorExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Character Set Password Validator", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readCharacterSetPasswordValidatorResponse(ctx, updateResponse.CharacterSetPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *characterSetPasswordValidatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readCharacterSetPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readCharacterSetPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readCharacterSetPasswordValidator(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state characterSetPasswordValidatorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PasswordValidatorApi.GetPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Character Set Password Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Character Set Password Validator", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.CharacterSetPasswordValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Character Set Password Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readCharacterSetPasswordValidatorResponse(ctx, readResponse.CharacterSetPasswordValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *characterSetPasswordValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateCharacterSetPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateCharacterSetPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateCharacterSetPasswordValidator(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan characterSetPasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state characterSetPasswordValidatorResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PasswordValidatorApi.UpdatePasswordValidator(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createCharacterSetPasswordValidatorOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PasswordValidatorApi.UpdatePasswordValidatorExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Character Set Password Validator", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readCharacterSetPasswordValidatorResponse(ctx, updateResponse.CharacterSetPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultCharacterSetPasswordValidatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *characterSetPasswordValidatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state characterSetPasswordValidatorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PasswordValidatorApi.DeletePasswordValidatorExecute(r.apiClient.PasswordValidatorApi.DeletePasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Character Set Password Validator", err, httpResp)
+		return
+	}
+}
+
+func (r *characterSetPasswordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCharacterSetPasswordValidator(ctx, req, resp)
+}
+
+func (r *defaultCharacterSetPasswordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCharacterSetPasswordValidator(ctx, req, resp)
+}
+
+func importCharacterSetPasswordValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
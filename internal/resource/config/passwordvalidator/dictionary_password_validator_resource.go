@@ -0,0 +1,586 @@
+package passwordvalidator
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dictionaryPasswordValidatorResource{}
+	_ resource.ResourceWithConfigure   = &dictionaryPasswordValidatorResource{}
+	_ resource.ResourceWithImportState = &dictionaryPasswordValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &dictionaryPasswordValidatorResource{}
+	_ resource.Resource                = &defaultDictionaryPasswordValidatorResource{}
+	_ resource.ResourceWithConfigure   = &defaultDictionaryPasswordValidatorResource{}
+	_ resource.ResourceWithImportState = &defaultDictionaryPasswordValidatorResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDictionaryPasswordValidatorResource{}
+)
+
+// Create a Dictionary Password Validator resource
+func NewDictionaryPasswordValidatorResource() resource.Resource {
+	return &dictionaryPasswordValidatorResource{}
+}
+
+func NewDefaultDictionaryPasswordValidatorResource() resource.Resource {
+	return &defaultDictionaryPasswordValidatorResource{}
+}
+
+// dictionaryPasswordValidatorResource is the resource implementation.
+type dictionaryPasswordValidatorResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// defaultDictionaryPasswordValidatorResource is the resource implementation.
+type defaultDictionaryPasswordValidatorResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *dictionaryPasswordValidatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dictionary_password_validator"
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_dictionary_password_validator"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dictionaryPasswordValidatorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type dictionaryPasswordValidatorResourceModel struct {
+	Id                                    types.String `tfsdk:"id"`
+	LastUpdated                           types.String `tfsdk:"last_updated"`
+	Notifications                         types.Set    `tfsdk:"notifications"`
+	RequiredActions                       types.Set    `tfsdk:"required_actions"`
+	DictionaryFile                        types.String `tfsdk:"dictionary_file"`
+	CaseSensitiveValidation               types.Bool   `tfsdk:"case_sensitive_validation"`
+	TestReversedPassword                  types.Bool   `tfsdk:"test_reversed_password"`
+	IgnoreLeadingNonAlphabeticCharacters  types.Bool   `tfsdk:"ignore_leading_non_alphabetic_characters"`
+	IgnoreTrailingNonAlphabeticCharacters types.Bool   `tfsdk:"ignore_trailing_non_alphabetic_characters"`
+	StripDiacriticalMarks                 types.Bool   `tfsdk:"strip_diacritical_marks"`
+	AlternativePasswordCharacterMapping   types.Set    `tfsdk:"alternative_password_character_mapping"`
+	MaximumAllowedPercentOfPassword       types.Int64  `tfsdk:"maximum_allowed_percent_of_password"`
+	Description                           types.String `tfsdk:"description"`
+	Enabled                               types.Bool   `tfsdk:"enabled"`
+	ValidatorRequirementDescription       types.String `tfsdk:"validator_requirement_description"`
+	ValidatorFailureMessage               types.String `tfsdk:"validator_failure_message"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *dictionaryPasswordValidatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dictionaryPasswordValidatorSchema(ctx, req, resp, false)
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dictionaryPasswordValidatorSchema(ctx, req, resp, true)
+}
+
+func dictionaryPasswordValidatorSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages a Dictionary Password Validator.",
+		Attributes: map[string]schema.Attribute{
+			"dictionary_file": schema.StringAttribute{
+				Description: "Specifies the path to the file containing a list of words that cannot be used as passwords.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"case_sensitive_validation": schema.BoolAttribute{
+				Description: "Indicates whether this password validator is to treat password characters in a case-sensitive manner.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_reversed_password": schema.BoolAttribute{
+				Description: "Indicates whether this password validator is to test the reversed value of the provided password as well as the order in which it was given.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_leading_non_alphabetic_characters": schema.BoolAttribute{
+				Description: "Indicates whether to ignore any digits, symbols, or other non-alphabetic characters that may appear at the beginning of a proposed password.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_trailing_non_alphabetic_characters": schema.BoolAttribute{
+				Description: "Indicates whether to ignore any digits, symbols, or other non-alphabetic characters that may appear at the end of a proposed password.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"strip_diacritical_marks": schema.BoolAttribute{
+				Description: "Indicates whether to strip characters of any diacritical marks (like accents, cedillas, circumflexes, diaereses, tildes, and umlauts) they may contain. Any characters with a diacritical mark would be replaced with a base version",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alternative_password_character_mapping": schema.SetAttribute{
+				Description: "Provides a set of character substitutions that can be applied to the proposed password when checking to see if it is in the provided dictionary. Each mapping should consist of a single character followed by a colon and a list of the alternative characters that may be used in place of that character.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.StringType,
+			},
+			"maximum_allowed_percent_of_password": schema.Int64Attribute{
+				Description: "The maximum allowed percent of a proposed password that any single dictionary word is allowed to comprise. A value of 100 indicates that a proposed password will only be rejected if the dictionary contains the entire proposed password (after any configured transformations have been applied).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description for this Password Validator",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the password validator is enabled for use.",
+				Required:    true,
+			},
+			"validator_requirement_description": schema.StringAttribute{
+				Description: "Specifies a message that can be used to describe the requirements imposed by this password validator to end users. If a value is provided for this property, then it will override any description that may have otherwise been generated by the validator.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validator_failure_message": schema.StringAttribute{
+				Description: "Specifies a message that may be provided to the end user in the event that a proposed password is rejected by this validator. If a value is provided for this property, then it will override any failure message that may have otherwise been generated by the validator.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"id"})
+	}
+	config.AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *dictionaryPasswordValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
+// Add optional fields to create request
+func addOptionalDictionaryPasswordValidatorFields(ctx context.Context, addRequest *client.AddDictionaryPasswordValidatorRequest, plan dictionaryPasswordValidatorResourceModel) {
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.DictionaryFile) {
+		stringVal := plan.DictionaryFile.ValueString()
+		addRequest.DictionaryFile = &stringVal
+	}
+	if internaltypes.IsDefined(plan.CaseSensitiveValidation) {
+		boolVal := plan.CaseSensitiveValidation.ValueBool()
+		addRequest.CaseSensitiveValidation = &boolVal
+	}
+	if internaltypes.IsDefined(plan.TestReversedPassword) {
+		boolVal := plan.TestReversedPassword.ValueBool()
+		addRequest.TestReversedPassword = &boolVal
+	}
+	if internaltypes.IsDefined(plan.IgnoreLeadingNonAlphabeticCharacters) {
+		boolVal := plan.IgnoreLeadingNonAlphabeticCharacters.ValueBool()
+		addRequest.IgnoreLeadingNonAlphabeticCharacters = &boolVal
+	}
+	if internaltypes.IsDefined(plan.IgnoreTrailingNonAlphabeticCharacters) {
+		boolVal := plan.IgnoreTrailingNonAlphabeticCharacters.ValueBool()
+		addRequest.IgnoreTrailingNonAlphabeticCharacters = &boolVal
+	}
+	if internaltypes.IsDefined(plan.StripDiacriticalMarks) {
+		boolVal := plan.StripDiacriticalMarks.ValueBool()
+		addRequest.StripDiacriticalMarks = &boolVal
+	}
+	if internaltypes.IsDefined(plan.AlternativePasswordCharacterMapping) {
+		var slice []string
+		plan.AlternativePasswordCharacterMapping.ElementsAs(ctx, &slice, false)
+		addRequest.AlternativePasswordCharacterMapping = slice
+	}
+	if internaltypes.IsDefined(plan.MaximumAllowedPercentOfPassword) {
+		intVal := int32(plan.MaximumAllowedPercentOfPassword.ValueInt64())
+		addRequest.MaximumAllowedPercentOfPassword = &intVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		stringVal := plan.Description.ValueString()
+		addRequest.Description = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.ValidatorRequirementDescription) {
+		stringVal := plan.ValidatorRequirementDescription.ValueString()
+		addRequest.ValidatorRequirementDescription = &stringVal
+	}
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsNonEmptyString(plan.ValidatorFailureMessage) {
+		stringVal := plan.ValidatorFailureMessage.ValueString()
+		addRequest.ValidatorFailureMessage = &stringVal
+	}
+}
+
+// Read a DictionaryPasswordValidatorResponse object into the model struct
+func readDictionaryPasswordValidatorResponse(ctx context.Context, r *client.DictionaryPasswordValidatorResponse, state *dictionaryPasswordValidatorResourceModel, expectedValues *dictionaryPasswordValidatorResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(r.Id)
+	state.DictionaryFile = types.StringValue(r.DictionaryFile)
+	state.CaseSensitiveValidation = types.BoolValue(r.CaseSensitiveValidation)
+	state.TestReversedPassword = types.BoolValue(r.TestReversedPassword)
+	state.IgnoreLeadingNonAlphabeticCharacters = internaltypes.BoolTypeOrNil(r.IgnoreLeadingNonAlphabeticCharacters)
+	state.IgnoreTrailingNonAlphabeticCharacters = internaltypes.BoolTypeOrNil(r.IgnoreTrailingNonAlphabeticCharacters)
+	state.StripDiacriticalMarks = internaltypes.BoolTypeOrNil(r.StripDiacriticalMarks)
+	state.AlternativePasswordCharacterMapping = internaltypes.GetStringSet(r.AlternativePasswordCharacterMapping)
+	state.MaximumAllowedPercentOfPassword = internaltypes.Int64TypeOrNil(r.MaximumAllowedPercentOfPassword)
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsEmptyString(expectedValues.Description))
+	state.Enabled = types.BoolValue(r.Enabled)
+	state.ValidatorRequirementDescription = internaltypes.StringTypeOrNil(r.ValidatorRequirementDescription, internaltypes.IsEmptyString(expectedValues.ValidatorRequirementDescription))
+	state.ValidatorFailureMessage = internaltypes.StringTypeOrNil(r.ValidatorFailureMessage, internaltypes.IsEmptyString(expectedValues.ValidatorFailureMessage))
+	state.Notifications, state.RequiredActions = config.ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createDictionaryPasswordValidatorOperations(plan dictionaryPasswordValidatorResourceModel, state dictionaryPasswordValidatorResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddStringOperationIfNecessary(&ops, plan.DictionaryFile, state.DictionaryFile, "dictionary-file")
+	operations.AddBoolOperationIfNecessary(&ops, plan.CaseSensitiveValidation, state.CaseSensitiveValidation, "case-sensitive-validation")
+	operations.AddBoolOperationIfNecessary(&ops, plan.TestReversedPassword, state.TestReversedPassword, "test-reversed-password")
+	operations.AddBoolOperationIfNecessary(&ops, plan.IgnoreLeadingNonAlphabeticCharacters, state.IgnoreLeadingNonAlphabeticCharacters, "ignore-leading-non-alphabetic-characters")
+	operations.AddBoolOperationIfNecessary(&ops, plan.IgnoreTrailingNonAlphabeticCharacters, state.IgnoreTrailingNonAlphabeticCharacters, "ignore-trailing-non-alphabetic-characters")
+	operations.AddBoolOperationIfNecessary(&ops, plan.StripDiacriticalMarks, state.StripDiacriticalMarks, "strip-diacritical-marks")
+	operations.AddStringSetOperationsIfNecessary(&ops, plan.AlternativePasswordCharacterMapping, state.AlternativePasswordCharacterMapping, "alternative-password-character-mapping")
+	operations.AddInt64OperationIfNecessary(&ops, plan.MaximumAllowedPercentOfPassword, state.MaximumAllowedPercentOfPassword, "maximum-allowed-percent-of-password")
+	operations.AddStringOperationIfNecessary(&ops, plan.Description, state.Description, "description")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Enabled, state.Enabled, "enabled")
+	operations.AddStringOperationIfNecessary(&ops, plan.ValidatorRequirementDescription, state.ValidatorRequirementDescription, "validator-requirement-description")
+	operations.AddStringOperationIfNecessary(&ops, plan.ValidatorFailureMessage, state.ValidatorFailureMessage, "validator-failure-message")
+	return ops
+}
+
+// Create a new resource
+func (r *dictionaryPasswordValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dictionaryPasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addRequest := client.NewAddDictionaryPasswordValidatorRequest(plan.Id.ValueString(),
+		[]client.EnumdictionaryPasswordValidatorSchemaUrn{client.ENUMDICTIONARYPASSWORDVALIDATORSCHEMAURN_URNPINGIDENTITYSCHEMASCONFIGURATION2_0PASSWORD_VALIDATORDICTIONARY},
+		plan.Enabled.ValueBool())
+	addOptionalDictionaryPasswordValidatorFields(ctx, addRequest, plan)
+	// Log request JSON
+	requestJson, err := addRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiAddRequest := r.apiClient.PasswordValidatorApi.AddPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiAddRequest = apiAddRequest.AddPasswordValidatorRequest(
+		client.AddDictionaryPasswordValidatorRequestAsAddPasswordValidatorRequest(addRequest))
+
+	addResponse, httpResp, err := r.apiClient.PasswordValidatorApi.AddPasswordValidatorExecute(apiAddRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Dictionary Password Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := addResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state dictionaryPasswordValidatorResourceModel
+	readDictionaryPasswordValidatorResponse(ctx, addResponse.DictionaryPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+
+	// Populate Computed attribute values
+	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *defaultDictionaryPasswordValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dictionaryPasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PasswordValidatorApi.GetPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dictionary Password Validator", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.DictionaryPasswordValidatorResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Dictionary Password Validator", plan.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the existing configuration
+	var state dictionaryPasswordValidatorResourceModel
+	readDictionaryPasswordValidatorResponse(ctx, readResponse.DictionaryPasswordValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.PasswordValidatorApi.UpdatePasswordValidator(config.ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString())
+	ops := createDictionaryPasswordValidatorOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.PasswordValidatorApi.UpdatePasswordValidatorExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Dictionary Password Validator", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readDictionaryPasswordValidatorResponse(ctx, updateResponse.DictionaryPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *dictionaryPasswordValidatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readDictionaryPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readDictionaryPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readDictionaryPasswordValidator(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Get current state
+	var state dictionaryPasswordValidatorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := apiClient.PasswordValidatorApi.GetPasswordValidator(
+		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Dictionary Password Validator", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dictionary Password Validator", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.DictionaryPasswordValidatorResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Dictionary Password Validator", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
+	// Read the response into the state
+	readDictionaryPasswordValidatorResponse(ctx, readResponse.DictionaryPasswordValidatorResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *dictionaryPasswordValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateDictionaryPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateDictionaryPasswordValidator(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateDictionaryPasswordValidator(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan dictionaryPasswordValidatorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state dictionaryPasswordValidatorResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := apiClient.PasswordValidatorApi.UpdatePasswordValidator(
+		config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createDictionaryPasswordValidatorOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := apiClient.PasswordValidatorApi.UpdatePasswordValidatorExecute(updateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Dictionary Password Validator", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readDictionaryPasswordValidatorResponse(ctx, updateResponse.DictionaryPasswordValidatorResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *defaultDictionaryPasswordValidatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *dictionaryPasswordValidatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dictionaryPasswordValidatorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.PasswordValidatorApi.DeletePasswordValidatorExecute(r.apiClient.PasswordValidatorApi.DeletePasswordValidator(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the Dictionary Password Validator", err, httpResp)
+		return
+	}
+}
+
+func (r *dictionaryPasswordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDictionaryPasswordValidator(ctx, req, resp)
+}
+
+func (r *defaultDictionaryPasswordValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDictionaryPasswordValidator(ctx, req, resp)
+}
+
+func importDictionaryPasswordValidator(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
+}
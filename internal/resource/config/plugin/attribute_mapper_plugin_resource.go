@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &attributeMapperPluginResource{}
 	_ resource.ResourceWithConfigure   = &attributeMapperPluginResource{}
 	_ resource.ResourceWithImportState = &attributeMapperPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &attributeMapperPluginResource{}
 	_ resource.Resource                = &defaultAttributeMapperPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultAttributeMapperPluginResource{}
 	_ resource.ResourceWithImportState = &defaultAttributeMapperPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAttributeMapperPluginResource{}
 )
 
 // Create a Attribute Mapper Plugin resource
@@ -166,6 +168,15 @@ func attributeMapperPluginSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *attributeMapperPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAttributeMapperPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAttributeMapperPluginFields(ctx context.Context, addRequest *client.AddAttributeMapperPluginRequest, plan attributeMapperPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -275,6 +286,7 @@ func (r *attributeMapperPluginResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state attributeMapperPluginResourceModel
 	readAttributeMapperPluginResponse(ctx, addResponse.AttributeMapperPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -313,6 +325,12 @@ func (r *defaultAttributeMapperPluginResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AttributeMapperPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Attribute Mapper Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state attributeMapperPluginResourceModel
 	readAttributeMapperPluginResponse(ctx, readResponse.AttributeMapperPluginResponse, &state, &state, &resp.Diagnostics)
@@ -339,6 +357,7 @@ func (r *defaultAttributeMapperPluginResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readAttributeMapperPluginResponse(ctx, updateResponse.AttributeMapperPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -371,7 +390,12 @@ func readAttributeMapperPlugin(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Attribute Mapper Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Attribute Mapper Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Attribute Mapper Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -381,6 +405,13 @@ func readAttributeMapperPlugin(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AttributeMapperPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Attribute Mapper Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAttributeMapperPluginResponse(ctx, readResponse.AttributeMapperPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -437,6 +468,7 @@ func updateAttributeMapperPlugin(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readAttributeMapperPluginResponse(ctx, updateResponse.AttributeMapperPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -485,4 +517,5 @@ func (r *defaultAttributeMapperPluginResource) ImportState(ctx context.Context,
 func importAttributeMapperPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
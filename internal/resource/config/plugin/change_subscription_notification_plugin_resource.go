@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &changeSubscriptionNotificationPluginResource{}
 	_ resource.ResourceWithConfigure   = &changeSubscriptionNotificationPluginResource{}
 	_ resource.ResourceWithImportState = &changeSubscriptionNotificationPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &changeSubscriptionNotificationPluginResource{}
 )
 
 // Create a Change Subscription Notification Plugin resource
@@ -109,6 +110,11 @@ func (r *changeSubscriptionNotificationPluginResource) Schema(ctx context.Contex
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *changeSubscriptionNotificationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ChangeSubscriptionNotificationPluginResponse object into the model struct
 func readChangeSubscriptionNotificationPluginResponse(ctx context.Context, r *client.ChangeSubscriptionNotificationPluginResponse, state *changeSubscriptionNotificationPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -156,6 +162,12 @@ func (r *changeSubscriptionNotificationPluginResource) Create(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ChangeSubscriptionNotificationPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Change Subscription Notification Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state changeSubscriptionNotificationPluginResourceModel
 	readChangeSubscriptionNotificationPluginResponse(ctx, readResponse.ChangeSubscriptionNotificationPluginResponse, &state, &resp.Diagnostics)
@@ -182,6 +194,7 @@ func (r *changeSubscriptionNotificationPluginResource) Create(ctx context.Contex
 
 		// Read the response
 		readChangeSubscriptionNotificationPluginResponse(ctx, updateResponse.ChangeSubscriptionNotificationPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -206,7 +219,12 @@ func (r *changeSubscriptionNotificationPluginResource) Read(ctx context.Context,
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Change Subscription Notification Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Change Subscription Notification Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Change Subscription Notification Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -216,6 +234,13 @@ func (r *changeSubscriptionNotificationPluginResource) Read(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ChangeSubscriptionNotificationPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Change Subscription Notification Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readChangeSubscriptionNotificationPluginResponse(ctx, readResponse.ChangeSubscriptionNotificationPluginResponse, &state, &resp.Diagnostics)
 
@@ -264,6 +289,7 @@ func (r *changeSubscriptionNotificationPluginResource) Update(ctx context.Contex
 
 		// Read the response
 		readChangeSubscriptionNotificationPluginResponse(ctx, updateResponse.ChangeSubscriptionNotificationPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -287,4 +313,5 @@ func (r *changeSubscriptionNotificationPluginResource) Delete(ctx context.Contex
 func (r *changeSubscriptionNotificationPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &changelogPasswordEncryptionPluginResource{}
 	_ resource.ResourceWithConfigure   = &changelogPasswordEncryptionPluginResource{}
 	_ resource.ResourceWithImportState = &changelogPasswordEncryptionPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &changelogPasswordEncryptionPluginResource{}
 )
 
 // Create a Changelog Password Encryption Plugin resource
@@ -128,6 +129,11 @@ func (r *changelogPasswordEncryptionPluginResource) Schema(ctx context.Context,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *changelogPasswordEncryptionPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ChangelogPasswordEncryptionPluginResponse object into the model struct
 func readChangelogPasswordEncryptionPluginResponse(ctx context.Context, r *client.ChangelogPasswordEncryptionPluginResponse, state *changelogPasswordEncryptionPluginResourceModel, expectedValues *changelogPasswordEncryptionPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -180,6 +186,12 @@ func (r *changelogPasswordEncryptionPluginResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ChangelogPasswordEncryptionPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Changelog Password Encryption Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state changelogPasswordEncryptionPluginResourceModel
 	readChangelogPasswordEncryptionPluginResponse(ctx, readResponse.ChangelogPasswordEncryptionPluginResponse, &state, &state, &resp.Diagnostics)
@@ -206,6 +218,7 @@ func (r *changelogPasswordEncryptionPluginResource) Create(ctx context.Context,
 
 		// Read the response
 		readChangelogPasswordEncryptionPluginResponse(ctx, updateResponse.ChangelogPasswordEncryptionPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -230,7 +243,12 @@ func (r *changelogPasswordEncryptionPluginResource) Read(ctx context.Context, re
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Changelog Password Encryption Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Changelog Password Encryption Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Changelog Password Encryption Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -240,6 +258,13 @@ func (r *changelogPasswordEncryptionPluginResource) Read(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ChangelogPasswordEncryptionPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Changelog Password Encryption Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readChangelogPasswordEncryptionPluginResponse(ctx, readResponse.ChangelogPasswordEncryptionPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -288,6 +313,7 @@ func (r *changelogPasswordEncryptionPluginResource) Update(ctx context.Context,
 
 		// Read the response
 		readChangelogPasswordEncryptionPluginResponse(ctx, updateResponse.ChangelogPasswordEncryptionPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -311,4 +337,5 @@ func (r *changelogPasswordEncryptionPluginResource) Delete(ctx context.Context,
 func (r *changelogPasswordEncryptionPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource{}
+	_ datasource.DataSourceWithConfigure = &cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource{}
+)
+
+// Create a Clean Up Expired Pingfederate Persistent Access Grants Plugin data source
+func NewCleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource() datasource.DataSource {
+	return &cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource{}
+}
+
+// cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource is the datasource implementation.
+type cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clean_up_expired_pingfederate_persistent_access_grants_plugin"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var resourceSchemaResp resource.SchemaResponse
+	cleanUpExpiredPingfederatePersistentAccessGrantsPluginSchema(ctx, resource.SchemaRequest{}, &resourceSchemaResp, false)
+	resp.Schema = config.ToDataSourceSchema(resourceSchemaResp.Schema, []string{"id"})
+}
+
+// Read resource information
+func (r *cleanUpExpiredPingfederatePersistentAccessGrantsPluginDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the config values used to identify the config object
+	var state cleanUpExpiredPingfederatePersistentAccessGrantsPluginResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
+		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Expired Pingfederate Persistent Access Grants Plugin", err, httpResp)
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Verify the config object has the expected type
+	if readResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Access Grants Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+
+	// Read the response into the state
+	readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse(ctx, readResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
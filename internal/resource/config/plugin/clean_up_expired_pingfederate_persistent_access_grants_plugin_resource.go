@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &cleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
 	_ resource.ResourceWithConfigure   = &cleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
 	_ resource.ResourceWithImportState = &cleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &cleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
 	_ resource.Resource                = &defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
 	_ resource.ResourceWithImportState = &defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource{}
 )
 
 // Create a Clean Up Expired Pingfederate Persistent Access Grants Plugin resource
@@ -159,6 +161,15 @@ func cleanUpExpiredPingfederatePersistentAccessGrantsPluginSchema(ctx context.Co
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *cleanUpExpiredPingfederatePersistentAccessGrantsPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalCleanUpExpiredPingfederatePersistentAccessGrantsPluginFields(ctx context.Context, addRequest *client.AddCleanUpExpiredPingfederatePersistentAccessGrantsPluginRequest, plan cleanUpExpiredPingfederatePersistentAccessGrantsPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -250,6 +261,7 @@ func (r *cleanUpExpiredPingfederatePersistentAccessGrantsPluginResource) Create(
 	// Read the response into the state
 	var state cleanUpExpiredPingfederatePersistentAccessGrantsPluginResourceModel
 	readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse(ctx, addResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -288,6 +300,12 @@ func (r *defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource)
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Access Grants Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state cleanUpExpiredPingfederatePersistentAccessGrantsPluginResourceModel
 	readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse(ctx, readResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &state, &state, &resp.Diagnostics)
@@ -314,6 +332,7 @@ func (r *defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource)
 
 		// Read the response
 		readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse(ctx, updateResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -346,7 +365,12 @@ func readCleanUpExpiredPingfederatePersistentAccessGrantsPlugin(ctx context.Cont
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Expired Pingfederate Persistent Access Grants Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Access Grants Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Expired Pingfederate Persistent Access Grants Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -356,6 +380,13 @@ func readCleanUpExpiredPingfederatePersistentAccessGrantsPlugin(ctx context.Cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Access Grants Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse(ctx, readResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -412,6 +443,7 @@ func updateCleanUpExpiredPingfederatePersistentAccessGrantsPlugin(ctx context.Co
 
 		// Read the response
 		readCleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse(ctx, updateResponse.CleanUpExpiredPingfederatePersistentAccessGrantsPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -460,4 +492,5 @@ func (r *defaultCleanUpExpiredPingfederatePersistentAccessGrantsPluginResource)
 func importCleanUpExpiredPingfederatePersistentAccessGrantsPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
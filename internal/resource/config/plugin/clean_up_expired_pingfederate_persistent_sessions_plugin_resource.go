@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &cleanUpExpiredPingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithConfigure   = &cleanUpExpiredPingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithImportState = &cleanUpExpiredPingfederatePersistentSessionsPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &cleanUpExpiredPingfederatePersistentSessionsPluginResource{}
 	_ resource.Resource                = &defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithImportState = &defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource{}
 )
 
 // Create a Clean Up Expired Pingfederate Persistent Sessions Plugin resource
@@ -159,6 +161,15 @@ func cleanUpExpiredPingfederatePersistentSessionsPluginSchema(ctx context.Contex
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *cleanUpExpiredPingfederatePersistentSessionsPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalCleanUpExpiredPingfederatePersistentSessionsPluginFields(ctx context.Context, addRequest *client.AddCleanUpExpiredPingfederatePersistentSessionsPluginRequest, plan cleanUpExpiredPingfederatePersistentSessionsPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -250,6 +261,7 @@ func (r *cleanUpExpiredPingfederatePersistentSessionsPluginResource) Create(ctx
 	// Read the response into the state
 	var state cleanUpExpiredPingfederatePersistentSessionsPluginResourceModel
 	readCleanUpExpiredPingfederatePersistentSessionsPluginResponse(ctx, addResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -288,6 +300,12 @@ func (r *defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource) Crea
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Sessions Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state cleanUpExpiredPingfederatePersistentSessionsPluginResourceModel
 	readCleanUpExpiredPingfederatePersistentSessionsPluginResponse(ctx, readResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse, &state, &state, &resp.Diagnostics)
@@ -314,6 +332,7 @@ func (r *defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource) Crea
 
 		// Read the response
 		readCleanUpExpiredPingfederatePersistentSessionsPluginResponse(ctx, updateResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -346,7 +365,12 @@ func readCleanUpExpiredPingfederatePersistentSessionsPlugin(ctx context.Context,
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Expired Pingfederate Persistent Sessions Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Sessions Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Expired Pingfederate Persistent Sessions Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -356,6 +380,13 @@ func readCleanUpExpiredPingfederatePersistentSessionsPlugin(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Clean Up Expired Pingfederate Persistent Sessions Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCleanUpExpiredPingfederatePersistentSessionsPluginResponse(ctx, readResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -412,6 +443,7 @@ func updateCleanUpExpiredPingfederatePersistentSessionsPlugin(ctx context.Contex
 
 		// Read the response
 		readCleanUpExpiredPingfederatePersistentSessionsPluginResponse(ctx, updateResponse.CleanUpExpiredPingfederatePersistentSessionsPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -460,4 +492,5 @@ func (r *defaultCleanUpExpiredPingfederatePersistentSessionsPluginResource) Impo
 func importCleanUpExpiredPingfederatePersistentSessionsPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
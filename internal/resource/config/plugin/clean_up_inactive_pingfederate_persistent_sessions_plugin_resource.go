@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &cleanUpInactivePingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithConfigure   = &cleanUpInactivePingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithImportState = &cleanUpInactivePingfederatePersistentSessionsPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &cleanUpInactivePingfederatePersistentSessionsPluginResource{}
 	_ resource.Resource                = &defaultCleanUpInactivePingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultCleanUpInactivePingfederatePersistentSessionsPluginResource{}
 	_ resource.ResourceWithImportState = &defaultCleanUpInactivePingfederatePersistentSessionsPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCleanUpInactivePingfederatePersistentSessionsPluginResource{}
 )
 
 // Create a Clean Up Inactive Pingfederate Persistent Sessions Plugin resource
@@ -164,6 +166,15 @@ func cleanUpInactivePingfederatePersistentSessionsPluginSchema(ctx context.Conte
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *cleanUpInactivePingfederatePersistentSessionsPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultCleanUpInactivePingfederatePersistentSessionsPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalCleanUpInactivePingfederatePersistentSessionsPluginFields(ctx context.Context, addRequest *client.AddCleanUpInactivePingfederatePersistentSessionsPluginRequest, plan cleanUpInactivePingfederatePersistentSessionsPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -260,6 +271,7 @@ func (r *cleanUpInactivePingfederatePersistentSessionsPluginResource) Create(ctx
 	// Read the response into the state
 	var state cleanUpInactivePingfederatePersistentSessionsPluginResourceModel
 	readCleanUpInactivePingfederatePersistentSessionsPluginResponse(ctx, addResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -298,6 +310,12 @@ func (r *defaultCleanUpInactivePingfederatePersistentSessionsPluginResource) Cre
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Clean Up Inactive Pingfederate Persistent Sessions Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state cleanUpInactivePingfederatePersistentSessionsPluginResourceModel
 	readCleanUpInactivePingfederatePersistentSessionsPluginResponse(ctx, readResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse, &state, &state, &resp.Diagnostics)
@@ -324,6 +342,7 @@ func (r *defaultCleanUpInactivePingfederatePersistentSessionsPluginResource) Cre
 
 		// Read the response
 		readCleanUpInactivePingfederatePersistentSessionsPluginResponse(ctx, updateResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -356,7 +375,12 @@ func readCleanUpInactivePingfederatePersistentSessionsPlugin(ctx context.Context
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Inactive Pingfederate Persistent Sessions Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Clean Up Inactive Pingfederate Persistent Sessions Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Clean Up Inactive Pingfederate Persistent Sessions Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -366,6 +390,13 @@ func readCleanUpInactivePingfederatePersistentSessionsPlugin(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Clean Up Inactive Pingfederate Persistent Sessions Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCleanUpInactivePingfederatePersistentSessionsPluginResponse(ctx, readResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -422,6 +453,7 @@ func updateCleanUpInactivePingfederatePersistentSessionsPlugin(ctx context.Conte
 
 		// Read the response
 		readCleanUpInactivePingfederatePersistentSessionsPluginResponse(ctx, updateResponse.CleanUpInactivePingfederatePersistentSessionsPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -470,4 +502,5 @@ func (r *defaultCleanUpInactivePingfederatePersistentSessionsPluginResource) Imp
 func importCleanUpInactivePingfederatePersistentSessionsPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
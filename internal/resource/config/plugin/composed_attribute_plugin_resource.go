@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &composedAttributePluginResource{}
 	_ resource.ResourceWithConfigure   = &composedAttributePluginResource{}
 	_ resource.ResourceWithImportState = &composedAttributePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &composedAttributePluginResource{}
 	_ resource.Resource                = &defaultComposedAttributePluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultComposedAttributePluginResource{}
 	_ resource.ResourceWithImportState = &defaultComposedAttributePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultComposedAttributePluginResource{}
 )
 
 // Create a Composed Attribute Plugin resource
@@ -262,6 +264,15 @@ func composedAttributePluginSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *composedAttributePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultComposedAttributePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalComposedAttributePluginFields(ctx context.Context, addRequest *client.AddComposedAttributePluginRequest, plan composedAttributePluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -477,6 +488,7 @@ func (r *composedAttributePluginResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state composedAttributePluginResourceModel
 	readComposedAttributePluginResponse(ctx, addResponse.ComposedAttributePluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -515,6 +527,12 @@ func (r *defaultComposedAttributePluginResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ComposedAttributePluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Composed Attribute Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state composedAttributePluginResourceModel
 	readComposedAttributePluginResponse(ctx, readResponse.ComposedAttributePluginResponse, &state, &state, &resp.Diagnostics)
@@ -541,6 +559,7 @@ func (r *defaultComposedAttributePluginResource) Create(ctx context.Context, req
 
 		// Read the response
 		readComposedAttributePluginResponse(ctx, updateResponse.ComposedAttributePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -573,7 +592,12 @@ func readComposedAttributePlugin(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Composed Attribute Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Composed Attribute Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Composed Attribute Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -583,6 +607,13 @@ func readComposedAttributePlugin(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ComposedAttributePluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Composed Attribute Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readComposedAttributePluginResponse(ctx, readResponse.ComposedAttributePluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -639,6 +670,7 @@ func updateComposedAttributePlugin(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readComposedAttributePluginResponse(ctx, updateResponse.ComposedAttributePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -687,4 +719,5 @@ func (r *defaultComposedAttributePluginResource) ImportState(ctx context.Context
 func importComposedAttributePlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &customPluginResource{}
 	_ resource.ResourceWithConfigure   = &customPluginResource{}
 	_ resource.ResourceWithImportState = &customPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &customPluginResource{}
 )
 
 // Create a Custom Plugin resource
@@ -109,6 +110,11 @@ func (r *customPluginResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *customPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a CustomPluginResponse object into the model struct
 func readCustomPluginResponse(ctx context.Context, r *client.CustomPluginResponse, state *customPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -156,6 +162,12 @@ func (r *customPluginResource) Create(ctx context.Context, req resource.CreateRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CustomPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Custom Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state customPluginResourceModel
 	readCustomPluginResponse(ctx, readResponse.CustomPluginResponse, &state, &resp.Diagnostics)
@@ -182,6 +194,7 @@ func (r *customPluginResource) Create(ctx context.Context, req resource.CreateRe
 
 		// Read the response
 		readCustomPluginResponse(ctx, updateResponse.CustomPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -206,7 +219,12 @@ func (r *customPluginResource) Read(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Custom Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -216,6 +234,13 @@ func (r *customPluginResource) Read(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CustomPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Custom Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCustomPluginResponse(ctx, readResponse.CustomPluginResponse, &state, &resp.Diagnostics)
 
@@ -264,6 +289,7 @@ func (r *customPluginResource) Update(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readCustomPluginResponse(ctx, updateResponse.CustomPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -287,4 +313,5 @@ func (r *customPluginResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *customPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
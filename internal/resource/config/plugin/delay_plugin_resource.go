@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &delayPluginResource{}
 	_ resource.ResourceWithConfigure   = &delayPluginResource{}
 	_ resource.ResourceWithImportState = &delayPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &delayPluginResource{}
 	_ resource.Resource                = &defaultDelayPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelayPluginResource{}
 	_ resource.ResourceWithImportState = &defaultDelayPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDelayPluginResource{}
 )
 
 // Create a Delay Plugin resource
@@ -153,6 +155,15 @@ func delayPluginSchema(ctx context.Context, req resource.SchemaRequest, resp *re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *delayPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultDelayPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalDelayPluginFields(ctx context.Context, addRequest *client.AddDelayPluginRequest, plan delayPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -263,6 +274,7 @@ func (r *delayPluginResource) Create(ctx context.Context, req resource.CreateReq
 	// Read the response into the state
 	var state delayPluginResourceModel
 	readDelayPluginResponse(ctx, addResponse.DelayPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -301,6 +313,12 @@ func (r *defaultDelayPluginResource) Create(ctx context.Context, req resource.Cr
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DelayPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Delay Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state delayPluginResourceModel
 	readDelayPluginResponse(ctx, readResponse.DelayPluginResponse, &state, &state, &resp.Diagnostics)
@@ -327,6 +345,7 @@ func (r *defaultDelayPluginResource) Create(ctx context.Context, req resource.Cr
 
 		// Read the response
 		readDelayPluginResponse(ctx, updateResponse.DelayPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -359,7 +378,12 @@ func readDelayPlugin(ctx context.Context, req resource.ReadRequest, resp *resour
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delay Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Delay Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delay Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -369,6 +393,13 @@ func readDelayPlugin(ctx context.Context, req resource.ReadRequest, resp *resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DelayPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Delay Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDelayPluginResponse(ctx, readResponse.DelayPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -425,6 +456,7 @@ func updateDelayPlugin(ctx context.Context, req resource.UpdateRequest, resp *re
 
 		// Read the response
 		readDelayPluginResponse(ctx, updateResponse.DelayPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -473,4 +505,5 @@ func (r *defaultDelayPluginResource) ImportState(ctx context.Context, req resour
 func importDelayPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
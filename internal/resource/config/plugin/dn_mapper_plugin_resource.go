@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &dnMapperPluginResource{}
 	_ resource.ResourceWithConfigure   = &dnMapperPluginResource{}
 	_ resource.ResourceWithImportState = &dnMapperPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &dnMapperPluginResource{}
 	_ resource.Resource                = &defaultDnMapperPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultDnMapperPluginResource{}
 	_ resource.ResourceWithImportState = &defaultDnMapperPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDnMapperPluginResource{}
 )
 
 // Create a Dn Mapper Plugin resource
@@ -185,6 +187,15 @@ func dnMapperPluginSchema(ctx context.Context, req resource.SchemaRequest, resp
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *dnMapperPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultDnMapperPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalDnMapperPluginFields(ctx context.Context, addRequest *client.AddDnMapperPluginRequest, plan dnMapperPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -307,6 +318,7 @@ func (r *dnMapperPluginResource) Create(ctx context.Context, req resource.Create
 	// Read the response into the state
 	var state dnMapperPluginResourceModel
 	readDnMapperPluginResponse(ctx, addResponse.DnMapperPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -345,6 +357,12 @@ func (r *defaultDnMapperPluginResource) Create(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DnMapperPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Dn Mapper Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state dnMapperPluginResourceModel
 	readDnMapperPluginResponse(ctx, readResponse.DnMapperPluginResponse, &state, &state, &resp.Diagnostics)
@@ -371,6 +389,7 @@ func (r *defaultDnMapperPluginResource) Create(ctx context.Context, req resource
 
 		// Read the response
 		readDnMapperPluginResponse(ctx, updateResponse.DnMapperPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -403,7 +422,12 @@ func readDnMapperPlugin(ctx context.Context, req resource.ReadRequest, resp *res
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dn Mapper Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Dn Mapper Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dn Mapper Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -413,6 +437,13 @@ func readDnMapperPlugin(ctx context.Context, req resource.ReadRequest, resp *res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DnMapperPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Dn Mapper Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDnMapperPluginResponse(ctx, readResponse.DnMapperPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -469,6 +500,7 @@ func updateDnMapperPlugin(ctx context.Context, req resource.UpdateRequest, resp
 
 		// Read the response
 		readDnMapperPluginResponse(ctx, updateResponse.DnMapperPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -517,4 +549,5 @@ func (r *defaultDnMapperPluginResource) ImportState(ctx context.Context, req res
 func importDnMapperPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &encryptAttributeValuesPluginResource{}
 	_ resource.ResourceWithConfigure   = &encryptAttributeValuesPluginResource{}
 	_ resource.ResourceWithImportState = &encryptAttributeValuesPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &encryptAttributeValuesPluginResource{}
 )
 
 // Create a Encrypt Attribute Values Plugin resource
@@ -128,6 +129,11 @@ func (r *encryptAttributeValuesPluginResource) Schema(ctx context.Context, req r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *encryptAttributeValuesPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a EncryptAttributeValuesPluginResponse object into the model struct
 func readEncryptAttributeValuesPluginResponse(ctx context.Context, r *client.EncryptAttributeValuesPluginResponse, state *encryptAttributeValuesPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -180,6 +186,12 @@ func (r *encryptAttributeValuesPluginResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EncryptAttributeValuesPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Encrypt Attribute Values Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state encryptAttributeValuesPluginResourceModel
 	readEncryptAttributeValuesPluginResponse(ctx, readResponse.EncryptAttributeValuesPluginResponse, &state, &resp.Diagnostics)
@@ -206,6 +218,7 @@ func (r *encryptAttributeValuesPluginResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readEncryptAttributeValuesPluginResponse(ctx, updateResponse.EncryptAttributeValuesPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -230,7 +243,12 @@ func (r *encryptAttributeValuesPluginResource) Read(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Encrypt Attribute Values Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Encrypt Attribute Values Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Encrypt Attribute Values Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -240,6 +258,13 @@ func (r *encryptAttributeValuesPluginResource) Read(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EncryptAttributeValuesPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Encrypt Attribute Values Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readEncryptAttributeValuesPluginResponse(ctx, readResponse.EncryptAttributeValuesPluginResponse, &state, &resp.Diagnostics)
 
@@ -288,6 +313,7 @@ func (r *encryptAttributeValuesPluginResource) Update(ctx context.Context, req r
 
 		// Read the response
 		readEncryptAttributeValuesPluginResponse(ctx, updateResponse.EncryptAttributeValuesPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -311,4 +337,5 @@ func (r *encryptAttributeValuesPluginResource) Delete(ctx context.Context, req r
 func (r *encryptAttributeValuesPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &groovyScriptedPluginResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedPluginResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedPluginResource{}
 	_ resource.Resource                = &defaultGroovyScriptedPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedPluginResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedPluginResource{}
 )
 
 // Create a Groovy Scripted Plugin resource
@@ -154,6 +156,15 @@ func groovyScriptedPluginSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedPluginFields(ctx context.Context, addRequest *client.AddGroovyScriptedPluginRequest, plan groovyScriptedPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -247,6 +258,7 @@ func (r *groovyScriptedPluginResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state groovyScriptedPluginResourceModel
 	readGroovyScriptedPluginResponse(ctx, addResponse.GroovyScriptedPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -285,6 +297,12 @@ func (r *defaultGroovyScriptedPluginResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedPluginResourceModel
 	readGroovyScriptedPluginResponse(ctx, readResponse.GroovyScriptedPluginResponse, &state, &state, &resp.Diagnostics)
@@ -311,6 +329,7 @@ func (r *defaultGroovyScriptedPluginResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readGroovyScriptedPluginResponse(ctx, updateResponse.GroovyScriptedPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -343,7 +362,12 @@ func readGroovyScriptedPlugin(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -353,6 +377,13 @@ func readGroovyScriptedPlugin(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedPluginResponse(ctx, readResponse.GroovyScriptedPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -409,6 +440,7 @@ func updateGroovyScriptedPlugin(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readGroovyScriptedPluginResponse(ctx, updateResponse.GroovyScriptedPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -457,4 +489,5 @@ func (r *defaultGroovyScriptedPluginResource) ImportState(ctx context.Context, r
 func importGroovyScriptedPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &internalSearchRatePluginResource{}
 	_ resource.ResourceWithConfigure   = &internalSearchRatePluginResource{}
 	_ resource.ResourceWithImportState = &internalSearchRatePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &internalSearchRatePluginResource{}
 	_ resource.Resource                = &defaultInternalSearchRatePluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultInternalSearchRatePluginResource{}
 	_ resource.ResourceWithImportState = &defaultInternalSearchRatePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultInternalSearchRatePluginResource{}
 )
 
 // Create a Internal Search Rate Plugin resource
@@ -177,6 +179,15 @@ func internalSearchRatePluginSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *internalSearchRatePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultInternalSearchRatePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalInternalSearchRatePluginFields(ctx context.Context, addRequest *client.AddInternalSearchRatePluginRequest, plan internalSearchRatePluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -299,6 +310,7 @@ func (r *internalSearchRatePluginResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state internalSearchRatePluginResourceModel
 	readInternalSearchRatePluginResponse(ctx, addResponse.InternalSearchRatePluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -337,6 +349,12 @@ func (r *defaultInternalSearchRatePluginResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.InternalSearchRatePluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Internal Search Rate Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state internalSearchRatePluginResourceModel
 	readInternalSearchRatePluginResponse(ctx, readResponse.InternalSearchRatePluginResponse, &state, &state, &resp.Diagnostics)
@@ -363,6 +381,7 @@ func (r *defaultInternalSearchRatePluginResource) Create(ctx context.Context, re
 
 		// Read the response
 		readInternalSearchRatePluginResponse(ctx, updateResponse.InternalSearchRatePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -395,7 +414,12 @@ func readInternalSearchRatePlugin(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Internal Search Rate Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Internal Search Rate Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Internal Search Rate Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -405,6 +429,13 @@ func readInternalSearchRatePlugin(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.InternalSearchRatePluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Internal Search Rate Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readInternalSearchRatePluginResponse(ctx, readResponse.InternalSearchRatePluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -461,6 +492,7 @@ func updateInternalSearchRatePlugin(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readInternalSearchRatePluginResponse(ctx, updateResponse.InternalSearchRatePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -509,4 +541,5 @@ func (r *defaultInternalSearchRatePluginResource) ImportState(ctx context.Contex
 func importInternalSearchRatePlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &lastAccessTimePluginResource{}
 	_ resource.ResourceWithConfigure   = &lastAccessTimePluginResource{}
 	_ resource.ResourceWithImportState = &lastAccessTimePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &lastAccessTimePluginResource{}
 )
 
 // Create a Last Access Time Plugin resource
@@ -146,6 +147,11 @@ func (r *lastAccessTimePluginResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *lastAccessTimePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a LastAccessTimePluginResponse object into the model struct
 func readLastAccessTimePluginResponse(ctx context.Context, r *client.LastAccessTimePluginResponse, state *lastAccessTimePluginResourceModel, expectedValues *lastAccessTimePluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -203,6 +209,12 @@ func (r *lastAccessTimePluginResource) Create(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LastAccessTimePluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Last Access Time Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state lastAccessTimePluginResourceModel
 	readLastAccessTimePluginResponse(ctx, readResponse.LastAccessTimePluginResponse, &state, &state, &resp.Diagnostics)
@@ -229,6 +241,7 @@ func (r *lastAccessTimePluginResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readLastAccessTimePluginResponse(ctx, updateResponse.LastAccessTimePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -253,7 +266,12 @@ func (r *lastAccessTimePluginResource) Read(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Last Access Time Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Last Access Time Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Last Access Time Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -263,6 +281,13 @@ func (r *lastAccessTimePluginResource) Read(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LastAccessTimePluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Last Access Time Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLastAccessTimePluginResponse(ctx, readResponse.LastAccessTimePluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -311,6 +336,7 @@ func (r *lastAccessTimePluginResource) Update(ctx context.Context, req resource.
 
 		// Read the response
 		readLastAccessTimePluginResponse(ctx, updateResponse.LastAccessTimePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -334,4 +360,5 @@ func (r *lastAccessTimePluginResource) Delete(ctx context.Context, req resource.
 func (r *lastAccessTimePluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &lastModPluginResource{}
 	_ resource.ResourceWithConfigure   = &lastModPluginResource{}
 	_ resource.ResourceWithImportState = &lastModPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &lastModPluginResource{}
 )
 
 // Create a Last Mod Plugin resource
@@ -129,6 +130,11 @@ func (r *lastModPluginResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *lastModPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a LastModPluginResponse object into the model struct
 func readLastModPluginResponse(ctx context.Context, r *client.LastModPluginResponse, state *lastModPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -180,6 +186,12 @@ func (r *lastModPluginResource) Create(ctx context.Context, req resource.CreateR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LastModPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Last Mod Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state lastModPluginResourceModel
 	readLastModPluginResponse(ctx, readResponse.LastModPluginResponse, &state, &resp.Diagnostics)
@@ -206,6 +218,7 @@ func (r *lastModPluginResource) Create(ctx context.Context, req resource.CreateR
 
 		// Read the response
 		readLastModPluginResponse(ctx, updateResponse.LastModPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -230,7 +243,12 @@ func (r *lastModPluginResource) Read(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Last Mod Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Last Mod Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Last Mod Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -240,6 +258,13 @@ func (r *lastModPluginResource) Read(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LastModPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Last Mod Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLastModPluginResponse(ctx, readResponse.LastModPluginResponse, &state, &resp.Diagnostics)
 
@@ -288,6 +313,7 @@ func (r *lastModPluginResource) Update(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readLastModPluginResponse(ctx, updateResponse.LastModPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -311,4 +337,5 @@ func (r *lastModPluginResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *lastModPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &ldapResultCodeTrackerPluginResource{}
 	_ resource.ResourceWithConfigure   = &ldapResultCodeTrackerPluginResource{}
 	_ resource.ResourceWithImportState = &ldapResultCodeTrackerPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &ldapResultCodeTrackerPluginResource{}
 )
 
 // Create a Ldap Result Code Tracker Plugin resource
@@ -109,6 +110,11 @@ func (r *ldapResultCodeTrackerPluginResource) Schema(ctx context.Context, req re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *ldapResultCodeTrackerPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a LdapResultCodeTrackerPluginResponse object into the model struct
 func readLdapResultCodeTrackerPluginResponse(ctx context.Context, r *client.LdapResultCodeTrackerPluginResponse, state *ldapResultCodeTrackerPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -156,6 +162,12 @@ func (r *ldapResultCodeTrackerPluginResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapResultCodeTrackerPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldap Result Code Tracker Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldapResultCodeTrackerPluginResourceModel
 	readLdapResultCodeTrackerPluginResponse(ctx, readResponse.LdapResultCodeTrackerPluginResponse, &state, &resp.Diagnostics)
@@ -182,6 +194,7 @@ func (r *ldapResultCodeTrackerPluginResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readLdapResultCodeTrackerPluginResponse(ctx, updateResponse.LdapResultCodeTrackerPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -206,7 +219,12 @@ func (r *ldapResultCodeTrackerPluginResource) Read(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Result Code Tracker Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldap Result Code Tracker Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldap Result Code Tracker Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -216,6 +234,13 @@ func (r *ldapResultCodeTrackerPluginResource) Read(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdapResultCodeTrackerPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldap Result Code Tracker Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdapResultCodeTrackerPluginResponse(ctx, readResponse.LdapResultCodeTrackerPluginResponse, &state, &resp.Diagnostics)
 
@@ -264,6 +289,7 @@ func (r *ldapResultCodeTrackerPluginResource) Update(ctx context.Context, req re
 
 		// Read the response
 		readLdapResultCodeTrackerPluginResponse(ctx, updateResponse.LdapResultCodeTrackerPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -287,4 +313,5 @@ func (r *ldapResultCodeTrackerPluginResource) Delete(ctx context.Context, req re
 func (r *ldapResultCodeTrackerPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &modifiablePasswordPolicyStatePluginResource{}
 	_ resource.ResourceWithConfigure   = &modifiablePasswordPolicyStatePluginResource{}
 	_ resource.ResourceWithImportState = &modifiablePasswordPolicyStatePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &modifiablePasswordPolicyStatePluginResource{}
 	_ resource.Resource                = &defaultModifiablePasswordPolicyStatePluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultModifiablePasswordPolicyStatePluginResource{}
 	_ resource.ResourceWithImportState = &defaultModifiablePasswordPolicyStatePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultModifiablePasswordPolicyStatePluginResource{}
 )
 
 // Create a Modifiable Password Policy State Plugin resource
@@ -138,6 +140,15 @@ func modifiablePasswordPolicyStatePluginSchema(ctx context.Context, req resource
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *modifiablePasswordPolicyStatePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultModifiablePasswordPolicyStatePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalModifiablePasswordPolicyStatePluginFields(ctx context.Context, addRequest *client.AddModifiablePasswordPolicyStatePluginRequest, plan modifiablePasswordPolicyStatePluginResourceModel) {
 	if internaltypes.IsDefined(plan.BaseDN) {
@@ -216,6 +227,7 @@ func (r *modifiablePasswordPolicyStatePluginResource) Create(ctx context.Context
 	// Read the response into the state
 	var state modifiablePasswordPolicyStatePluginResourceModel
 	readModifiablePasswordPolicyStatePluginResponse(ctx, addResponse.ModifiablePasswordPolicyStatePluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -254,6 +266,12 @@ func (r *defaultModifiablePasswordPolicyStatePluginResource) Create(ctx context.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ModifiablePasswordPolicyStatePluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Modifiable Password Policy State Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state modifiablePasswordPolicyStatePluginResourceModel
 	readModifiablePasswordPolicyStatePluginResponse(ctx, readResponse.ModifiablePasswordPolicyStatePluginResponse, &state, &state, &resp.Diagnostics)
@@ -280,6 +298,7 @@ func (r *defaultModifiablePasswordPolicyStatePluginResource) Create(ctx context.
 
 		// Read the response
 		readModifiablePasswordPolicyStatePluginResponse(ctx, updateResponse.ModifiablePasswordPolicyStatePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -312,7 +331,12 @@ func readModifiablePasswordPolicyStatePlugin(ctx context.Context, req resource.R
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Modifiable Password Policy State Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Modifiable Password Policy State Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Modifiable Password Policy State Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -322,6 +346,13 @@ func readModifiablePasswordPolicyStatePlugin(ctx context.Context, req resource.R
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ModifiablePasswordPolicyStatePluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Modifiable Password Policy State Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readModifiablePasswordPolicyStatePluginResponse(ctx, readResponse.ModifiablePasswordPolicyStatePluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -378,6 +409,7 @@ func updateModifiablePasswordPolicyStatePlugin(ctx context.Context, req resource
 
 		// Read the response
 		readModifiablePasswordPolicyStatePluginResponse(ctx, updateResponse.ModifiablePasswordPolicyStatePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -426,4 +458,5 @@ func (r *defaultModifiablePasswordPolicyStatePluginResource) ImportState(ctx con
 func importModifiablePasswordPolicyStatePlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
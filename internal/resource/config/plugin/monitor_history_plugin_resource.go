@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &monitorHistoryPluginResource{}
 	_ resource.ResourceWithConfigure   = &monitorHistoryPluginResource{}
 	_ resource.ResourceWithImportState = &monitorHistoryPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &monitorHistoryPluginResource{}
 )
 
 // Create a Monitor History Plugin resource
@@ -154,6 +155,11 @@ func (r *monitorHistoryPluginResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *monitorHistoryPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a MonitorHistoryPluginResponse object into the model struct
 func readMonitorHistoryPluginResponse(ctx context.Context, r *client.MonitorHistoryPluginResponse, state *monitorHistoryPluginResourceModel, expectedValues *monitorHistoryPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -213,6 +219,12 @@ func (r *monitorHistoryPluginResource) Create(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MonitorHistoryPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Monitor History Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state monitorHistoryPluginResourceModel
 	readMonitorHistoryPluginResponse(ctx, readResponse.MonitorHistoryPluginResponse, &state, &state, &resp.Diagnostics)
@@ -239,6 +251,7 @@ func (r *monitorHistoryPluginResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readMonitorHistoryPluginResponse(ctx, updateResponse.MonitorHistoryPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -263,7 +276,12 @@ func (r *monitorHistoryPluginResource) Read(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitor History Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Monitor History Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Monitor History Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -273,6 +291,13 @@ func (r *monitorHistoryPluginResource) Read(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MonitorHistoryPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Monitor History Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMonitorHistoryPluginResponse(ctx, readResponse.MonitorHistoryPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -321,6 +346,7 @@ func (r *monitorHistoryPluginResource) Update(ctx context.Context, req resource.
 
 		// Read the response
 		readMonitorHistoryPluginResponse(ctx, updateResponse.MonitorHistoryPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -344,4 +370,5 @@ func (r *monitorHistoryPluginResource) Delete(ctx context.Context, req resource.
 func (r *monitorHistoryPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
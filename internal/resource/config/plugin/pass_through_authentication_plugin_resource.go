@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &passThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithConfigure   = &passThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithImportState = &passThroughAuthenticationPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &passThroughAuthenticationPluginResource{}
 	_ resource.Resource                = &defaultPassThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultPassThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithImportState = &defaultPassThroughAuthenticationPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPassThroughAuthenticationPluginResource{}
 )
 
 // Create a Pass Through Authentication Plugin resource
@@ -258,6 +260,15 @@ func passThroughAuthenticationPluginSchema(ctx context.Context, req resource.Sch
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *passThroughAuthenticationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPassThroughAuthenticationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPassThroughAuthenticationPluginFields(ctx context.Context, addRequest *client.AddPassThroughAuthenticationPluginRequest, plan passThroughAuthenticationPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -449,6 +460,7 @@ func (r *passThroughAuthenticationPluginResource) Create(ctx context.Context, re
 	// Read the response into the state
 	var state passThroughAuthenticationPluginResourceModel
 	readPassThroughAuthenticationPluginResponse(ctx, addResponse.PassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -487,6 +499,12 @@ func (r *defaultPassThroughAuthenticationPluginResource) Create(ctx context.Cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PassThroughAuthenticationPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Pass Through Authentication Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state passThroughAuthenticationPluginResourceModel
 	readPassThroughAuthenticationPluginResponse(ctx, readResponse.PassThroughAuthenticationPluginResponse, &state, &state, &resp.Diagnostics)
@@ -513,6 +531,7 @@ func (r *defaultPassThroughAuthenticationPluginResource) Create(ctx context.Cont
 
 		// Read the response
 		readPassThroughAuthenticationPluginResponse(ctx, updateResponse.PassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -545,7 +564,12 @@ func readPassThroughAuthenticationPlugin(ctx context.Context, req resource.ReadR
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Pass Through Authentication Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Pass Through Authentication Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Pass Through Authentication Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -555,6 +579,13 @@ func readPassThroughAuthenticationPlugin(ctx context.Context, req resource.ReadR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PassThroughAuthenticationPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Pass Through Authentication Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPassThroughAuthenticationPluginResponse(ctx, readResponse.PassThroughAuthenticationPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -611,6 +642,7 @@ func updatePassThroughAuthenticationPlugin(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readPassThroughAuthenticationPluginResponse(ctx, updateResponse.PassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -659,4 +691,5 @@ func (r *defaultPassThroughAuthenticationPluginResource) ImportState(ctx context
 func importPassThroughAuthenticationPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
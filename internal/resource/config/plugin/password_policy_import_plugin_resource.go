@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &passwordPolicyImportPluginResource{}
 	_ resource.ResourceWithConfigure   = &passwordPolicyImportPluginResource{}
 	_ resource.ResourceWithImportState = &passwordPolicyImportPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &passwordPolicyImportPluginResource{}
 )
 
 // Create a Password Policy Import Plugin resource
@@ -119,6 +120,11 @@ func (r *passwordPolicyImportPluginResource) Schema(ctx context.Context, req res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *passwordPolicyImportPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a PasswordPolicyImportPluginResponse object into the model struct
 func readPasswordPolicyImportPluginResponse(ctx context.Context, r *client.PasswordPolicyImportPluginResponse, state *passwordPolicyImportPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -167,6 +173,12 @@ func (r *passwordPolicyImportPluginResource) Create(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PasswordPolicyImportPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Password Policy Import Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state passwordPolicyImportPluginResourceModel
 	readPasswordPolicyImportPluginResponse(ctx, readResponse.PasswordPolicyImportPluginResponse, &state, &resp.Diagnostics)
@@ -193,6 +205,7 @@ func (r *passwordPolicyImportPluginResource) Create(ctx context.Context, req res
 
 		// Read the response
 		readPasswordPolicyImportPluginResponse(ctx, updateResponse.PasswordPolicyImportPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -217,7 +230,12 @@ func (r *passwordPolicyImportPluginResource) Read(ctx context.Context, req resou
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Policy Import Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Password Policy Import Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Policy Import Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -227,6 +245,13 @@ func (r *passwordPolicyImportPluginResource) Read(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PasswordPolicyImportPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Password Policy Import Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPasswordPolicyImportPluginResponse(ctx, readResponse.PasswordPolicyImportPluginResponse, &state, &resp.Diagnostics)
 
@@ -275,6 +300,7 @@ func (r *passwordPolicyImportPluginResource) Update(ctx context.Context, req res
 
 		// Read the response
 		readPasswordPolicyImportPluginResponse(ctx, updateResponse.PasswordPolicyImportPluginResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -298,4 +324,5 @@ func (r *passwordPolicyImportPluginResource) Delete(ctx context.Context, req res
 func (r *passwordPolicyImportPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
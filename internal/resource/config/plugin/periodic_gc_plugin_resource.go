@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &periodicGcPluginResource{}
 	_ resource.ResourceWithConfigure   = &periodicGcPluginResource{}
 	_ resource.ResourceWithImportState = &periodicGcPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &periodicGcPluginResource{}
 	_ resource.Resource                = &defaultPeriodicGcPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultPeriodicGcPluginResource{}
 	_ resource.ResourceWithImportState = &defaultPeriodicGcPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPeriodicGcPluginResource{}
 )
 
 // Create a Periodic Gc Plugin resource
@@ -173,6 +175,15 @@ func periodicGcPluginSchema(ctx context.Context, req resource.SchemaRequest, res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *periodicGcPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPeriodicGcPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPeriodicGcPluginFields(ctx context.Context, addRequest *client.AddPeriodicGcPluginRequest, plan periodicGcPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -303,6 +314,7 @@ func (r *periodicGcPluginResource) Create(ctx context.Context, req resource.Crea
 	// Read the response into the state
 	var state periodicGcPluginResourceModel
 	readPeriodicGcPluginResponse(ctx, addResponse.PeriodicGcPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -341,6 +353,12 @@ func (r *defaultPeriodicGcPluginResource) Create(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PeriodicGcPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Periodic Gc Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state periodicGcPluginResourceModel
 	readPeriodicGcPluginResponse(ctx, readResponse.PeriodicGcPluginResponse, &state, &state, &resp.Diagnostics)
@@ -367,6 +385,7 @@ func (r *defaultPeriodicGcPluginResource) Create(ctx context.Context, req resour
 
 		// Read the response
 		readPeriodicGcPluginResponse(ctx, updateResponse.PeriodicGcPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -399,7 +418,12 @@ func readPeriodicGcPlugin(ctx context.Context, req resource.ReadRequest, resp *r
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Periodic Gc Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Periodic Gc Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Periodic Gc Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -409,6 +433,13 @@ func readPeriodicGcPlugin(ctx context.Context, req resource.ReadRequest, resp *r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PeriodicGcPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Periodic Gc Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPeriodicGcPluginResponse(ctx, readResponse.PeriodicGcPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -465,6 +496,7 @@ func updatePeriodicGcPlugin(ctx context.Context, req resource.UpdateRequest, res
 
 		// Read the response
 		readPeriodicGcPluginResponse(ctx, updateResponse.PeriodicGcPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -513,4 +545,5 @@ func (r *defaultPeriodicGcPluginResource) ImportState(ctx context.Context, req r
 func importPeriodicGcPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
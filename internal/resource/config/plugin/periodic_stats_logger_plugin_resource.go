@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &periodicStatsLoggerPluginResource{}
 	_ resource.ResourceWithConfigure   = &periodicStatsLoggerPluginResource{}
 	_ resource.ResourceWithImportState = &periodicStatsLoggerPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &periodicStatsLoggerPluginResource{}
 	_ resource.Resource                = &defaultPeriodicStatsLoggerPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultPeriodicStatsLoggerPluginResource{}
 	_ resource.ResourceWithImportState = &defaultPeriodicStatsLoggerPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPeriodicStatsLoggerPluginResource{}
 )
 
 // Create a Periodic Stats Logger Plugin resource
@@ -368,6 +370,15 @@ func periodicStatsLoggerPluginSchema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *periodicStatsLoggerPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPeriodicStatsLoggerPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPeriodicStatsLoggerPluginFields(ctx context.Context, addRequest *client.AddPeriodicStatsLoggerPluginRequest, plan periodicStatsLoggerPluginResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -696,6 +707,7 @@ func (r *periodicStatsLoggerPluginResource) Create(ctx context.Context, req reso
 	// Read the response into the state
 	var state periodicStatsLoggerPluginResourceModel
 	readPeriodicStatsLoggerPluginResponse(ctx, addResponse.PeriodicStatsLoggerPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -734,6 +746,12 @@ func (r *defaultPeriodicStatsLoggerPluginResource) Create(ctx context.Context, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PeriodicStatsLoggerPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Periodic Stats Logger Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state periodicStatsLoggerPluginResourceModel
 	readPeriodicStatsLoggerPluginResponse(ctx, readResponse.PeriodicStatsLoggerPluginResponse, &state, &state, &resp.Diagnostics)
@@ -760,6 +778,7 @@ func (r *defaultPeriodicStatsLoggerPluginResource) Create(ctx context.Context, r
 
 		// Read the response
 		readPeriodicStatsLoggerPluginResponse(ctx, updateResponse.PeriodicStatsLoggerPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -792,7 +811,12 @@ func readPeriodicStatsLoggerPlugin(ctx context.Context, req resource.ReadRequest
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Periodic Stats Logger Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Periodic Stats Logger Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Periodic Stats Logger Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -802,6 +826,13 @@ func readPeriodicStatsLoggerPlugin(ctx context.Context, req resource.ReadRequest
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PeriodicStatsLoggerPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Periodic Stats Logger Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPeriodicStatsLoggerPluginResponse(ctx, readResponse.PeriodicStatsLoggerPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -858,6 +889,7 @@ func updatePeriodicStatsLoggerPlugin(ctx context.Context, req resource.UpdateReq
 
 		// Read the response
 		readPeriodicStatsLoggerPluginResponse(ctx, updateResponse.PeriodicStatsLoggerPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -906,4 +938,5 @@ func (r *defaultPeriodicStatsLoggerPluginResource) ImportState(ctx context.Conte
 func importPeriodicStatsLoggerPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
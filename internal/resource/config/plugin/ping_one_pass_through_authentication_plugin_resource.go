@@ -258,12 +258,15 @@ func pingOnePassThroughAuthenticationPluginSchema(ctx context.Context, req resou
 	resp.Schema = schema
 }
 
-// Validate that any version restrictions are met in the plan
+// Plan a replacement if the config object has been replaced with one of a different type, and validate
+// that any version restrictions are met in the plan
 func (r *pingOnePassThroughAuthenticationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
 	modifyPlanPingOnePassThroughAuthenticationPlugin(ctx, req, resp, r.apiClient, r.providerConfig)
 }
 
 func (r *defaultPingOnePassThroughAuthenticationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
 	modifyPlanPingOnePassThroughAuthenticationPlugin(ctx, req, resp, r.apiClient, r.providerConfig)
 }
 
@@ -478,6 +481,7 @@ func (r *pingOnePassThroughAuthenticationPluginResource) Create(ctx context.Cont
 	// Read the response into the state
 	var state pingOnePassThroughAuthenticationPluginResourceModel
 	readPingOnePassThroughAuthenticationPluginResponse(ctx, addResponse.PingOnePassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -516,6 +520,12 @@ func (r *defaultPingOnePassThroughAuthenticationPluginResource) Create(ctx conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingOnePassThroughAuthenticationPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ping One Pass Through Authentication Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state pingOnePassThroughAuthenticationPluginResourceModel
 	readPingOnePassThroughAuthenticationPluginResponse(ctx, readResponse.PingOnePassThroughAuthenticationPluginResponse, &state, &state, &resp.Diagnostics)
@@ -542,6 +552,7 @@ func (r *defaultPingOnePassThroughAuthenticationPluginResource) Create(ctx conte
 
 		// Read the response
 		readPingOnePassThroughAuthenticationPluginResponse(ctx, updateResponse.PingOnePassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -574,7 +585,12 @@ func readPingOnePassThroughAuthenticationPlugin(ctx context.Context, req resourc
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping One Pass Through Authentication Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ping One Pass Through Authentication Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ping One Pass Through Authentication Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -584,6 +600,13 @@ func readPingOnePassThroughAuthenticationPlugin(ctx context.Context, req resourc
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PingOnePassThroughAuthenticationPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ping One Pass Through Authentication Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPingOnePassThroughAuthenticationPluginResponse(ctx, readResponse.PingOnePassThroughAuthenticationPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -640,6 +663,7 @@ func updatePingOnePassThroughAuthenticationPlugin(ctx context.Context, req resou
 
 		// Read the response
 		readPingOnePassThroughAuthenticationPluginResponse(ctx, updateResponse.PingOnePassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -688,4 +712,5 @@ func (r *defaultPingOnePassThroughAuthenticationPluginResource) ImportState(ctx
 func importPingOnePassThroughAuthenticationPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
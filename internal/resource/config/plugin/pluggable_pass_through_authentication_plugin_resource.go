@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &pluggablePassThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithConfigure   = &pluggablePassThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithImportState = &pluggablePassThroughAuthenticationPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &pluggablePassThroughAuthenticationPluginResource{}
 	_ resource.Resource                = &defaultPluggablePassThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultPluggablePassThroughAuthenticationPluginResource{}
 	_ resource.ResourceWithImportState = &defaultPluggablePassThroughAuthenticationPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPluggablePassThroughAuthenticationPluginResource{}
 )
 
 // Create a Pluggable Pass Through Authentication Plugin resource
@@ -204,6 +206,15 @@ func pluggablePassThroughAuthenticationPluginSchema(ctx context.Context, req res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *pluggablePassThroughAuthenticationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPluggablePassThroughAuthenticationPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPluggablePassThroughAuthenticationPluginFields(ctx context.Context, addRequest *client.AddPluggablePassThroughAuthenticationPluginRequest, plan pluggablePassThroughAuthenticationPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.IncludedLocalEntryBaseDN) {
@@ -350,6 +361,7 @@ func (r *pluggablePassThroughAuthenticationPluginResource) Create(ctx context.Co
 	// Read the response into the state
 	var state pluggablePassThroughAuthenticationPluginResourceModel
 	readPluggablePassThroughAuthenticationPluginResponse(ctx, addResponse.PluggablePassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -388,6 +400,12 @@ func (r *defaultPluggablePassThroughAuthenticationPluginResource) Create(ctx con
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PluggablePassThroughAuthenticationPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Pluggable Pass Through Authentication Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state pluggablePassThroughAuthenticationPluginResourceModel
 	readPluggablePassThroughAuthenticationPluginResponse(ctx, readResponse.PluggablePassThroughAuthenticationPluginResponse, &state, &state, &resp.Diagnostics)
@@ -414,6 +432,7 @@ func (r *defaultPluggablePassThroughAuthenticationPluginResource) Create(ctx con
 
 		// Read the response
 		readPluggablePassThroughAuthenticationPluginResponse(ctx, updateResponse.PluggablePassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -446,7 +465,12 @@ func readPluggablePassThroughAuthenticationPlugin(ctx context.Context, req resou
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Pluggable Pass Through Authentication Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Pluggable Pass Through Authentication Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Pluggable Pass Through Authentication Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -456,6 +480,13 @@ func readPluggablePassThroughAuthenticationPlugin(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PluggablePassThroughAuthenticationPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Pluggable Pass Through Authentication Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPluggablePassThroughAuthenticationPluginResponse(ctx, readResponse.PluggablePassThroughAuthenticationPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -512,6 +543,7 @@ func updatePluggablePassThroughAuthenticationPlugin(ctx context.Context, req res
 
 		// Read the response
 		readPluggablePassThroughAuthenticationPluginResponse(ctx, updateResponse.PluggablePassThroughAuthenticationPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -560,4 +592,5 @@ func (r *defaultPluggablePassThroughAuthenticationPluginResource) ImportState(ct
 func importPluggablePassThroughAuthenticationPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
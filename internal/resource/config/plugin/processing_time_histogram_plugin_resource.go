@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &processingTimeHistogramPluginResource{}
 	_ resource.ResourceWithConfigure   = &processingTimeHistogramPluginResource{}
 	_ resource.ResourceWithImportState = &processingTimeHistogramPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &processingTimeHistogramPluginResource{}
 )
 
 // Create a Processing Time Histogram Plugin resource
@@ -137,6 +138,11 @@ func (r *processingTimeHistogramPluginResource) Schema(ctx context.Context, req
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *processingTimeHistogramPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ProcessingTimeHistogramPluginResponse object into the model struct
 func readProcessingTimeHistogramPluginResponse(ctx context.Context, r *client.ProcessingTimeHistogramPluginResponse, state *processingTimeHistogramPluginResourceModel, expectedValues *processingTimeHistogramPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -190,6 +196,12 @@ func (r *processingTimeHistogramPluginResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ProcessingTimeHistogramPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Processing Time Histogram Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state processingTimeHistogramPluginResourceModel
 	readProcessingTimeHistogramPluginResponse(ctx, readResponse.ProcessingTimeHistogramPluginResponse, &state, &state, &resp.Diagnostics)
@@ -216,6 +228,7 @@ func (r *processingTimeHistogramPluginResource) Create(ctx context.Context, req
 
 		// Read the response
 		readProcessingTimeHistogramPluginResponse(ctx, updateResponse.ProcessingTimeHistogramPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -240,7 +253,12 @@ func (r *processingTimeHistogramPluginResource) Read(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Processing Time Histogram Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Processing Time Histogram Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Processing Time Histogram Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -250,6 +268,13 @@ func (r *processingTimeHistogramPluginResource) Read(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ProcessingTimeHistogramPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Processing Time Histogram Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readProcessingTimeHistogramPluginResponse(ctx, readResponse.ProcessingTimeHistogramPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -298,6 +323,7 @@ func (r *processingTimeHistogramPluginResource) Update(ctx context.Context, req
 
 		// Read the response
 		readProcessingTimeHistogramPluginResponse(ctx, updateResponse.ProcessingTimeHistogramPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -321,4 +347,5 @@ func (r *processingTimeHistogramPluginResource) Delete(ctx context.Context, req
 func (r *processingTimeHistogramPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &profilerPluginResource{}
 	_ resource.ResourceWithConfigure   = &profilerPluginResource{}
 	_ resource.ResourceWithImportState = &profilerPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &profilerPluginResource{}
 )
 
 // Create a Profiler Plugin resource
@@ -125,6 +126,11 @@ func (r *profilerPluginResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *profilerPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ProfilerPluginResponse object into the model struct
 func readProfilerPluginResponse(ctx context.Context, r *client.ProfilerPluginResponse, state *profilerPluginResourceModel, expectedValues *profilerPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -178,6 +184,12 @@ func (r *profilerPluginResource) Create(ctx context.Context, req resource.Create
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ProfilerPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Profiler Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state profilerPluginResourceModel
 	readProfilerPluginResponse(ctx, readResponse.ProfilerPluginResponse, &state, &state, &resp.Diagnostics)
@@ -204,6 +216,7 @@ func (r *profilerPluginResource) Create(ctx context.Context, req resource.Create
 
 		// Read the response
 		readProfilerPluginResponse(ctx, updateResponse.ProfilerPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -228,7 +241,12 @@ func (r *profilerPluginResource) Read(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Profiler Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Profiler Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Profiler Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -238,6 +256,13 @@ func (r *profilerPluginResource) Read(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ProfilerPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Profiler Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readProfilerPluginResponse(ctx, readResponse.ProfilerPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -286,6 +311,7 @@ func (r *profilerPluginResource) Update(ctx context.Context, req resource.Update
 
 		// Read the response
 		readProfilerPluginResponse(ctx, updateResponse.ProfilerPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -309,4 +335,5 @@ func (r *profilerPluginResource) Delete(ctx context.Context, req resource.Delete
 func (r *profilerPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &purgeExpiredDataPluginResource{}
 	_ resource.ResourceWithConfigure   = &purgeExpiredDataPluginResource{}
 	_ resource.ResourceWithImportState = &purgeExpiredDataPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &purgeExpiredDataPluginResource{}
 	_ resource.Resource                = &defaultPurgeExpiredDataPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultPurgeExpiredDataPluginResource{}
 	_ resource.ResourceWithImportState = &defaultPurgeExpiredDataPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPurgeExpiredDataPluginResource{}
 )
 
 // Create a Purge Expired Data Plugin resource
@@ -216,6 +218,15 @@ func purgeExpiredDataPluginSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *purgeExpiredDataPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPurgeExpiredDataPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPurgeExpiredDataPluginFields(ctx context.Context, addRequest *client.AddPurgeExpiredDataPluginRequest, plan purgeExpiredDataPluginResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -376,6 +387,7 @@ func (r *purgeExpiredDataPluginResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state purgeExpiredDataPluginResourceModel
 	readPurgeExpiredDataPluginResponse(ctx, addResponse.PurgeExpiredDataPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -414,6 +426,12 @@ func (r *defaultPurgeExpiredDataPluginResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PurgeExpiredDataPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Purge Expired Data Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state purgeExpiredDataPluginResourceModel
 	readPurgeExpiredDataPluginResponse(ctx, readResponse.PurgeExpiredDataPluginResponse, &state, &state, &resp.Diagnostics)
@@ -440,6 +458,7 @@ func (r *defaultPurgeExpiredDataPluginResource) Create(ctx context.Context, req
 
 		// Read the response
 		readPurgeExpiredDataPluginResponse(ctx, updateResponse.PurgeExpiredDataPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -472,7 +491,12 @@ func readPurgeExpiredDataPlugin(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Purge Expired Data Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Purge Expired Data Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Purge Expired Data Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -482,6 +506,13 @@ func readPurgeExpiredDataPlugin(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PurgeExpiredDataPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Purge Expired Data Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPurgeExpiredDataPluginResponse(ctx, readResponse.PurgeExpiredDataPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -538,6 +569,7 @@ func updatePurgeExpiredDataPlugin(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readPurgeExpiredDataPluginResponse(ctx, updateResponse.PurgeExpiredDataPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -586,4 +618,5 @@ func (r *defaultPurgeExpiredDataPluginResource) ImportState(ctx context.Context,
 func importPurgeExpiredDataPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
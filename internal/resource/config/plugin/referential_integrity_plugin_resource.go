@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &referentialIntegrityPluginResource{}
 	_ resource.ResourceWithConfigure   = &referentialIntegrityPluginResource{}
 	_ resource.ResourceWithImportState = &referentialIntegrityPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &referentialIntegrityPluginResource{}
 	_ resource.Resource                = &defaultReferentialIntegrityPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultReferentialIntegrityPluginResource{}
 	_ resource.ResourceWithImportState = &defaultReferentialIntegrityPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultReferentialIntegrityPluginResource{}
 )
 
 // Create a Referential Integrity Plugin resource
@@ -173,6 +175,15 @@ func referentialIntegrityPluginSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *referentialIntegrityPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultReferentialIntegrityPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalReferentialIntegrityPluginFields(ctx context.Context, addRequest *client.AddReferentialIntegrityPluginRequest, plan referentialIntegrityPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -292,6 +303,7 @@ func (r *referentialIntegrityPluginResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state referentialIntegrityPluginResourceModel
 	readReferentialIntegrityPluginResponse(ctx, addResponse.ReferentialIntegrityPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -330,6 +342,12 @@ func (r *defaultReferentialIntegrityPluginResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReferentialIntegrityPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Referential Integrity Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state referentialIntegrityPluginResourceModel
 	readReferentialIntegrityPluginResponse(ctx, readResponse.ReferentialIntegrityPluginResponse, &state, &state, &resp.Diagnostics)
@@ -356,6 +374,7 @@ func (r *defaultReferentialIntegrityPluginResource) Create(ctx context.Context,
 
 		// Read the response
 		readReferentialIntegrityPluginResponse(ctx, updateResponse.ReferentialIntegrityPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -388,7 +407,12 @@ func readReferentialIntegrityPlugin(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Referential Integrity Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Referential Integrity Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Referential Integrity Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -398,6 +422,13 @@ func readReferentialIntegrityPlugin(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReferentialIntegrityPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Referential Integrity Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readReferentialIntegrityPluginResponse(ctx, readResponse.ReferentialIntegrityPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -454,6 +485,7 @@ func updateReferentialIntegrityPlugin(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readReferentialIntegrityPluginResponse(ctx, updateResponse.ReferentialIntegrityPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -502,4 +534,5 @@ func (r *defaultReferentialIntegrityPluginResource) ImportState(ctx context.Cont
 func importReferentialIntegrityPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &referralOnUpdatePluginResource{}
 	_ resource.ResourceWithConfigure   = &referralOnUpdatePluginResource{}
 	_ resource.ResourceWithImportState = &referralOnUpdatePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &referralOnUpdatePluginResource{}
 	_ resource.Resource                = &defaultReferralOnUpdatePluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultReferralOnUpdatePluginResource{}
 	_ resource.ResourceWithImportState = &defaultReferralOnUpdatePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultReferralOnUpdatePluginResource{}
 )
 
 // Create a Referral On Update Plugin resource
@@ -154,6 +156,15 @@ func referralOnUpdatePluginSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *referralOnUpdatePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultReferralOnUpdatePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalReferralOnUpdatePluginFields(ctx context.Context, addRequest *client.AddReferralOnUpdatePluginRequest, plan referralOnUpdatePluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -257,6 +268,7 @@ func (r *referralOnUpdatePluginResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state referralOnUpdatePluginResourceModel
 	readReferralOnUpdatePluginResponse(ctx, addResponse.ReferralOnUpdatePluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -295,6 +307,12 @@ func (r *defaultReferralOnUpdatePluginResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReferralOnUpdatePluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Referral On Update Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state referralOnUpdatePluginResourceModel
 	readReferralOnUpdatePluginResponse(ctx, readResponse.ReferralOnUpdatePluginResponse, &state, &state, &resp.Diagnostics)
@@ -321,6 +339,7 @@ func (r *defaultReferralOnUpdatePluginResource) Create(ctx context.Context, req
 
 		// Read the response
 		readReferralOnUpdatePluginResponse(ctx, updateResponse.ReferralOnUpdatePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -353,7 +372,12 @@ func readReferralOnUpdatePlugin(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Referral On Update Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Referral On Update Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Referral On Update Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -363,6 +387,13 @@ func readReferralOnUpdatePlugin(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReferralOnUpdatePluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Referral On Update Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readReferralOnUpdatePluginResponse(ctx, readResponse.ReferralOnUpdatePluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -419,6 +450,7 @@ func updateReferralOnUpdatePlugin(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readReferralOnUpdatePluginResponse(ctx, updateResponse.ReferralOnUpdatePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -467,4 +499,5 @@ func (r *defaultReferralOnUpdatePluginResource) ImportState(ctx context.Context,
 func importReferralOnUpdatePlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &searchShutdownPluginResource{}
 	_ resource.ResourceWithConfigure   = &searchShutdownPluginResource{}
 	_ resource.ResourceWithImportState = &searchShutdownPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &searchShutdownPluginResource{}
 	_ resource.Resource                = &defaultSearchShutdownPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultSearchShutdownPluginResource{}
 	_ resource.ResourceWithImportState = &defaultSearchShutdownPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSearchShutdownPluginResource{}
 )
 
 // Create a Search Shutdown Plugin resource
@@ -162,6 +164,15 @@ func searchShutdownPluginSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *searchShutdownPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSearchShutdownPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSearchShutdownPluginFields(ctx context.Context, addRequest *client.AddSearchShutdownPluginRequest, plan searchShutdownPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -261,6 +272,7 @@ func (r *searchShutdownPluginResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state searchShutdownPluginResourceModel
 	readSearchShutdownPluginResponse(ctx, addResponse.SearchShutdownPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -299,6 +311,12 @@ func (r *defaultSearchShutdownPluginResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SearchShutdownPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Search Shutdown Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state searchShutdownPluginResourceModel
 	readSearchShutdownPluginResponse(ctx, readResponse.SearchShutdownPluginResponse, &state, &state, &resp.Diagnostics)
@@ -325,6 +343,7 @@ func (r *defaultSearchShutdownPluginResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readSearchShutdownPluginResponse(ctx, updateResponse.SearchShutdownPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -357,7 +376,12 @@ func readSearchShutdownPlugin(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Search Shutdown Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Search Shutdown Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Search Shutdown Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -367,6 +391,13 @@ func readSearchShutdownPlugin(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SearchShutdownPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Search Shutdown Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSearchShutdownPluginResponse(ctx, readResponse.SearchShutdownPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -423,6 +454,7 @@ func updateSearchShutdownPlugin(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readSearchShutdownPluginResponse(ctx, updateResponse.SearchShutdownPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -471,4 +503,5 @@ func (r *defaultSearchShutdownPluginResource) ImportState(ctx context.Context, r
 func importSearchShutdownPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
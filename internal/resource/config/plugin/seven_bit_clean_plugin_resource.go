@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &sevenBitCleanPluginResource{}
 	_ resource.ResourceWithConfigure   = &sevenBitCleanPluginResource{}
 	_ resource.ResourceWithImportState = &sevenBitCleanPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &sevenBitCleanPluginResource{}
 	_ resource.Resource                = &defaultSevenBitCleanPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultSevenBitCleanPluginResource{}
 	_ resource.ResourceWithImportState = &defaultSevenBitCleanPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSevenBitCleanPluginResource{}
 )
 
 // Create a Seven Bit Clean Plugin resource
@@ -158,6 +160,15 @@ func sevenBitCleanPluginSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *sevenBitCleanPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSevenBitCleanPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSevenBitCleanPluginFields(ctx context.Context, addRequest *client.AddSevenBitCleanPluginRequest, plan sevenBitCleanPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -263,6 +274,7 @@ func (r *sevenBitCleanPluginResource) Create(ctx context.Context, req resource.C
 	// Read the response into the state
 	var state sevenBitCleanPluginResourceModel
 	readSevenBitCleanPluginResponse(ctx, addResponse.SevenBitCleanPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -301,6 +313,12 @@ func (r *defaultSevenBitCleanPluginResource) Create(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SevenBitCleanPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Seven Bit Clean Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state sevenBitCleanPluginResourceModel
 	readSevenBitCleanPluginResponse(ctx, readResponse.SevenBitCleanPluginResponse, &state, &state, &resp.Diagnostics)
@@ -327,6 +345,7 @@ func (r *defaultSevenBitCleanPluginResource) Create(ctx context.Context, req res
 
 		// Read the response
 		readSevenBitCleanPluginResponse(ctx, updateResponse.SevenBitCleanPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -359,7 +378,12 @@ func readSevenBitCleanPlugin(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Seven Bit Clean Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Seven Bit Clean Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Seven Bit Clean Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -369,6 +393,13 @@ func readSevenBitCleanPlugin(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SevenBitCleanPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Seven Bit Clean Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSevenBitCleanPluginResponse(ctx, readResponse.SevenBitCleanPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -425,6 +456,7 @@ func updateSevenBitCleanPlugin(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readSevenBitCleanPluginResponse(ctx, updateResponse.SevenBitCleanPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -473,4 +505,5 @@ func (r *defaultSevenBitCleanPluginResource) ImportState(ctx context.Context, re
 func importSevenBitCleanPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -21,9 +21,11 @@ var (
 	_ resource.Resource                = &simpleToExternalBindPluginResource{}
 	_ resource.ResourceWithConfigure   = &simpleToExternalBindPluginResource{}
 	_ resource.ResourceWithImportState = &simpleToExternalBindPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &simpleToExternalBindPluginResource{}
 	_ resource.Resource                = &defaultSimpleToExternalBindPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultSimpleToExternalBindPluginResource{}
 	_ resource.ResourceWithImportState = &defaultSimpleToExternalBindPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSimpleToExternalBindPluginResource{}
 )
 
 // Create a Simple To External Bind Plugin resource
@@ -126,6 +128,15 @@ func simpleToExternalBindPluginSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *simpleToExternalBindPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSimpleToExternalBindPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSimpleToExternalBindPluginFields(ctx context.Context, addRequest *client.AddSimpleToExternalBindPluginRequest, plan simpleToExternalBindPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -204,6 +215,7 @@ func (r *simpleToExternalBindPluginResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state simpleToExternalBindPluginResourceModel
 	readSimpleToExternalBindPluginResponse(ctx, addResponse.SimpleToExternalBindPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -242,6 +254,12 @@ func (r *defaultSimpleToExternalBindPluginResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SimpleToExternalBindPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Simple To External Bind Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state simpleToExternalBindPluginResourceModel
 	readSimpleToExternalBindPluginResponse(ctx, readResponse.SimpleToExternalBindPluginResponse, &state, &state, &resp.Diagnostics)
@@ -268,6 +286,7 @@ func (r *defaultSimpleToExternalBindPluginResource) Create(ctx context.Context,
 
 		// Read the response
 		readSimpleToExternalBindPluginResponse(ctx, updateResponse.SimpleToExternalBindPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -300,7 +319,12 @@ func readSimpleToExternalBindPlugin(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Simple To External Bind Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Simple To External Bind Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Simple To External Bind Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -310,6 +334,13 @@ func readSimpleToExternalBindPlugin(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SimpleToExternalBindPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Simple To External Bind Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSimpleToExternalBindPluginResponse(ctx, readResponse.SimpleToExternalBindPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -366,6 +397,7 @@ func updateSimpleToExternalBindPlugin(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readSimpleToExternalBindPluginResponse(ctx, updateResponse.SimpleToExternalBindPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -414,4 +446,5 @@ func (r *defaultSimpleToExternalBindPluginResource) ImportState(ctx context.Cont
 func importSimpleToExternalBindPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &snmpSubagentPluginResource{}
 	_ resource.ResourceWithConfigure   = &snmpSubagentPluginResource{}
 	_ resource.ResourceWithImportState = &snmpSubagentPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &snmpSubagentPluginResource{}
 	_ resource.Resource                = &defaultSnmpSubagentPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultSnmpSubagentPluginResource{}
 	_ resource.ResourceWithImportState = &defaultSnmpSubagentPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSnmpSubagentPluginResource{}
 )
 
 // Create a Snmp Subagent Plugin resource
@@ -192,6 +194,15 @@ func snmpSubagentPluginSchema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *snmpSubagentPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSnmpSubagentPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSnmpSubagentPluginFields(ctx context.Context, addRequest *client.AddSnmpSubagentPluginRequest, plan snmpSubagentPluginResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -315,6 +326,7 @@ func (r *snmpSubagentPluginResource) Create(ctx context.Context, req resource.Cr
 	// Read the response into the state
 	var state snmpSubagentPluginResourceModel
 	readSnmpSubagentPluginResponse(ctx, addResponse.SnmpSubagentPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -353,6 +365,12 @@ func (r *defaultSnmpSubagentPluginResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SnmpSubagentPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Snmp Subagent Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state snmpSubagentPluginResourceModel
 	readSnmpSubagentPluginResponse(ctx, readResponse.SnmpSubagentPluginResponse, &state, &state, &resp.Diagnostics)
@@ -379,6 +397,7 @@ func (r *defaultSnmpSubagentPluginResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readSnmpSubagentPluginResponse(ctx, updateResponse.SnmpSubagentPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -411,7 +430,12 @@ func readSnmpSubagentPlugin(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Snmp Subagent Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Snmp Subagent Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Snmp Subagent Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -421,6 +445,13 @@ func readSnmpSubagentPlugin(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SnmpSubagentPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Snmp Subagent Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSnmpSubagentPluginResponse(ctx, readResponse.SnmpSubagentPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -477,6 +508,7 @@ func updateSnmpSubagentPlugin(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readSnmpSubagentPluginResponse(ctx, updateResponse.SnmpSubagentPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -525,4 +557,5 @@ func (r *defaultSnmpSubagentPluginResource) ImportState(ctx context.Context, req
 func importSnmpSubagentPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &statsCollectorPluginResource{}
 	_ resource.ResourceWithConfigure   = &statsCollectorPluginResource{}
 	_ resource.ResourceWithImportState = &statsCollectorPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &statsCollectorPluginResource{}
 )
 
 // Create a Stats Collector Plugin resource
@@ -209,6 +210,11 @@ func (r *statsCollectorPluginResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *statsCollectorPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a StatsCollectorPluginResponse object into the model struct
 func readStatsCollectorPluginResponse(ctx context.Context, r *client.StatsCollectorPluginResponse, state *statsCollectorPluginResourceModel, expectedValues *statsCollectorPluginResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -290,6 +296,12 @@ func (r *statsCollectorPluginResource) Create(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.StatsCollectorPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Stats Collector Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state statsCollectorPluginResourceModel
 	readStatsCollectorPluginResponse(ctx, readResponse.StatsCollectorPluginResponse, &state, &state, &resp.Diagnostics)
@@ -316,6 +328,7 @@ func (r *statsCollectorPluginResource) Create(ctx context.Context, req resource.
 
 		// Read the response
 		readStatsCollectorPluginResponse(ctx, updateResponse.StatsCollectorPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -340,7 +353,12 @@ func (r *statsCollectorPluginResource) Read(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := r.apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Stats Collector Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Stats Collector Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Stats Collector Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -350,6 +368,13 @@ func (r *statsCollectorPluginResource) Read(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.StatsCollectorPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Stats Collector Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readStatsCollectorPluginResponse(ctx, readResponse.StatsCollectorPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -398,6 +423,7 @@ func (r *statsCollectorPluginResource) Update(ctx context.Context, req resource.
 
 		// Read the response
 		readStatsCollectorPluginResponse(ctx, updateResponse.StatsCollectorPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -421,4 +447,5 @@ func (r *statsCollectorPluginResource) Delete(ctx context.Context, req resource.
 func (r *statsCollectorPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
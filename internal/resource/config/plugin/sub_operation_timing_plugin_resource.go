@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &subOperationTimingPluginResource{}
 	_ resource.ResourceWithConfigure   = &subOperationTimingPluginResource{}
 	_ resource.ResourceWithImportState = &subOperationTimingPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &subOperationTimingPluginResource{}
 	_ resource.Resource                = &defaultSubOperationTimingPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultSubOperationTimingPluginResource{}
 	_ resource.ResourceWithImportState = &defaultSubOperationTimingPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSubOperationTimingPluginResource{}
 )
 
 // Create a Sub Operation Timing Plugin resource
@@ -153,6 +155,15 @@ func subOperationTimingPluginSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *subOperationTimingPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSubOperationTimingPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSubOperationTimingPluginFields(ctx context.Context, addRequest *client.AddSubOperationTimingPluginRequest, plan subOperationTimingPluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -257,6 +268,7 @@ func (r *subOperationTimingPluginResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state subOperationTimingPluginResourceModel
 	readSubOperationTimingPluginResponse(ctx, addResponse.SubOperationTimingPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -295,6 +307,12 @@ func (r *defaultSubOperationTimingPluginResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SubOperationTimingPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Sub Operation Timing Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state subOperationTimingPluginResourceModel
 	readSubOperationTimingPluginResponse(ctx, readResponse.SubOperationTimingPluginResponse, &state, &state, &resp.Diagnostics)
@@ -321,6 +339,7 @@ func (r *defaultSubOperationTimingPluginResource) Create(ctx context.Context, re
 
 		// Read the response
 		readSubOperationTimingPluginResponse(ctx, updateResponse.SubOperationTimingPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -353,7 +372,12 @@ func readSubOperationTimingPlugin(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Sub Operation Timing Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Sub Operation Timing Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Sub Operation Timing Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -363,6 +387,13 @@ func readSubOperationTimingPlugin(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SubOperationTimingPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Sub Operation Timing Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSubOperationTimingPluginResponse(ctx, readResponse.SubOperationTimingPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -419,6 +450,7 @@ func updateSubOperationTimingPlugin(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readSubOperationTimingPluginResponse(ctx, updateResponse.SubOperationTimingPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -467,4 +499,5 @@ func (r *defaultSubOperationTimingPluginResource) ImportState(ctx context.Contex
 func importSubOperationTimingPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
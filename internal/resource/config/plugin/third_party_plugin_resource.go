@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyPluginResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyPluginResource{}
 	_ resource.ResourceWithImportState = &thirdPartyPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyPluginResource{}
 	_ resource.Resource                = &defaultThirdPartyPluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyPluginResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyPluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyPluginResource{}
 )
 
 // Create a Third Party Plugin resource
@@ -154,6 +156,15 @@ func thirdPartyPluginSchema(ctx context.Context, req resource.SchemaRequest, res
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyPluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyPluginFields(ctx context.Context, addRequest *client.AddThirdPartyPluginRequest, plan thirdPartyPluginResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -247,6 +258,7 @@ func (r *thirdPartyPluginResource) Create(ctx context.Context, req resource.Crea
 	// Read the response into the state
 	var state thirdPartyPluginResourceModel
 	readThirdPartyPluginResponse(ctx, addResponse.ThirdPartyPluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -285,6 +297,12 @@ func (r *defaultThirdPartyPluginResource) Create(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyPluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyPluginResourceModel
 	readThirdPartyPluginResponse(ctx, readResponse.ThirdPartyPluginResponse, &state, &state, &resp.Diagnostics)
@@ -311,6 +329,7 @@ func (r *defaultThirdPartyPluginResource) Create(ctx context.Context, req resour
 
 		// Read the response
 		readThirdPartyPluginResponse(ctx, updateResponse.ThirdPartyPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -343,7 +362,12 @@ func readThirdPartyPlugin(ctx context.Context, req resource.ReadRequest, resp *r
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -353,6 +377,13 @@ func readThirdPartyPlugin(ctx context.Context, req resource.ReadRequest, resp *r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyPluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyPluginResponse(ctx, readResponse.ThirdPartyPluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -409,6 +440,7 @@ func updateThirdPartyPlugin(ctx context.Context, req resource.UpdateRequest, res
 
 		// Read the response
 		readThirdPartyPluginResponse(ctx, updateResponse.ThirdPartyPluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -457,4 +489,5 @@ func (r *defaultThirdPartyPluginResource) ImportState(ctx context.Context, req r
 func importThirdPartyPlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &uniqueAttributePluginResource{}
 	_ resource.ResourceWithConfigure   = &uniqueAttributePluginResource{}
 	_ resource.ResourceWithImportState = &uniqueAttributePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &uniqueAttributePluginResource{}
 	_ resource.Resource                = &defaultUniqueAttributePluginResource{}
 	_ resource.ResourceWithConfigure   = &defaultUniqueAttributePluginResource{}
 	_ resource.ResourceWithImportState = &defaultUniqueAttributePluginResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultUniqueAttributePluginResource{}
 )
 
 // Create a Unique Attribute Plugin resource
@@ -178,6 +180,15 @@ func uniqueAttributePluginSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *uniqueAttributePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultUniqueAttributePluginResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalUniqueAttributePluginFields(ctx context.Context, addRequest *client.AddUniqueAttributePluginRequest, plan uniqueAttributePluginResourceModel) error {
 	if internaltypes.IsDefined(plan.PluginType) {
@@ -305,6 +316,7 @@ func (r *uniqueAttributePluginResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state uniqueAttributePluginResourceModel
 	readUniqueAttributePluginResponse(ctx, addResponse.UniqueAttributePluginResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -343,6 +355,12 @@ func (r *defaultUniqueAttributePluginResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.UniqueAttributePluginResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Unique Attribute Plugin", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state uniqueAttributePluginResourceModel
 	readUniqueAttributePluginResponse(ctx, readResponse.UniqueAttributePluginResponse, &state, &state, &resp.Diagnostics)
@@ -369,6 +387,7 @@ func (r *defaultUniqueAttributePluginResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readUniqueAttributePluginResponse(ctx, updateResponse.UniqueAttributePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -401,7 +420,12 @@ func readUniqueAttributePlugin(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.PluginApi.GetPlugin(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Unique Attribute Plugin", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Unique Attribute Plugin", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Unique Attribute Plugin", err, httpResp)
+		}
 		return
 	}
 
@@ -411,6 +435,13 @@ func readUniqueAttributePlugin(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.UniqueAttributePluginResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Unique Attribute Plugin", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readUniqueAttributePluginResponse(ctx, readResponse.UniqueAttributePluginResponse, &state, &state, &resp.Diagnostics)
 
@@ -467,6 +498,7 @@ func updateUniqueAttributePlugin(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readUniqueAttributePluginResponse(ctx, updateResponse.UniqueAttributePluginResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -515,4 +547,5 @@ func (r *defaultUniqueAttributePluginResource) ImportState(ctx context.Context,
 func importUniqueAttributePlugin(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
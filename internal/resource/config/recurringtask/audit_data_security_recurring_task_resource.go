@@ -240,12 +240,17 @@ func auditDataSecurityRecurringTaskSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
-// Validate that any version restrictions are met in the plan
+// Plan a replacement if the config object has been replaced with one of a different type, record the planned
+// id for any Recurring Task Chains that reference it, and validate that any version restrictions are met in the plan
 func (r *auditDataSecurityRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
 	modifyPlanAuditDataSecurityRecurringTask(ctx, req, resp, r.apiClient, r.providerConfig, "pingdirectory_audit_data_security_recurring_task")
 }
 
 func (r *defaultAuditDataSecurityRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
 	modifyPlanAuditDataSecurityRecurringTask(ctx, req, resp, r.apiClient, r.providerConfig, "pingdirectory_default_audit_data_security_recurring_task")
 }
 
@@ -403,6 +408,7 @@ func (r *auditDataSecurityRecurringTaskResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state auditDataSecurityRecurringTaskResourceModel
 	readAuditDataSecurityRecurringTaskResponse(ctx, addResponse.AuditDataSecurityRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -441,6 +447,12 @@ func (r *defaultAuditDataSecurityRecurringTaskResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AuditDataSecurityRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Audit Data Security Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state auditDataSecurityRecurringTaskResourceModel
 	readAuditDataSecurityRecurringTaskResponse(ctx, readResponse.AuditDataSecurityRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -467,6 +479,7 @@ func (r *defaultAuditDataSecurityRecurringTaskResource) Create(ctx context.Conte
 
 		// Read the response
 		readAuditDataSecurityRecurringTaskResponse(ctx, updateResponse.AuditDataSecurityRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -499,7 +512,12 @@ func readAuditDataSecurityRecurringTask(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Audit Data Security Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Audit Data Security Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Audit Data Security Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -509,6 +527,13 @@ func readAuditDataSecurityRecurringTask(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AuditDataSecurityRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Audit Data Security Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAuditDataSecurityRecurringTaskResponse(ctx, readResponse.AuditDataSecurityRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -565,6 +590,7 @@ func updateAuditDataSecurityRecurringTask(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readAuditDataSecurityRecurringTaskResponse(ctx, updateResponse.AuditDataSecurityRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -613,4 +639,5 @@ func (r *defaultAuditDataSecurityRecurringTaskResource) ImportState(ctx context.
 func importAuditDataSecurityRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &backupRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &backupRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &backupRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &backupRecurringTaskResource{}
 	_ resource.Resource                = &defaultBackupRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultBackupRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultBackupRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultBackupRecurringTaskResource{}
 )
 
 // Create a Backup Recurring Task resource
@@ -270,6 +272,18 @@ func backupRecurringTaskSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *backupRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultBackupRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalBackupRecurringTaskFields(ctx context.Context, addRequest *client.AddBackupRecurringTaskRequest, plan backupRecurringTaskResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -443,6 +457,7 @@ func (r *backupRecurringTaskResource) Create(ctx context.Context, req resource.C
 	// Read the response into the state
 	var state backupRecurringTaskResourceModel
 	readBackupRecurringTaskResponse(ctx, addResponse.BackupRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -481,6 +496,12 @@ func (r *defaultBackupRecurringTaskResource) Create(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.BackupRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Backup Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state backupRecurringTaskResourceModel
 	readBackupRecurringTaskResponse(ctx, readResponse.BackupRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -507,6 +528,7 @@ func (r *defaultBackupRecurringTaskResource) Create(ctx context.Context, req res
 
 		// Read the response
 		readBackupRecurringTaskResponse(ctx, updateResponse.BackupRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -539,7 +561,12 @@ func readBackupRecurringTask(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Backup Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Backup Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Backup Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -549,6 +576,13 @@ func readBackupRecurringTask(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.BackupRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Backup Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readBackupRecurringTaskResponse(ctx, readResponse.BackupRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -605,6 +639,7 @@ func updateBackupRecurringTask(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readBackupRecurringTaskResponse(ctx, updateResponse.BackupRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -653,4 +688,5 @@ func (r *defaultBackupRecurringTaskResource) ImportState(ctx context.Context, re
 func importBackupRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
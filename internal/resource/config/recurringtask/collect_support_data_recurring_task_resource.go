@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &collectSupportDataRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &collectSupportDataRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &collectSupportDataRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &collectSupportDataRecurringTaskResource{}
 	_ resource.Resource                = &defaultCollectSupportDataRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultCollectSupportDataRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultCollectSupportDataRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultCollectSupportDataRecurringTaskResource{}
 )
 
 // Create a Collect Support Data Recurring Task resource
@@ -323,6 +325,18 @@ func collectSupportDataRecurringTaskSchema(ctx context.Context, req resource.Sch
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *collectSupportDataRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultCollectSupportDataRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalCollectSupportDataRecurringTaskFields(ctx context.Context, addRequest *client.AddCollectSupportDataRecurringTaskRequest, plan collectSupportDataRecurringTaskResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -552,6 +566,7 @@ func (r *collectSupportDataRecurringTaskResource) Create(ctx context.Context, re
 	// Read the response into the state
 	var state collectSupportDataRecurringTaskResourceModel
 	readCollectSupportDataRecurringTaskResponse(ctx, addResponse.CollectSupportDataRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -590,6 +605,12 @@ func (r *defaultCollectSupportDataRecurringTaskResource) Create(ctx context.Cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CollectSupportDataRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Collect Support Data Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state collectSupportDataRecurringTaskResourceModel
 	readCollectSupportDataRecurringTaskResponse(ctx, readResponse.CollectSupportDataRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -616,6 +637,7 @@ func (r *defaultCollectSupportDataRecurringTaskResource) Create(ctx context.Cont
 
 		// Read the response
 		readCollectSupportDataRecurringTaskResponse(ctx, updateResponse.CollectSupportDataRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -648,7 +670,12 @@ func readCollectSupportDataRecurringTask(ctx context.Context, req resource.ReadR
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Collect Support Data Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Collect Support Data Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Collect Support Data Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -658,6 +685,13 @@ func readCollectSupportDataRecurringTask(ctx context.Context, req resource.ReadR
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CollectSupportDataRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Collect Support Data Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCollectSupportDataRecurringTaskResponse(ctx, readResponse.CollectSupportDataRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -714,6 +748,7 @@ func updateCollectSupportDataRecurringTask(ctx context.Context, req resource.Upd
 
 		// Read the response
 		readCollectSupportDataRecurringTaskResponse(ctx, updateResponse.CollectSupportDataRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -762,4 +797,5 @@ func (r *defaultCollectSupportDataRecurringTaskResource) ImportState(ctx context
 func importCollectSupportDataRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
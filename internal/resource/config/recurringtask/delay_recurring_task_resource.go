@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &delayRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &delayRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &delayRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &delayRecurringTaskResource{}
 	_ resource.Resource                = &defaultDelayRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultDelayRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultDelayRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDelayRecurringTaskResource{}
 )
 
 // Create a Delay Recurring Task resource
@@ -225,6 +227,18 @@ func delayRecurringTaskSchema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *delayRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultDelayRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalDelayRecurringTaskFields(ctx context.Context, addRequest *client.AddDelayRecurringTaskRequest, plan delayRecurringTaskResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -399,6 +413,7 @@ func (r *delayRecurringTaskResource) Create(ctx context.Context, req resource.Cr
 	// Read the response into the state
 	var state delayRecurringTaskResourceModel
 	readDelayRecurringTaskResponse(ctx, addResponse.DelayRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -437,6 +452,12 @@ func (r *defaultDelayRecurringTaskResource) Create(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DelayRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Delay Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state delayRecurringTaskResourceModel
 	readDelayRecurringTaskResponse(ctx, readResponse.DelayRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -463,6 +484,7 @@ func (r *defaultDelayRecurringTaskResource) Create(ctx context.Context, req reso
 
 		// Read the response
 		readDelayRecurringTaskResponse(ctx, updateResponse.DelayRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -495,7 +517,12 @@ func readDelayRecurringTask(ctx context.Context, req resource.ReadRequest, resp
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delay Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Delay Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Delay Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -505,6 +532,13 @@ func readDelayRecurringTask(ctx context.Context, req resource.ReadRequest, resp
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DelayRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Delay Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDelayRecurringTaskResponse(ctx, readResponse.DelayRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -561,6 +595,7 @@ func updateDelayRecurringTask(ctx context.Context, req resource.UpdateRequest, r
 
 		// Read the response
 		readDelayRecurringTaskResponse(ctx, updateResponse.DelayRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -609,4 +644,5 @@ func (r *defaultDelayRecurringTaskResource) ImportState(ctx context.Context, req
 func importDelayRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
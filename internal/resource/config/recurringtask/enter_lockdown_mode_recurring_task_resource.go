@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &enterLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &enterLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &enterLockdownModeRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &enterLockdownModeRecurringTaskResource{}
 	_ resource.Resource                = &defaultEnterLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultEnterLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultEnterLockdownModeRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultEnterLockdownModeRecurringTaskResource{}
 )
 
 // Create a Enter Lockdown Mode Recurring Task resource
@@ -185,6 +187,18 @@ func enterLockdownModeRecurringTaskSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *enterLockdownModeRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultEnterLockdownModeRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalEnterLockdownModeRecurringTaskFields(ctx context.Context, addRequest *client.AddEnterLockdownModeRecurringTaskRequest, plan enterLockdownModeRecurringTaskResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -298,6 +312,7 @@ func (r *enterLockdownModeRecurringTaskResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state enterLockdownModeRecurringTaskResourceModel
 	readEnterLockdownModeRecurringTaskResponse(ctx, addResponse.EnterLockdownModeRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -336,6 +351,12 @@ func (r *defaultEnterLockdownModeRecurringTaskResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EnterLockdownModeRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Enter Lockdown Mode Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state enterLockdownModeRecurringTaskResourceModel
 	readEnterLockdownModeRecurringTaskResponse(ctx, readResponse.EnterLockdownModeRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -362,6 +383,7 @@ func (r *defaultEnterLockdownModeRecurringTaskResource) Create(ctx context.Conte
 
 		// Read the response
 		readEnterLockdownModeRecurringTaskResponse(ctx, updateResponse.EnterLockdownModeRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -394,7 +416,12 @@ func readEnterLockdownModeRecurringTask(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Enter Lockdown Mode Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Enter Lockdown Mode Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Enter Lockdown Mode Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -404,6 +431,13 @@ func readEnterLockdownModeRecurringTask(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EnterLockdownModeRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Enter Lockdown Mode Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readEnterLockdownModeRecurringTaskResponse(ctx, readResponse.EnterLockdownModeRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -460,6 +494,7 @@ func updateEnterLockdownModeRecurringTask(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readEnterLockdownModeRecurringTaskResponse(ctx, updateResponse.EnterLockdownModeRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -508,4 +543,5 @@ func (r *defaultEnterLockdownModeRecurringTaskResource) ImportState(ctx context.
 func importEnterLockdownModeRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &execRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &execRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &execRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &execRecurringTaskResource{}
 	_ resource.Resource                = &defaultExecRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultExecRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultExecRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultExecRecurringTaskResource{}
 )
 
 // Create a Exec Recurring Task resource
@@ -242,6 +244,18 @@ func execRecurringTaskSchema(ctx context.Context, req resource.SchemaRequest, re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *execRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultExecRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalExecRecurringTaskFields(ctx context.Context, addRequest *client.AddExecRecurringTaskRequest, plan execRecurringTaskResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -409,6 +423,7 @@ func (r *execRecurringTaskResource) Create(ctx context.Context, req resource.Cre
 	// Read the response into the state
 	var state execRecurringTaskResourceModel
 	readExecRecurringTaskResponse(ctx, addResponse.ExecRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -447,6 +462,12 @@ func (r *defaultExecRecurringTaskResource) Create(ctx context.Context, req resou
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ExecRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Exec Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state execRecurringTaskResourceModel
 	readExecRecurringTaskResponse(ctx, readResponse.ExecRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -473,6 +494,7 @@ func (r *defaultExecRecurringTaskResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readExecRecurringTaskResponse(ctx, updateResponse.ExecRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -505,7 +527,12 @@ func readExecRecurringTask(ctx context.Context, req resource.ReadRequest, resp *
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Exec Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Exec Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Exec Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -515,6 +542,13 @@ func readExecRecurringTask(ctx context.Context, req resource.ReadRequest, resp *
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ExecRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Exec Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readExecRecurringTaskResponse(ctx, readResponse.ExecRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -571,6 +605,7 @@ func updateExecRecurringTask(ctx context.Context, req resource.UpdateRequest, re
 
 		// Read the response
 		readExecRecurringTaskResponse(ctx, updateResponse.ExecRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -619,4 +654,5 @@ func (r *defaultExecRecurringTaskResource) ImportState(ctx context.Context, req
 func importExecRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &fileRetentionRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &fileRetentionRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &fileRetentionRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &fileRetentionRecurringTaskResource{}
 	_ resource.Resource                = &defaultFileRetentionRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileRetentionRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultFileRetentionRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileRetentionRecurringTaskResource{}
 )
 
 // Create a File Retention Recurring Task resource
@@ -210,6 +212,18 @@ func fileRetentionRecurringTaskSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *fileRetentionRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultFileRetentionRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalFileRetentionRecurringTaskFields(ctx context.Context, addRequest *client.AddFileRetentionRecurringTaskRequest, plan fileRetentionRecurringTaskResourceModel) {
 	if internaltypes.IsDefined(plan.RetainFileCount) {
@@ -354,6 +368,7 @@ func (r *fileRetentionRecurringTaskResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state fileRetentionRecurringTaskResourceModel
 	readFileRetentionRecurringTaskResponse(ctx, addResponse.FileRetentionRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -392,6 +407,12 @@ func (r *defaultFileRetentionRecurringTaskResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileRetentionRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Retention Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileRetentionRecurringTaskResourceModel
 	readFileRetentionRecurringTaskResponse(ctx, readResponse.FileRetentionRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -418,6 +439,7 @@ func (r *defaultFileRetentionRecurringTaskResource) Create(ctx context.Context,
 
 		// Read the response
 		readFileRetentionRecurringTaskResponse(ctx, updateResponse.FileRetentionRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -450,7 +472,12 @@ func readFileRetentionRecurringTask(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Retention Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Retention Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Retention Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -460,6 +487,13 @@ func readFileRetentionRecurringTask(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileRetentionRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Retention Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileRetentionRecurringTaskResponse(ctx, readResponse.FileRetentionRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -516,6 +550,7 @@ func updateFileRetentionRecurringTask(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readFileRetentionRecurringTaskResponse(ctx, updateResponse.FileRetentionRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -564,4 +599,5 @@ func (r *defaultFileRetentionRecurringTaskResource) ImportState(ctx context.Cont
 func importFileRetentionRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &generateServerProfileRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &generateServerProfileRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &generateServerProfileRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &generateServerProfileRecurringTaskResource{}
 	_ resource.Resource                = &defaultGenerateServerProfileRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultGenerateServerProfileRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultGenerateServerProfileRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGenerateServerProfileRecurringTaskResource{}
 )
 
 // Create a Generate Server Profile Recurring Task resource
@@ -215,6 +217,18 @@ func generateServerProfileRecurringTaskSchema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *generateServerProfileRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultGenerateServerProfileRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalGenerateServerProfileRecurringTaskFields(ctx context.Context, addRequest *client.AddGenerateServerProfileRecurringTaskRequest, plan generateServerProfileRecurringTaskResourceModel) {
 	if internaltypes.IsDefined(plan.IncludePath) {
@@ -346,6 +360,7 @@ func (r *generateServerProfileRecurringTaskResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state generateServerProfileRecurringTaskResourceModel
 	readGenerateServerProfileRecurringTaskResponse(ctx, addResponse.GenerateServerProfileRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -384,6 +399,12 @@ func (r *defaultGenerateServerProfileRecurringTaskResource) Create(ctx context.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GenerateServerProfileRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Generate Server Profile Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state generateServerProfileRecurringTaskResourceModel
 	readGenerateServerProfileRecurringTaskResponse(ctx, readResponse.GenerateServerProfileRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -410,6 +431,7 @@ func (r *defaultGenerateServerProfileRecurringTaskResource) Create(ctx context.C
 
 		// Read the response
 		readGenerateServerProfileRecurringTaskResponse(ctx, updateResponse.GenerateServerProfileRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -442,7 +464,12 @@ func readGenerateServerProfileRecurringTask(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Generate Server Profile Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Generate Server Profile Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Generate Server Profile Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -452,6 +479,13 @@ func readGenerateServerProfileRecurringTask(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GenerateServerProfileRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Generate Server Profile Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGenerateServerProfileRecurringTaskResponse(ctx, readResponse.GenerateServerProfileRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -508,6 +542,7 @@ func updateGenerateServerProfileRecurringTask(ctx context.Context, req resource.
 
 		// Read the response
 		readGenerateServerProfileRecurringTaskResponse(ctx, updateResponse.GenerateServerProfileRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -556,4 +591,5 @@ func (r *defaultGenerateServerProfileRecurringTaskResource) ImportState(ctx cont
 func importGenerateServerProfileRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &ldifExportRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &ldifExportRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &ldifExportRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &ldifExportRecurringTaskResource{}
 	_ resource.Resource                = &defaultLdifExportRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultLdifExportRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultLdifExportRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLdifExportRecurringTaskResource{}
 )
 
 // Create a Ldif Export Recurring Task resource
@@ -270,6 +272,18 @@ func ldifExportRecurringTaskSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *ldifExportRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultLdifExportRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalLdifExportRecurringTaskFields(ctx context.Context, addRequest *client.AddLdifExportRecurringTaskRequest, plan ldifExportRecurringTaskResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -443,6 +457,7 @@ func (r *ldifExportRecurringTaskResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state ldifExportRecurringTaskResourceModel
 	readLdifExportRecurringTaskResponse(ctx, addResponse.LdifExportRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -481,6 +496,12 @@ func (r *defaultLdifExportRecurringTaskResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdifExportRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Ldif Export Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state ldifExportRecurringTaskResourceModel
 	readLdifExportRecurringTaskResponse(ctx, readResponse.LdifExportRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -507,6 +528,7 @@ func (r *defaultLdifExportRecurringTaskResource) Create(ctx context.Context, req
 
 		// Read the response
 		readLdifExportRecurringTaskResponse(ctx, updateResponse.LdifExportRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -539,7 +561,12 @@ func readLdifExportRecurringTask(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldif Export Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Ldif Export Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Ldif Export Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -549,6 +576,13 @@ func readLdifExportRecurringTask(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LdifExportRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Ldif Export Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLdifExportRecurringTaskResponse(ctx, readResponse.LdifExportRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -605,6 +639,7 @@ func updateLdifExportRecurringTask(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readLdifExportRecurringTaskResponse(ctx, updateResponse.LdifExportRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -653,4 +688,5 @@ func (r *defaultLdifExportRecurringTaskResource) ImportState(ctx context.Context
 func importLdifExportRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &leaveLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &leaveLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &leaveLockdownModeRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &leaveLockdownModeRecurringTaskResource{}
 	_ resource.Resource                = &defaultLeaveLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultLeaveLockdownModeRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultLeaveLockdownModeRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultLeaveLockdownModeRecurringTaskResource{}
 )
 
 // Create a Leave Lockdown Mode Recurring Task resource
@@ -185,6 +187,18 @@ func leaveLockdownModeRecurringTaskSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *leaveLockdownModeRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultLeaveLockdownModeRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalLeaveLockdownModeRecurringTaskFields(ctx context.Context, addRequest *client.AddLeaveLockdownModeRecurringTaskRequest, plan leaveLockdownModeRecurringTaskResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -298,6 +312,7 @@ func (r *leaveLockdownModeRecurringTaskResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state leaveLockdownModeRecurringTaskResourceModel
 	readLeaveLockdownModeRecurringTaskResponse(ctx, addResponse.LeaveLockdownModeRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -336,6 +351,12 @@ func (r *defaultLeaveLockdownModeRecurringTaskResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LeaveLockdownModeRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Leave Lockdown Mode Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state leaveLockdownModeRecurringTaskResourceModel
 	readLeaveLockdownModeRecurringTaskResponse(ctx, readResponse.LeaveLockdownModeRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -362,6 +383,7 @@ func (r *defaultLeaveLockdownModeRecurringTaskResource) Create(ctx context.Conte
 
 		// Read the response
 		readLeaveLockdownModeRecurringTaskResponse(ctx, updateResponse.LeaveLockdownModeRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -394,7 +416,12 @@ func readLeaveLockdownModeRecurringTask(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Leave Lockdown Mode Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Leave Lockdown Mode Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Leave Lockdown Mode Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -404,6 +431,13 @@ func readLeaveLockdownModeRecurringTask(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.LeaveLockdownModeRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Leave Lockdown Mode Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readLeaveLockdownModeRecurringTaskResponse(ctx, readResponse.LeaveLockdownModeRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -460,6 +494,7 @@ func updateLeaveLockdownModeRecurringTask(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readLeaveLockdownModeRecurringTaskResponse(ctx, updateResponse.LeaveLockdownModeRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -508,4 +543,5 @@ func (r *defaultLeaveLockdownModeRecurringTaskResource) ImportState(ctx context.
 func importLeaveLockdownModeRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &staticallyDefinedRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &staticallyDefinedRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &staticallyDefinedRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &staticallyDefinedRecurringTaskResource{}
 	_ resource.Resource                = &defaultStaticallyDefinedRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultStaticallyDefinedRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultStaticallyDefinedRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultStaticallyDefinedRecurringTaskResource{}
 )
 
 // Create a Statically Defined Recurring Task resource
@@ -201,6 +203,18 @@ func staticallyDefinedRecurringTaskSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *staticallyDefinedRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultStaticallyDefinedRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalStaticallyDefinedRecurringTaskFields(ctx context.Context, addRequest *client.AddStaticallyDefinedRecurringTaskRequest, plan staticallyDefinedRecurringTaskResourceModel) {
 	if internaltypes.IsDefined(plan.TaskAttributeValue) {
@@ -322,6 +336,7 @@ func (r *staticallyDefinedRecurringTaskResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state staticallyDefinedRecurringTaskResourceModel
 	readStaticallyDefinedRecurringTaskResponse(ctx, addResponse.StaticallyDefinedRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -360,6 +375,12 @@ func (r *defaultStaticallyDefinedRecurringTaskResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.StaticallyDefinedRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Statically Defined Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state staticallyDefinedRecurringTaskResourceModel
 	readStaticallyDefinedRecurringTaskResponse(ctx, readResponse.StaticallyDefinedRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -386,6 +407,7 @@ func (r *defaultStaticallyDefinedRecurringTaskResource) Create(ctx context.Conte
 
 		// Read the response
 		readStaticallyDefinedRecurringTaskResponse(ctx, updateResponse.StaticallyDefinedRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -418,7 +440,12 @@ func readStaticallyDefinedRecurringTask(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Statically Defined Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Statically Defined Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Statically Defined Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -428,6 +455,13 @@ func readStaticallyDefinedRecurringTask(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.StaticallyDefinedRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Statically Defined Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readStaticallyDefinedRecurringTaskResponse(ctx, readResponse.StaticallyDefinedRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -484,6 +518,7 @@ func updateStaticallyDefinedRecurringTask(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readStaticallyDefinedRecurringTaskResponse(ctx, updateResponse.StaticallyDefinedRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -532,4 +567,5 @@ func (r *defaultStaticallyDefinedRecurringTaskResource) ImportState(ctx context.
 func importStaticallyDefinedRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &thirdPartyRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyRecurringTaskResource{}
 	_ resource.Resource                = &defaultThirdPartyRecurringTaskResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyRecurringTaskResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyRecurringTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyRecurringTaskResource{}
 )
 
 // Create a Third Party Recurring Task resource
@@ -195,6 +197,18 @@ func thirdPartyRecurringTaskSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type, and record the
+// planned id for any Recurring Task Chains that reference it
+func (r *thirdPartyRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
+func (r *defaultThirdPartyRecurringTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+	config.AddPlannedRecurringTask(ctx, req, r.providerConfig)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyRecurringTaskFields(ctx context.Context, addRequest *client.AddThirdPartyRecurringTaskRequest, plan thirdPartyRecurringTaskResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -311,6 +325,7 @@ func (r *thirdPartyRecurringTaskResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state thirdPartyRecurringTaskResourceModel
 	readThirdPartyRecurringTaskResponse(ctx, addResponse.ThirdPartyRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -349,6 +364,12 @@ func (r *defaultThirdPartyRecurringTaskResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Recurring Task", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyRecurringTaskResourceModel
 	readThirdPartyRecurringTaskResponse(ctx, readResponse.ThirdPartyRecurringTaskResponse, &state, &state, &resp.Diagnostics)
@@ -375,6 +396,7 @@ func (r *defaultThirdPartyRecurringTaskResource) Create(ctx context.Context, req
 
 		// Read the response
 		readThirdPartyRecurringTaskResponse(ctx, updateResponse.ThirdPartyRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -407,7 +429,12 @@ func readThirdPartyRecurringTask(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.RecurringTaskApi.GetRecurringTask(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Recurring Task", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Recurring Task", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Recurring Task", err, httpResp)
+		}
 		return
 	}
 
@@ -417,6 +444,13 @@ func readThirdPartyRecurringTask(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyRecurringTaskResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Recurring Task", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyRecurringTaskResponse(ctx, readResponse.ThirdPartyRecurringTaskResponse, &state, &state, &resp.Diagnostics)
 
@@ -473,6 +507,7 @@ func updateThirdPartyRecurringTask(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readThirdPartyRecurringTaskResponse(ctx, updateResponse.ThirdPartyRecurringTaskResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -521,4 +556,5 @@ func (r *defaultThirdPartyRecurringTaskResource) ImportState(ctx context.Context
 func importThirdPartyRecurringTask(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
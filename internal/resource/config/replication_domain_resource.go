@@ -0,0 +1,381 @@
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingdirectory/internal/operations"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &replicationDomainResource{}
+	_ resource.ResourceWithConfigure   = &replicationDomainResource{}
+	_ resource.ResourceWithImportState = &replicationDomainResource{}
+	_ resource.ResourceWithModifyPlan  = &replicationDomainResource{}
+)
+
+// Create a Replication Domain resource
+func NewReplicationDomainResource() resource.Resource {
+	return &replicationDomainResource{}
+}
+
+// replicationDomainResource is the resource implementation.
+type replicationDomainResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *replicationDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_replication_domain"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *replicationDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClientV9200
+}
+
+type replicationDomainResourceModel struct {
+	Id                                        types.String `tfsdk:"id"`
+	SynchronizationProviderName               types.String `tfsdk:"synchronization_provider_name"`
+	LastUpdated                               types.String `tfsdk:"last_updated"`
+	Notifications                             types.Set    `tfsdk:"notifications"`
+	RequiredActions                           types.Set    `tfsdk:"required_actions"`
+	ServerID                                  types.Int64  `tfsdk:"server_id"`
+	BaseDN                                    types.String `tfsdk:"base_dn"`
+	WindowSize                                types.Int64  `tfsdk:"window_size"`
+	HeartbeatInterval                         types.String `tfsdk:"heartbeat_interval"`
+	SyncHistPurgeDelay                        types.String `tfsdk:"sync_hist_purge_delay"`
+	Restricted                                types.Bool   `tfsdk:"restricted"`
+	OnReplayFailureWaitForDependentOpsTimeout types.String `tfsdk:"on_replay_failure_wait_for_dependent_ops_timeout"`
+	DependentOpsReplayFailureWaitTime         types.String `tfsdk:"dependent_ops_replay_failure_wait_time"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *replicationDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a Replication Domain.",
+		Attributes: map[string]schema.Attribute{
+			"synchronization_provider_name": schema.StringAttribute{
+				Description: "Name of the parent Synchronization Provider",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Specifies a unique identifier for the Directory Server within the Replication Domain. This is assigned when replication is enabled, and can't be changed with Terraform.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"base_dn": schema.StringAttribute{
+				Description: "Specifies the base DN of the replicated data.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"window_size": schema.Int64Attribute{
+				Description: "Specifies the maximum number of replication updates the Directory Server can have outstanding from the Replication Server.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"heartbeat_interval": schema.StringAttribute{
+				Description: "Specifies the heartbeat interval that the Directory Server will use when communicating with Replication Servers.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sync_hist_purge_delay": schema.StringAttribute{
+				Description: "The time in seconds after which historical information used in replication conflict resolution is purged. The information is removed from entries when they are modified after the purge delay has elapsed.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"restricted": schema.BoolAttribute{
+				Description: "When set to true, changes are only replicated with server instances that belong to the same replication set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_replay_failure_wait_for_dependent_ops_timeout": schema.StringAttribute{
+				Description: "Defines the maximum time to retry a failed operation. An operation will be retried only if it appears that the failure might be dependent on an earlier operation from a different server that hasn't replicated yet. The frequency of the retry is determined by the dependent-ops-replay-failure-wait-time property.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dependent_ops_replay_failure_wait_time": schema.StringAttribute{
+				Description: "Defines how long to wait before retrying certain operations, specifically operations that might have failed because they depend on an operation from a different server that has not yet replicated to this instance.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	AddCommonSchema(&schema, true)
+	resp.Schema = schema
+}
+
+// Check that replication is enabled before adopting a Replication Domain, so that the problem is reported at plan time
+func (r *replicationDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.apiClient == nil || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var plan replicationDomainResourceModel
+	req.Plan.Get(ctx, &plan)
+	if !internaltypes.IsDefined(plan.SynchronizationProviderName) {
+		return
+	}
+	detail := replicationNotEnabledDetail(ctx, r.apiClient, r.providerConfig, plan.SynchronizationProviderName.ValueString(), &resp.Diagnostics)
+	if detail != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("synchronization_provider_name"), "Replication is not enabled", detail)
+	}
+}
+
+// Read a ReplicationDomainResponse object into the model struct
+func readReplicationDomainResponse(ctx context.Context, r *client.ReplicationDomainResponse, state *replicationDomainResourceModel, expectedValues *replicationDomainResourceModel, diagnostics *diag.Diagnostics) {
+	// The id and parent synchronization provider name aren't included in the response, so they are left unchanged
+	state.ServerID = types.Int64Value(int64(r.ServerID))
+	state.BaseDN = types.StringValue(r.BaseDN)
+	state.WindowSize = internaltypes.Int64TypeOrNil(r.WindowSize)
+	state.HeartbeatInterval = internaltypes.StringTypeOrNil(r.HeartbeatInterval, true)
+	CheckMismatchedPDFormattedAttributes("heartbeat_interval",
+		expectedValues.HeartbeatInterval, state.HeartbeatInterval, diagnostics)
+	state.SyncHistPurgeDelay = internaltypes.StringTypeOrNil(r.SyncHistPurgeDelay, true)
+	CheckMismatchedPDFormattedAttributes("sync_hist_purge_delay",
+		expectedValues.SyncHistPurgeDelay, state.SyncHistPurgeDelay, diagnostics)
+	state.Restricted = internaltypes.BoolTypeOrNil(r.Restricted)
+	state.OnReplayFailureWaitForDependentOpsTimeout = internaltypes.StringTypeOrNil(r.OnReplayFailureWaitForDependentOpsTimeout, true)
+	CheckMismatchedPDFormattedAttributes("on_replay_failure_wait_for_dependent_ops_timeout",
+		expectedValues.OnReplayFailureWaitForDependentOpsTimeout, state.OnReplayFailureWaitForDependentOpsTimeout, diagnostics)
+	state.DependentOpsReplayFailureWaitTime = internaltypes.StringTypeOrNil(r.DependentOpsReplayFailureWaitTime, true)
+	state.Notifications, state.RequiredActions = ReadMessages(ctx, r.Urnpingidentityschemasconfigurationmessages20, diagnostics)
+}
+
+// Create any update operations necessary to make the state match the plan
+func createReplicationDomainOperations(plan replicationDomainResourceModel, state replicationDomainResourceModel) []client.Operation {
+	var ops []client.Operation
+	operations.AddInt64OperationIfNecessary(&ops, plan.WindowSize, state.WindowSize, "window-size")
+	operations.AddStringOperationIfNecessary(&ops, plan.HeartbeatInterval, state.HeartbeatInterval, "heartbeat-interval")
+	operations.AddStringOperationIfNecessary(&ops, plan.SyncHistPurgeDelay, state.SyncHistPurgeDelay, "sync-hist-purge-delay")
+	operations.AddBoolOperationIfNecessary(&ops, plan.Restricted, state.Restricted, "restricted")
+	operations.AddStringOperationIfNecessary(&ops, plan.OnReplayFailureWaitForDependentOpsTimeout, state.OnReplayFailureWaitForDependentOpsTimeout, "on-replay-failure-wait-for-dependent-ops-timeout")
+	operations.AddStringOperationIfNecessary(&ops, plan.DependentOpsReplayFailureWaitTime, state.DependentOpsReplayFailureWaitTime, "dependent-ops-replay-failure-wait-time")
+	return ops
+}
+
+// Create a new resource
+// For edit only resources like this, create doesn't actually "create" anything - it "adopts" the existing
+// config object into management by terraform. This method reads the existing config object
+// and makes any changes needed to make it match the plan - similar to the Update method.
+func (r *replicationDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan replicationDomainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.ReplicationDomainApi.GetReplicationDomain(
+		ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString(), plan.SynchronizationProviderName.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.Diagnostics.AddError("Replication Domain not found", "The Replication Domain \""+plan.Id.ValueString()+
+				"\" was not found in the \""+plan.SynchronizationProviderName.ValueString()+"\" synchronization provider. "+
+				"Replication must be enabled for the base DN before the Replication Domain can be managed with Terraform.")
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication Domain", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the existing configuration
+	var state replicationDomainResourceModel
+	state.Id = plan.Id
+	state.SynchronizationProviderName = plan.SynchronizationProviderName
+	readReplicationDomainResponse(ctx, readResponse, &state, &state, &resp.Diagnostics)
+
+	// Determine what changes are needed to match the plan
+	updateRequest := r.apiClient.ReplicationDomainApi.UpdateReplicationDomain(ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString(), plan.SynchronizationProviderName.ValueString())
+	ops := createReplicationDomainOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.ReplicationDomainApi.UpdateReplicationDomainExecute(updateRequest)
+		if err != nil {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Domain", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readReplicationDomainResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *replicationDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state replicationDomainResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponse, httpResp, err := r.apiClient.ReplicationDomainApi.GetReplicationDomain(
+		ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString(), state.SynchronizationProviderName.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Replication Domain", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication Domain", err, httpResp)
+		}
+		return
+	}
+
+	// Log response JSON
+	responseJson, err := readResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readReplicationDomainResponse(ctx, readResponse, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update a resource
+func (r *replicationDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan replicationDomainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state to see how any attributes are changing
+	var state replicationDomainResourceModel
+	req.State.Get(ctx, &state)
+	updateRequest := r.apiClient.ReplicationDomainApi.UpdateReplicationDomain(
+		ProviderBasicAuthContext(ctx, r.providerConfig), plan.Id.ValueString(), plan.SynchronizationProviderName.ValueString())
+
+	// Determine what update operations are necessary
+	ops := createReplicationDomainOperations(plan, state)
+	if len(ops) > 0 {
+		updateRequest = updateRequest.UpdateRequest(*client.NewUpdateRequest(ops))
+		// Log operations
+		operations.LogUpdateOperations(ctx, ops)
+
+		updateResponse, httpResp, err := r.apiClient.ReplicationDomainApi.UpdateReplicationDomainExecute(updateRequest)
+		if err != nil {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the Replication Domain", err, httpResp)
+			return
+		}
+
+		// Log response JSON
+		responseJson, err := updateResponse.MarshalJSON()
+		if err == nil {
+			tflog.Debug(ctx, "Update response: "+string(responseJson))
+		}
+
+		// Read the response
+		readReplicationDomainResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
+		// Update computed values
+		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
+	} else {
+		tflog.Warn(ctx, "No configuration API operations created for update")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// This config object is edit-only, so Terraform can't delete it.
+// After running a delete, Terraform will just "forget" about this object and it can be managed elsewhere.
+func (r *replicationDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No implementation necessary
+}
+
+func (r *replicationDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Replication domain names are usually base DNs, so only the first "/" separates the two parts of the import id
+	split := strings.SplitN(req.ID, "/", 2)
+	if len(split) != 2 {
+		resp.Diagnostics.AddError("Invalid import id for resource", "Expected [synchronization-provider-name]/[replication-domain-name]. Got: "+req.ID)
+		return
+	}
+	// Set the required attributes to read the resource
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("synchronization_provider_name"), split[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), split[1])...)
+}
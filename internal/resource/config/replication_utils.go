@@ -0,0 +1,36 @@
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	client "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// The synchronization provider used for replication between PingDirectory servers
+const defaultReplicationSynchronizationProviderName = "Multimaster Synchronization"
+
+// Check whether replication is enabled on the PingDirectory server through the given synchronization provider.
+// Returns a description of why replication is not enabled, or an empty string if it is enabled. The replication
+// resources can only be used after replication has been enabled, for example with the dsreplication tool.
+func replicationNotEnabledDetail(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, synchronizationProviderName string, diagnostics *diag.Diagnostics) string {
+	response, httpResp, err := apiClient.SynchronizationProviderApi.GetSynchronizationProvider(
+		ProviderBasicAuthContext(ctx, providerConfig), synchronizationProviderName).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return "The synchronization provider \"" + synchronizationProviderName + "\" was not found on the PingDirectory server. " +
+				"Replication must be enabled with the dsreplication tool before it can be configured with Terraform."
+		}
+		ReportHttpError(ctx, diagnostics, "An error occurred while checking whether replication is enabled", err, httpResp)
+		return ""
+	}
+	if response.ReplicationSynchronizationProviderResponse == nil {
+		return "The synchronization provider \"" + synchronizationProviderName + "\" is not a replication synchronization provider."
+	}
+	if !response.ReplicationSynchronizationProviderResponse.Enabled {
+		return "The synchronization provider \"" + synchronizationProviderName + "\" is disabled on the PingDirectory server. " +
+			"Replication must be enabled before it can be configured with Terraform."
+	}
+	return ""
+}
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &aggregateRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &aggregateRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &aggregateRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &aggregateRequestCriteriaResource{}
 	_ resource.Resource                = &defaultAggregateRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultAggregateRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultAggregateRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultAggregateRequestCriteriaResource{}
 )
 
 // Create a Aggregate Request Criteria resource
@@ -153,6 +155,15 @@ func aggregateRequestCriteriaSchema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *aggregateRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultAggregateRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalAggregateRequestCriteriaFields(ctx context.Context, addRequest *client.AddAggregateRequestCriteriaRequest, plan aggregateRequestCriteriaResourceModel) {
 	if internaltypes.IsDefined(plan.AllIncludedRequestCriteria) {
@@ -242,6 +253,7 @@ func (r *aggregateRequestCriteriaResource) Create(ctx context.Context, req resou
 	// Read the response into the state
 	var state aggregateRequestCriteriaResourceModel
 	readAggregateRequestCriteriaResponse(ctx, addResponse.AggregateRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -280,6 +292,12 @@ func (r *defaultAggregateRequestCriteriaResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AggregateRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Aggregate Request Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state aggregateRequestCriteriaResourceModel
 	readAggregateRequestCriteriaResponse(ctx, readResponse.AggregateRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -306,6 +324,7 @@ func (r *defaultAggregateRequestCriteriaResource) Create(ctx context.Context, re
 
 		// Read the response
 		readAggregateRequestCriteriaResponse(ctx, updateResponse.AggregateRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -338,7 +357,12 @@ func readAggregateRequestCriteria(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.RequestCriteriaApi.GetRequestCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Aggregate Request Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Aggregate Request Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Aggregate Request Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -348,6 +372,13 @@ func readAggregateRequestCriteria(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AggregateRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Aggregate Request Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAggregateRequestCriteriaResponse(ctx, readResponse.AggregateRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -404,6 +435,7 @@ func updateAggregateRequestCriteria(ctx context.Context, req resource.UpdateRequ
 
 		// Read the response
 		readAggregateRequestCriteriaResponse(ctx, updateResponse.AggregateRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -452,4 +484,5 @@ func (r *defaultAggregateRequestCriteriaResource) ImportState(ctx context.Contex
 func importAggregateRequestCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &rootDseRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &rootDseRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &rootDseRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &rootDseRequestCriteriaResource{}
 	_ resource.Resource                = &defaultRootDseRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultRootDseRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultRootDseRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultRootDseRequestCriteriaResource{}
 )
 
 // Create a Root Dse Request Criteria resource
@@ -123,6 +125,15 @@ func rootDseRequestCriteriaSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *rootDseRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultRootDseRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalRootDseRequestCriteriaFields(ctx context.Context, addRequest *client.AddRootDseRequestCriteriaRequest, plan rootDseRequestCriteriaResourceModel) error {
 	if internaltypes.IsDefined(plan.OperationType) {
@@ -205,6 +216,7 @@ func (r *rootDseRequestCriteriaResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state rootDseRequestCriteriaResourceModel
 	readRootDseRequestCriteriaResponse(ctx, addResponse.RootDseRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -243,6 +255,12 @@ func (r *defaultRootDseRequestCriteriaResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.RootDseRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Root Dse Request Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state rootDseRequestCriteriaResourceModel
 	readRootDseRequestCriteriaResponse(ctx, readResponse.RootDseRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -269,6 +287,7 @@ func (r *defaultRootDseRequestCriteriaResource) Create(ctx context.Context, req
 
 		// Read the response
 		readRootDseRequestCriteriaResponse(ctx, updateResponse.RootDseRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -301,7 +320,12 @@ func readRootDseRequestCriteria(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.RequestCriteriaApi.GetRequestCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dse Request Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Root Dse Request Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dse Request Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -311,6 +335,13 @@ func readRootDseRequestCriteria(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.RootDseRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Root Dse Request Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readRootDseRequestCriteriaResponse(ctx, readResponse.RootDseRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -367,6 +398,7 @@ func updateRootDseRequestCriteria(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readRootDseRequestCriteriaResponse(ctx, updateResponse.RootDseRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -415,4 +447,5 @@ func (r *defaultRootDseRequestCriteriaResource) ImportState(ctx context.Context,
 func importRootDseRequestCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
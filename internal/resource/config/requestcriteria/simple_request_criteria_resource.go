@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &simpleRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &simpleRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &simpleRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &simpleRequestCriteriaResource{}
 	_ resource.Resource                = &defaultSimpleRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultSimpleRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultSimpleRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultSimpleRequestCriteriaResource{}
 )
 
 // Create a Simple Request Criteria resource
@@ -388,6 +390,15 @@ func simpleRequestCriteriaSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *simpleRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultSimpleRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalSimpleRequestCriteriaFields(ctx context.Context, addRequest *client.AddSimpleRequestCriteriaRequest, plan simpleRequestCriteriaResourceModel) error {
 	if internaltypes.IsDefined(plan.OperationType) {
@@ -690,6 +701,7 @@ func (r *simpleRequestCriteriaResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state simpleRequestCriteriaResourceModel
 	readSimpleRequestCriteriaResponse(ctx, addResponse.SimpleRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -728,6 +740,12 @@ func (r *defaultSimpleRequestCriteriaResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SimpleRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Simple Request Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state simpleRequestCriteriaResourceModel
 	readSimpleRequestCriteriaResponse(ctx, readResponse.SimpleRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -754,6 +772,7 @@ func (r *defaultSimpleRequestCriteriaResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readSimpleRequestCriteriaResponse(ctx, updateResponse.SimpleRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -786,7 +805,12 @@ func readSimpleRequestCriteria(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.RequestCriteriaApi.GetRequestCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Simple Request Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Simple Request Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Simple Request Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -796,6 +820,13 @@ func readSimpleRequestCriteria(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SimpleRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Simple Request Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSimpleRequestCriteriaResponse(ctx, readResponse.SimpleRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -852,6 +883,7 @@ func updateSimpleRequestCriteria(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readSimpleRequestCriteriaResponse(ctx, updateResponse.SimpleRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -900,4 +932,5 @@ func (r *defaultSimpleRequestCriteriaResource) ImportState(ctx context.Context,
 func importSimpleRequestCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &thirdPartyRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &thirdPartyRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyRequestCriteriaResource{}
 	_ resource.Resource                = &defaultThirdPartyRequestCriteriaResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyRequestCriteriaResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyRequestCriteriaResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyRequestCriteriaResource{}
 )
 
 // Create a Third Party Request Criteria resource
@@ -128,6 +130,15 @@ func thirdPartyRequestCriteriaSchema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyRequestCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyRequestCriteriaFields(ctx context.Context, addRequest *client.AddThirdPartyRequestCriteriaRequest, plan thirdPartyRequestCriteriaResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -199,6 +210,7 @@ func (r *thirdPartyRequestCriteriaResource) Create(ctx context.Context, req reso
 	// Read the response into the state
 	var state thirdPartyRequestCriteriaResourceModel
 	readThirdPartyRequestCriteriaResponse(ctx, addResponse.ThirdPartyRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -237,6 +249,12 @@ func (r *defaultThirdPartyRequestCriteriaResource) Create(ctx context.Context, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Request Criteria", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyRequestCriteriaResourceModel
 	readThirdPartyRequestCriteriaResponse(ctx, readResponse.ThirdPartyRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
@@ -263,6 +281,7 @@ func (r *defaultThirdPartyRequestCriteriaResource) Create(ctx context.Context, r
 
 		// Read the response
 		readThirdPartyRequestCriteriaResponse(ctx, updateResponse.ThirdPartyRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -295,7 +314,12 @@ func readThirdPartyRequestCriteria(ctx context.Context, req resource.ReadRequest
 	readResponse, httpResp, err := apiClient.RequestCriteriaApi.GetRequestCriteria(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Request Criteria", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Request Criteria", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Request Criteria", err, httpResp)
+		}
 		return
 	}
 
@@ -305,6 +329,13 @@ func readThirdPartyRequestCriteria(ctx context.Context, req resource.ReadRequest
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyRequestCriteriaResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Request Criteria", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyRequestCriteriaResponse(ctx, readResponse.ThirdPartyRequestCriteriaResponse, &state, &state, &resp.Diagnostics)
 
@@ -361,6 +392,7 @@ func updateThirdPartyRequestCriteria(ctx context.Context, req resource.UpdateReq
 
 		// Read the response
 		readThirdPartyRequestCriteriaResponse(ctx, updateResponse.ThirdPartyRequestCriteriaResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -409,4 +441,5 @@ func (r *defaultThirdPartyRequestCriteriaResource) ImportState(ctx context.Conte
 func importThirdPartyRequestCriteria(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &genericRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &genericRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &genericRestResourceTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &genericRestResourceTypeResource{}
 	_ resource.Resource                = &defaultGenericRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &defaultGenericRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &defaultGenericRestResourceTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGenericRestResourceTypeResource{}
 )
 
 // Create a Generic Rest Resource Type resource
@@ -254,6 +256,15 @@ func genericRestResourceTypeSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *genericRestResourceTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGenericRestResourceTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGenericRestResourceTypeFields(ctx context.Context, addRequest *client.AddGenericRestResourceTypeRequest, plan genericRestResourceTypeResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -430,6 +441,7 @@ func (r *genericRestResourceTypeResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state genericRestResourceTypeResourceModel
 	readGenericRestResourceTypeResponse(ctx, addResponse.GenericRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -468,6 +480,12 @@ func (r *defaultGenericRestResourceTypeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GenericRestResourceTypeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Generic Rest Resource Type", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state genericRestResourceTypeResourceModel
 	readGenericRestResourceTypeResponse(ctx, readResponse.GenericRestResourceTypeResponse, &state, &state, &resp.Diagnostics)
@@ -494,6 +512,7 @@ func (r *defaultGenericRestResourceTypeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readGenericRestResourceTypeResponse(ctx, updateResponse.GenericRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -526,7 +545,12 @@ func readGenericRestResourceType(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.RestResourceTypeApi.GetRestResourceType(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Generic Rest Resource Type", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Generic Rest Resource Type", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Generic Rest Resource Type", err, httpResp)
+		}
 		return
 	}
 
@@ -536,6 +560,13 @@ func readGenericRestResourceType(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GenericRestResourceTypeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Generic Rest Resource Type", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGenericRestResourceTypeResponse(ctx, readResponse.GenericRestResourceTypeResponse, &state, &state, &resp.Diagnostics)
 
@@ -592,6 +623,7 @@ func updateGenericRestResourceType(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readGenericRestResourceTypeResponse(ctx, updateResponse.GenericRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -640,4 +672,5 @@ func (r *defaultGenericRestResourceTypeResource) ImportState(ctx context.Context
 func importGenericRestResourceType(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &groupRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &groupRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &groupRestResourceTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &groupRestResourceTypeResource{}
 	_ resource.Resource                = &defaultGroupRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroupRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &defaultGroupRestResourceTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroupRestResourceTypeResource{}
 )
 
 // Create a Group Rest Resource Type resource
@@ -254,6 +256,15 @@ func groupRestResourceTypeSchema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groupRestResourceTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroupRestResourceTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroupRestResourceTypeFields(ctx context.Context, addRequest *client.AddGroupRestResourceTypeRequest, plan groupRestResourceTypeResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -430,6 +441,7 @@ func (r *groupRestResourceTypeResource) Create(ctx context.Context, req resource
 	// Read the response into the state
 	var state groupRestResourceTypeResourceModel
 	readGroupRestResourceTypeResponse(ctx, addResponse.GroupRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -468,6 +480,12 @@ func (r *defaultGroupRestResourceTypeResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroupRestResourceTypeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Group Rest Resource Type", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groupRestResourceTypeResourceModel
 	readGroupRestResourceTypeResponse(ctx, readResponse.GroupRestResourceTypeResponse, &state, &state, &resp.Diagnostics)
@@ -494,6 +512,7 @@ func (r *defaultGroupRestResourceTypeResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readGroupRestResourceTypeResponse(ctx, updateResponse.GroupRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -526,7 +545,12 @@ func readGroupRestResourceType(ctx context.Context, req resource.ReadRequest, re
 	readResponse, httpResp, err := apiClient.RestResourceTypeApi.GetRestResourceType(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Group Rest Resource Type", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Group Rest Resource Type", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Group Rest Resource Type", err, httpResp)
+		}
 		return
 	}
 
@@ -536,6 +560,13 @@ func readGroupRestResourceType(ctx context.Context, req resource.ReadRequest, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroupRestResourceTypeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Group Rest Resource Type", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroupRestResourceTypeResponse(ctx, readResponse.GroupRestResourceTypeResponse, &state, &state, &resp.Diagnostics)
 
@@ -592,6 +623,7 @@ func updateGroupRestResourceType(ctx context.Context, req resource.UpdateRequest
 
 		// Read the response
 		readGroupRestResourceTypeResponse(ctx, updateResponse.GroupRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -640,4 +672,5 @@ func (r *defaultGroupRestResourceTypeResource) ImportState(ctx context.Context,
 func importGroupRestResourceType(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
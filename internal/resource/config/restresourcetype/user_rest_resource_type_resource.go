@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &userRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &userRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &userRestResourceTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &userRestResourceTypeResource{}
 	_ resource.Resource                = &defaultUserRestResourceTypeResource{}
 	_ resource.ResourceWithConfigure   = &defaultUserRestResourceTypeResource{}
 	_ resource.ResourceWithImportState = &defaultUserRestResourceTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultUserRestResourceTypeResource{}
 )
 
 // Create a User Rest Resource Type resource
@@ -272,6 +274,15 @@ func userRestResourceTypeSchema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *userRestResourceTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultUserRestResourceTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalUserRestResourceTypeFields(ctx context.Context, addRequest *client.AddUserRestResourceTypeRequest, plan userRestResourceTypeResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -461,6 +472,7 @@ func (r *userRestResourceTypeResource) Create(ctx context.Context, req resource.
 	// Read the response into the state
 	var state userRestResourceTypeResourceModel
 	readUserRestResourceTypeResponse(ctx, addResponse.UserRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -499,6 +511,12 @@ func (r *defaultUserRestResourceTypeResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.UserRestResourceTypeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "User Rest Resource Type", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state userRestResourceTypeResourceModel
 	readUserRestResourceTypeResponse(ctx, readResponse.UserRestResourceTypeResponse, &state, &state, &resp.Diagnostics)
@@ -525,6 +543,7 @@ func (r *defaultUserRestResourceTypeResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readUserRestResourceTypeResponse(ctx, updateResponse.UserRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -557,7 +576,12 @@ func readUserRestResourceType(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := apiClient.RestResourceTypeApi.GetRestResourceType(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the User Rest Resource Type", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "User Rest Resource Type", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the User Rest Resource Type", err, httpResp)
+		}
 		return
 	}
 
@@ -567,6 +591,13 @@ func readUserRestResourceType(ctx context.Context, req resource.ReadRequest, res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.UserRestResourceTypeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "User Rest Resource Type", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readUserRestResourceTypeResponse(ctx, readResponse.UserRestResourceTypeResponse, &state, &state, &resp.Diagnostics)
 
@@ -623,6 +654,7 @@ func updateUserRestResourceType(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readUserRestResourceTypeResponse(ctx, updateResponse.UserRestResourceTypeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -671,4 +703,5 @@ func (r *defaultUserRestResourceTypeResource) ImportState(ctx context.Context, r
 func importUserRestResourceType(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
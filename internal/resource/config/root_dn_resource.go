@@ -148,6 +148,7 @@ func (r *rootDnResource) Create(ctx context.Context, req resource.CreateRequest,
 
 		// Read the response
 		readRootDnResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -172,7 +173,12 @@ func (r *rootDnResource) Read(ctx context.Context, req resource.ReadRequest, res
 	readResponse, httpResp, err := r.apiClient.RootDnApi.GetRootDn(
 		ProviderBasicAuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dn", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Root Dn", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dn", err, httpResp)
+		}
 		return
 	}
 
@@ -230,6 +236,7 @@ func (r *rootDnResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		// Read the response
 		readRootDnResponse(ctx, updateResponse, &state, &resp.Diagnostics)
+		CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
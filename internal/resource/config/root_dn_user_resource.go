@@ -700,6 +700,7 @@ func (r *rootDnUserResource) Create(ctx context.Context, req resource.CreateRequ
 	// Read the response into the state
 	var state rootDnUserResourceModel
 	readRootDnUserResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -764,6 +765,7 @@ func (r *defaultRootDnUserResource) Create(ctx context.Context, req resource.Cre
 
 		// Read the response
 		readRootDnUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -796,7 +798,12 @@ func readRootDnUser(ctx context.Context, req resource.ReadRequest, resp *resourc
 	readResponse, httpResp, err := apiClient.RootDnUserApi.GetRootDnUser(
 		ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dn User", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Root Dn User", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Root Dn User", err, httpResp)
+		}
 		return
 	}
 
@@ -862,6 +869,7 @@ func updateRootDnUser(ctx context.Context, req resource.UpdateRequest, resp *res
 
 		// Read the response
 		readRootDnUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
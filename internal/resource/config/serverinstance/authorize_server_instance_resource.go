@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &authorizeServerInstanceResource{}
 	_ resource.ResourceWithConfigure   = &authorizeServerInstanceResource{}
 	_ resource.ResourceWithImportState = &authorizeServerInstanceResource{}
+	_ resource.ResourceWithModifyPlan  = &authorizeServerInstanceResource{}
 )
 
 // Create a Authorize Server Instance resource
@@ -265,6 +266,11 @@ func (r *authorizeServerInstanceResource) Schema(ctx context.Context, req resour
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *authorizeServerInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a AuthorizeServerInstanceResponse object into the model struct
 func readAuthorizeServerInstanceResponse(ctx context.Context, r *client.AuthorizeServerInstanceResponse, state *authorizeServerInstanceResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -347,6 +353,12 @@ func (r *authorizeServerInstanceResource) Create(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AuthorizeServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Authorize Server Instance", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state authorizeServerInstanceResourceModel
 	readAuthorizeServerInstanceResponse(ctx, readResponse.AuthorizeServerInstanceResponse, &state, &resp.Diagnostics)
@@ -373,6 +385,7 @@ func (r *authorizeServerInstanceResource) Create(ctx context.Context, req resour
 
 		// Read the response
 		readAuthorizeServerInstanceResponse(ctx, updateResponse.AuthorizeServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -397,7 +410,12 @@ func (r *authorizeServerInstanceResource) Read(ctx context.Context, req resource
 	readResponse, httpResp, err := r.apiClient.ServerInstanceApi.GetServerInstance(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Authorize Server Instance", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Authorize Server Instance", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Authorize Server Instance", err, httpResp)
+		}
 		return
 	}
 
@@ -407,6 +425,13 @@ func (r *authorizeServerInstanceResource) Read(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.AuthorizeServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Authorize Server Instance", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readAuthorizeServerInstanceResponse(ctx, readResponse.AuthorizeServerInstanceResponse, &state, &resp.Diagnostics)
 
@@ -455,6 +480,7 @@ func (r *authorizeServerInstanceResource) Update(ctx context.Context, req resour
 
 		// Read the response
 		readAuthorizeServerInstanceResponse(ctx, updateResponse.AuthorizeServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -478,4 +504,5 @@ func (r *authorizeServerInstanceResource) Delete(ctx context.Context, req resour
 func (r *authorizeServerInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
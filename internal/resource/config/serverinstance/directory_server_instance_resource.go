@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &directoryServerInstanceResource{}
 	_ resource.ResourceWithConfigure   = &directoryServerInstanceResource{}
 	_ resource.ResourceWithImportState = &directoryServerInstanceResource{}
+	_ resource.ResourceWithModifyPlan  = &directoryServerInstanceResource{}
 )
 
 // Create a Directory Server Instance resource
@@ -284,6 +285,11 @@ func (r *directoryServerInstanceResource) Schema(ctx context.Context, req resour
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *directoryServerInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a DirectoryServerInstanceResponse object into the model struct
 func readDirectoryServerInstanceResponse(ctx context.Context, r *client.DirectoryServerInstanceResponse, state *directoryServerInstanceResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -370,6 +376,12 @@ func (r *directoryServerInstanceResource) Create(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DirectoryServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Directory Server Instance", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state directoryServerInstanceResourceModel
 	readDirectoryServerInstanceResponse(ctx, readResponse.DirectoryServerInstanceResponse, &state, &resp.Diagnostics)
@@ -396,6 +408,7 @@ func (r *directoryServerInstanceResource) Create(ctx context.Context, req resour
 
 		// Read the response
 		readDirectoryServerInstanceResponse(ctx, updateResponse.DirectoryServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -420,7 +433,12 @@ func (r *directoryServerInstanceResource) Read(ctx context.Context, req resource
 	readResponse, httpResp, err := r.apiClient.ServerInstanceApi.GetServerInstance(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Directory Server Instance", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Directory Server Instance", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Directory Server Instance", err, httpResp)
+		}
 		return
 	}
 
@@ -430,6 +448,13 @@ func (r *directoryServerInstanceResource) Read(ctx context.Context, req resource
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DirectoryServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Directory Server Instance", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDirectoryServerInstanceResponse(ctx, readResponse.DirectoryServerInstanceResponse, &state, &resp.Diagnostics)
 
@@ -478,6 +503,7 @@ func (r *directoryServerInstanceResource) Update(ctx context.Context, req resour
 
 		// Read the response
 		readDirectoryServerInstanceResponse(ctx, updateResponse.DirectoryServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -501,4 +527,5 @@ func (r *directoryServerInstanceResource) Delete(ctx context.Context, req resour
 func (r *directoryServerInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &proxyServerInstanceResource{}
 	_ resource.ResourceWithConfigure   = &proxyServerInstanceResource{}
 	_ resource.ResourceWithImportState = &proxyServerInstanceResource{}
+	_ resource.ResourceWithModifyPlan  = &proxyServerInstanceResource{}
 )
 
 // Create a Proxy Server Instance resource
@@ -265,6 +266,11 @@ func (r *proxyServerInstanceResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *proxyServerInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ProxyServerInstanceResponse object into the model struct
 func readProxyServerInstanceResponse(ctx context.Context, r *client.ProxyServerInstanceResponse, state *proxyServerInstanceResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -347,6 +353,12 @@ func (r *proxyServerInstanceResource) Create(ctx context.Context, req resource.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ProxyServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Proxy Server Instance", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state proxyServerInstanceResourceModel
 	readProxyServerInstanceResponse(ctx, readResponse.ProxyServerInstanceResponse, &state, &resp.Diagnostics)
@@ -373,6 +385,7 @@ func (r *proxyServerInstanceResource) Create(ctx context.Context, req resource.C
 
 		// Read the response
 		readProxyServerInstanceResponse(ctx, updateResponse.ProxyServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -397,7 +410,12 @@ func (r *proxyServerInstanceResource) Read(ctx context.Context, req resource.Rea
 	readResponse, httpResp, err := r.apiClient.ServerInstanceApi.GetServerInstance(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Proxy Server Instance", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Proxy Server Instance", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Proxy Server Instance", err, httpResp)
+		}
 		return
 	}
 
@@ -407,6 +425,13 @@ func (r *proxyServerInstanceResource) Read(ctx context.Context, req resource.Rea
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ProxyServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Proxy Server Instance", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readProxyServerInstanceResponse(ctx, readResponse.ProxyServerInstanceResponse, &state, &resp.Diagnostics)
 
@@ -455,6 +480,7 @@ func (r *proxyServerInstanceResource) Update(ctx context.Context, req resource.U
 
 		// Read the response
 		readProxyServerInstanceResponse(ctx, updateResponse.ProxyServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -478,4 +504,5 @@ func (r *proxyServerInstanceResource) Delete(ctx context.Context, req resource.D
 func (r *proxyServerInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
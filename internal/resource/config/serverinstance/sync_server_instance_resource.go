@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &syncServerInstanceResource{}
 	_ resource.ResourceWithConfigure   = &syncServerInstanceResource{}
 	_ resource.ResourceWithImportState = &syncServerInstanceResource{}
+	_ resource.ResourceWithModifyPlan  = &syncServerInstanceResource{}
 )
 
 // Create a Sync Server Instance resource
@@ -265,6 +266,11 @@ func (r *syncServerInstanceResource) Schema(ctx context.Context, req resource.Sc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *syncServerInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a SyncServerInstanceResponse object into the model struct
 func readSyncServerInstanceResponse(ctx context.Context, r *client.SyncServerInstanceResponse, state *syncServerInstanceResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -347,6 +353,12 @@ func (r *syncServerInstanceResource) Create(ctx context.Context, req resource.Cr
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyncServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Sync Server Instance", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state syncServerInstanceResourceModel
 	readSyncServerInstanceResponse(ctx, readResponse.SyncServerInstanceResponse, &state, &resp.Diagnostics)
@@ -373,6 +385,7 @@ func (r *syncServerInstanceResource) Create(ctx context.Context, req resource.Cr
 
 		// Read the response
 		readSyncServerInstanceResponse(ctx, updateResponse.SyncServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -397,7 +410,12 @@ func (r *syncServerInstanceResource) Read(ctx context.Context, req resource.Read
 	readResponse, httpResp, err := r.apiClient.ServerInstanceApi.GetServerInstance(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Sync Server Instance", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Sync Server Instance", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Sync Server Instance", err, httpResp)
+		}
 		return
 	}
 
@@ -407,6 +425,13 @@ func (r *syncServerInstanceResource) Read(ctx context.Context, req resource.Read
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SyncServerInstanceResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Sync Server Instance", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSyncServerInstanceResponse(ctx, readResponse.SyncServerInstanceResponse, &state, &resp.Diagnostics)
 
@@ -455,6 +480,7 @@ func (r *syncServerInstanceResource) Update(ctx context.Context, req resource.Up
 
 		// Read the response
 		readSyncServerInstanceResponse(ctx, updateResponse.SyncServerInstanceResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -478,4 +504,5 @@ func (r *syncServerInstanceResource) Delete(ctx context.Context, req resource.De
 func (r *syncServerInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
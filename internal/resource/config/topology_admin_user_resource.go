@@ -700,6 +700,7 @@ func (r *topologyAdminUserResource) Create(ctx context.Context, req resource.Cre
 	// Read the response into the state
 	var state topologyAdminUserResourceModel
 	readTopologyAdminUserResponse(ctx, addResponse, &state, &plan, &resp.Diagnostics)
+	CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -764,6 +765,7 @@ func (r *defaultTopologyAdminUserResource) Create(ctx context.Context, req resou
 
 		// Read the response
 		readTopologyAdminUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -796,7 +798,12 @@ func readTopologyAdminUser(ctx context.Context, req resource.ReadRequest, resp *
 	readResponse, httpResp, err := apiClient.TopologyAdminUserApi.GetTopologyAdminUser(
 		ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Topology Admin User", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Topology Admin User", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Topology Admin User", err, httpResp)
+		}
 		return
 	}
 
@@ -862,6 +869,7 @@ func updateTopologyAdminUser(ctx context.Context, req resource.UpdateRequest, re
 
 		// Read the response
 		readTopologyAdminUserResponse(ctx, updateResponse, &state, &plan, &resp.Diagnostics)
+		CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
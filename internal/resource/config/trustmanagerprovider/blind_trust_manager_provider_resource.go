@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &blindTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &blindTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &blindTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &blindTrustManagerProviderResource{}
 	_ resource.Resource                = &defaultBlindTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultBlindTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &defaultBlindTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultBlindTrustManagerProviderResource{}
 )
 
 // Create a Blind Trust Manager Provider resource
@@ -122,6 +124,15 @@ func blindTrustManagerProviderSchema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *blindTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultBlindTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalBlindTrustManagerProviderFields(ctx context.Context, addRequest *client.AddBlindTrustManagerProviderRequest, plan blindTrustManagerProviderResourceModel) {
 	if internaltypes.IsDefined(plan.IncludeJVMDefaultIssuers) {
@@ -185,6 +196,7 @@ func (r *blindTrustManagerProviderResource) Create(ctx context.Context, req reso
 	// Read the response into the state
 	var state blindTrustManagerProviderResourceModel
 	readBlindTrustManagerProviderResponse(ctx, addResponse.BlindTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -223,6 +235,12 @@ func (r *defaultBlindTrustManagerProviderResource) Create(ctx context.Context, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.BlindTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Blind Trust Manager Provider", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state blindTrustManagerProviderResourceModel
 	readBlindTrustManagerProviderResponse(ctx, readResponse.BlindTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
@@ -249,6 +267,7 @@ func (r *defaultBlindTrustManagerProviderResource) Create(ctx context.Context, r
 
 		// Read the response
 		readBlindTrustManagerProviderResponse(ctx, updateResponse.BlindTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -281,7 +300,12 @@ func readBlindTrustManagerProvider(ctx context.Context, req resource.ReadRequest
 	readResponse, httpResp, err := apiClient.TrustManagerProviderApi.GetTrustManagerProvider(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Blind Trust Manager Provider", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Blind Trust Manager Provider", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Blind Trust Manager Provider", err, httpResp)
+		}
 		return
 	}
 
@@ -291,6 +315,13 @@ func readBlindTrustManagerProvider(ctx context.Context, req resource.ReadRequest
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.BlindTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Blind Trust Manager Provider", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readBlindTrustManagerProviderResponse(ctx, readResponse.BlindTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
 
@@ -347,6 +378,7 @@ func updateBlindTrustManagerProvider(ctx context.Context, req resource.UpdateReq
 
 		// Read the response
 		readBlindTrustManagerProviderResponse(ctx, updateResponse.BlindTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -395,4 +427,5 @@ func (r *defaultBlindTrustManagerProviderResource) ImportState(ctx context.Conte
 func importBlindTrustManagerProvider(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,9 +23,11 @@ var (
 	_ resource.Resource                = &fileBasedTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &fileBasedTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &fileBasedTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &fileBasedTrustManagerProviderResource{}
 	_ resource.Resource                = &defaultFileBasedTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultFileBasedTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &defaultFileBasedTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultFileBasedTrustManagerProviderResource{}
 )
 
 // Create a File Based Trust Manager Provider resource
@@ -148,6 +150,15 @@ func fileBasedTrustManagerProviderSchema(ctx context.Context, req resource.Schem
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *fileBasedTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultFileBasedTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalFileBasedTrustManagerProviderFields(ctx context.Context, addRequest *client.AddFileBasedTrustManagerProviderRequest, plan fileBasedTrustManagerProviderResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -243,6 +254,7 @@ func (r *fileBasedTrustManagerProviderResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state fileBasedTrustManagerProviderResourceModel
 	readFileBasedTrustManagerProviderResponse(ctx, addResponse.FileBasedTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -281,6 +293,12 @@ func (r *defaultFileBasedTrustManagerProviderResource) Create(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "File Based Trust Manager Provider", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state fileBasedTrustManagerProviderResourceModel
 	readFileBasedTrustManagerProviderResponse(ctx, readResponse.FileBasedTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
@@ -307,6 +325,7 @@ func (r *defaultFileBasedTrustManagerProviderResource) Create(ctx context.Contex
 
 		// Read the response
 		readFileBasedTrustManagerProviderResponse(ctx, updateResponse.FileBasedTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -339,7 +358,12 @@ func readFileBasedTrustManagerProvider(ctx context.Context, req resource.ReadReq
 	readResponse, httpResp, err := apiClient.TrustManagerProviderApi.GetTrustManagerProvider(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Trust Manager Provider", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "File Based Trust Manager Provider", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the File Based Trust Manager Provider", err, httpResp)
+		}
 		return
 	}
 
@@ -349,6 +373,13 @@ func readFileBasedTrustManagerProvider(ctx context.Context, req resource.ReadReq
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.FileBasedTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "File Based Trust Manager Provider", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readFileBasedTrustManagerProviderResponse(ctx, readResponse.FileBasedTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
 
@@ -405,6 +436,7 @@ func updateFileBasedTrustManagerProvider(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readFileBasedTrustManagerProviderResponse(ctx, updateResponse.FileBasedTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -453,4 +485,5 @@ func (r *defaultFileBasedTrustManagerProviderResource) ImportState(ctx context.C
 func importFileBasedTrustManagerProvider(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -21,9 +21,11 @@ var (
 	_ resource.Resource                = &jvmDefaultTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &jvmDefaultTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &jvmDefaultTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &jvmDefaultTrustManagerProviderResource{}
 	_ resource.Resource                = &defaultJvmDefaultTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultJvmDefaultTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &defaultJvmDefaultTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultJvmDefaultTrustManagerProviderResource{}
 )
 
 // Create a Jvm Default Trust Manager Provider resource
@@ -111,6 +113,15 @@ func jvmDefaultTrustManagerProviderSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *jvmDefaultTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultJvmDefaultTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalJvmDefaultTrustManagerProviderFields(ctx context.Context, addRequest *client.AddJvmDefaultTrustManagerProviderRequest, plan jvmDefaultTrustManagerProviderResourceModel) {
 }
@@ -168,6 +179,7 @@ func (r *jvmDefaultTrustManagerProviderResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state jvmDefaultTrustManagerProviderResourceModel
 	readJvmDefaultTrustManagerProviderResponse(ctx, addResponse.JvmDefaultTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -206,6 +218,12 @@ func (r *defaultJvmDefaultTrustManagerProviderResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JvmDefaultTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Jvm Default Trust Manager Provider", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state jvmDefaultTrustManagerProviderResourceModel
 	readJvmDefaultTrustManagerProviderResponse(ctx, readResponse.JvmDefaultTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
@@ -232,6 +250,7 @@ func (r *defaultJvmDefaultTrustManagerProviderResource) Create(ctx context.Conte
 
 		// Read the response
 		readJvmDefaultTrustManagerProviderResponse(ctx, updateResponse.JvmDefaultTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -264,7 +283,12 @@ func readJvmDefaultTrustManagerProvider(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.TrustManagerProviderApi.GetTrustManagerProvider(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jvm Default Trust Manager Provider", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Jvm Default Trust Manager Provider", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Jvm Default Trust Manager Provider", err, httpResp)
+		}
 		return
 	}
 
@@ -274,6 +298,13 @@ func readJvmDefaultTrustManagerProvider(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.JvmDefaultTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Jvm Default Trust Manager Provider", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readJvmDefaultTrustManagerProviderResponse(ctx, readResponse.JvmDefaultTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
 
@@ -330,6 +361,7 @@ func updateJvmDefaultTrustManagerProvider(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readJvmDefaultTrustManagerProviderResponse(ctx, updateResponse.JvmDefaultTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -378,4 +410,5 @@ func (r *defaultJvmDefaultTrustManagerProviderResource) ImportState(ctx context.
 func importJvmDefaultTrustManagerProvider(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
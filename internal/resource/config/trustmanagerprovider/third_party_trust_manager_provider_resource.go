@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &thirdPartyTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &thirdPartyTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyTrustManagerProviderResource{}
 	_ resource.Resource                = &defaultThirdPartyTrustManagerProviderResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyTrustManagerProviderResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyTrustManagerProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyTrustManagerProviderResource{}
 )
 
 // Create a Third Party Trust Manager Provider resource
@@ -138,6 +140,15 @@ func thirdPartyTrustManagerProviderSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyTrustManagerProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyTrustManagerProviderFields(ctx context.Context, addRequest *client.AddThirdPartyTrustManagerProviderRequest, plan thirdPartyTrustManagerProviderResourceModel) {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -211,6 +222,7 @@ func (r *thirdPartyTrustManagerProviderResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state thirdPartyTrustManagerProviderResourceModel
 	readThirdPartyTrustManagerProviderResponse(ctx, addResponse.ThirdPartyTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -249,6 +261,12 @@ func (r *defaultThirdPartyTrustManagerProviderResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Trust Manager Provider", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyTrustManagerProviderResourceModel
 	readThirdPartyTrustManagerProviderResponse(ctx, readResponse.ThirdPartyTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
@@ -275,6 +293,7 @@ func (r *defaultThirdPartyTrustManagerProviderResource) Create(ctx context.Conte
 
 		// Read the response
 		readThirdPartyTrustManagerProviderResponse(ctx, updateResponse.ThirdPartyTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -307,7 +326,12 @@ func readThirdPartyTrustManagerProvider(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.TrustManagerProviderApi.GetTrustManagerProvider(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Trust Manager Provider", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Trust Manager Provider", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Trust Manager Provider", err, httpResp)
+		}
 		return
 	}
 
@@ -317,6 +341,13 @@ func readThirdPartyTrustManagerProvider(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyTrustManagerProviderResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Trust Manager Provider", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyTrustManagerProviderResponse(ctx, readResponse.ThirdPartyTrustManagerProviderResponse, &state, &state, &resp.Diagnostics)
 
@@ -373,6 +404,7 @@ func updateThirdPartyTrustManagerProvider(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readThirdPartyTrustManagerProviderResponse(ctx, updateResponse.ThirdPartyTrustManagerProviderResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -421,4 +453,5 @@ func (r *defaultThirdPartyTrustManagerProviderResource) ImportState(ctx context.
 func importThirdPartyTrustManagerProvider(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Check if a string attribute has been configured with a known, non-empty value. Empty strings are
+// treated as equivalent to null, and unknown values can't be validated until apply.
+func isConfiguredString(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
+// Add an error if more than one of the given string attributes is configured. The attributes are
+// alternative ways of providing the same value, such as a PIN, a PIN file, or a passphrase provider.
+func ValidateAtMostOneStringConfigured(diagnostics *diag.Diagnostics, attrNames []string, values []types.String) {
+	var configured []string
+	for i, value := range values {
+		if isConfiguredString(value) {
+			configured = append(configured, attrNames[i])
+		}
+	}
+	if len(configured) > 1 {
+		diagnostics.AddAttributeError(path.Root(configured[1]), "Conflicting attributes",
+			"Only one of '"+strings.Join(attrNames, "', '")+"' may be configured. Found: '"+strings.Join(configured, "', '")+"'")
+	}
+}
+
+// Add an error if a file path attribute contains only whitespace, or contains surrounding whitespace or
+// line breaks. Paths may be absolute, or relative to the PingDirectory server root. An empty path is
+// treated as equivalent to null.
+func ValidateFilePath(diagnostics *diag.Diagnostics, attrName string, value types.String) {
+	if !isConfiguredString(value) {
+		return
+	}
+	filePath := value.ValueString()
+	if strings.TrimSpace(filePath) == "" {
+		diagnostics.AddAttributeError(path.Root(attrName), "Invalid file path",
+			"The '"+attrName+"' attribute must not contain only whitespace")
+		return
+	}
+	if strings.TrimSpace(filePath) != filePath || strings.ContainsAny(filePath, "\r\n\x00") {
+		diagnostics.AddAttributeError(path.Root(attrName), "Invalid file path",
+			"The '"+attrName+"' attribute must not contain leading or trailing whitespace or line breaks. Got: \""+filePath+"\"")
+	}
+}
+
+// Add an error if a PIN attribute is configured with a value that can't be used as a key store PIN.
+// PINs are stored in single-line PIN files by PingDirectory, so they can't contain line breaks. An empty
+// PIN is treated as equivalent to null.
+func ValidatePin(diagnostics *diag.Diagnostics, attrName string, value types.String) {
+	if !isConfiguredString(value) {
+		return
+	}
+	if strings.TrimSpace(value.ValueString()) == "" {
+		diagnostics.AddAttributeError(path.Root(attrName), "Invalid PIN",
+			"The '"+attrName+"' attribute must not contain only whitespace")
+		return
+	}
+	if strings.ContainsAny(value.ValueString(), "\r\n") {
+		diagnostics.AddAttributeError(path.Root(attrName), "Invalid PIN",
+			"The '"+attrName+"' attribute must not contain line breaks")
+	}
+}
+
+// Add an error if a string attribute is configured with a value that isn't one of the allowed values.
+// The comparison is case-insensitive, matching how PingDirectory handles these values.
+func ValidateStringOneOfIgnoringCase(diagnostics *diag.Diagnostics, attrName string, value types.String, allowedValues []string) {
+	if !isConfiguredString(value) {
+		return
+	}
+	for _, allowed := range allowedValues {
+		if strings.EqualFold(value.ValueString(), allowed) {
+			return
+		}
+	}
+	diagnostics.AddAttributeError(path.Root(attrName), "Invalid attribute value",
+		"The '"+attrName+"' attribute must be one of '"+strings.Join(allowedValues, "', '")+"'. Got: \""+value.ValueString()+"\"")
+}
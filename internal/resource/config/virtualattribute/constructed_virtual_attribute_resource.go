@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &constructedVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &constructedVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &constructedVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &constructedVirtualAttributeResource{}
 	_ resource.Resource                = &defaultConstructedVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultConstructedVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultConstructedVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultConstructedVirtualAttributeResource{}
 )
 
 // Create a Constructed Virtual Attribute resource
@@ -212,6 +214,15 @@ func constructedVirtualAttributeSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *constructedVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultConstructedVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalConstructedVirtualAttributeFields(ctx context.Context, addRequest *client.AddConstructedVirtualAttributeRequest, plan constructedVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -357,6 +368,7 @@ func (r *constructedVirtualAttributeResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state constructedVirtualAttributeResourceModel
 	readConstructedVirtualAttributeResponse(ctx, addResponse.ConstructedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -395,6 +407,12 @@ func (r *defaultConstructedVirtualAttributeResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConstructedVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Constructed Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state constructedVirtualAttributeResourceModel
 	readConstructedVirtualAttributeResponse(ctx, readResponse.ConstructedVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -421,6 +439,7 @@ func (r *defaultConstructedVirtualAttributeResource) Create(ctx context.Context,
 
 		// Read the response
 		readConstructedVirtualAttributeResponse(ctx, updateResponse.ConstructedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -453,7 +472,12 @@ func readConstructedVirtualAttribute(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Constructed Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Constructed Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Constructed Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -463,6 +487,13 @@ func readConstructedVirtualAttribute(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ConstructedVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Constructed Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readConstructedVirtualAttributeResponse(ctx, readResponse.ConstructedVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -519,6 +550,7 @@ func updateConstructedVirtualAttribute(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readConstructedVirtualAttributeResponse(ctx, updateResponse.ConstructedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -567,4 +599,5 @@ func (r *defaultConstructedVirtualAttributeResource) ImportState(ctx context.Con
 func importConstructedVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &currentTimeVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &currentTimeVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &currentTimeVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &currentTimeVirtualAttributeResource{}
 )
 
 // Create a Current Time Virtual Attribute resource
@@ -203,6 +204,11 @@ func (r *currentTimeVirtualAttributeResource) Schema(ctx context.Context, req re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *currentTimeVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a CurrentTimeVirtualAttributeResponse object into the model struct
 func readCurrentTimeVirtualAttributeResponse(ctx context.Context, r *client.CurrentTimeVirtualAttributeResponse, state *currentTimeVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -271,6 +277,12 @@ func (r *currentTimeVirtualAttributeResource) Create(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CurrentTimeVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Current Time Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state currentTimeVirtualAttributeResourceModel
 	readCurrentTimeVirtualAttributeResponse(ctx, readResponse.CurrentTimeVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -297,6 +309,7 @@ func (r *currentTimeVirtualAttributeResource) Create(ctx context.Context, req re
 
 		// Read the response
 		readCurrentTimeVirtualAttributeResponse(ctx, updateResponse.CurrentTimeVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -321,7 +334,12 @@ func (r *currentTimeVirtualAttributeResource) Read(ctx context.Context, req reso
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Current Time Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Current Time Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Current Time Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -331,6 +349,13 @@ func (r *currentTimeVirtualAttributeResource) Read(ctx context.Context, req reso
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CurrentTimeVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Current Time Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCurrentTimeVirtualAttributeResponse(ctx, readResponse.CurrentTimeVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -379,6 +404,7 @@ func (r *currentTimeVirtualAttributeResource) Update(ctx context.Context, req re
 
 		// Read the response
 		readCurrentTimeVirtualAttributeResponse(ctx, updateResponse.CurrentTimeVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -402,4 +428,5 @@ func (r *currentTimeVirtualAttributeResource) Delete(ctx context.Context, req re
 func (r *currentTimeVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
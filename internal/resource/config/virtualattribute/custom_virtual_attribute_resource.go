@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &customVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &customVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &customVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &customVirtualAttributeResource{}
 )
 
 // Create a Custom Virtual Attribute resource
@@ -185,6 +186,11 @@ func (r *customVirtualAttributeResource) Schema(ctx context.Context, req resourc
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *customVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a CustomVirtualAttributeResponse object into the model struct
 func readCustomVirtualAttributeResponse(ctx context.Context, r *client.CustomVirtualAttributeResponse, state *customVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -249,6 +255,12 @@ func (r *customVirtualAttributeResource) Create(ctx context.Context, req resourc
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CustomVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Custom Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state customVirtualAttributeResourceModel
 	readCustomVirtualAttributeResponse(ctx, readResponse.CustomVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -275,6 +287,7 @@ func (r *customVirtualAttributeResource) Create(ctx context.Context, req resourc
 
 		// Read the response
 		readCustomVirtualAttributeResponse(ctx, updateResponse.CustomVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -299,7 +312,12 @@ func (r *customVirtualAttributeResource) Read(ctx context.Context, req resource.
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Custom Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Custom Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -309,6 +327,13 @@ func (r *customVirtualAttributeResource) Read(ctx context.Context, req resource.
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.CustomVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Custom Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readCustomVirtualAttributeResponse(ctx, readResponse.CustomVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -357,6 +382,7 @@ func (r *customVirtualAttributeResource) Update(ctx context.Context, req resourc
 
 		// Read the response
 		readCustomVirtualAttributeResponse(ctx, updateResponse.CustomVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -380,4 +406,5 @@ func (r *customVirtualAttributeResource) Delete(ctx context.Context, req resourc
 func (r *customVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &dnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &dnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &dnJoinVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &dnJoinVirtualAttributeResource{}
 	_ resource.Resource                = &defaultDnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultDnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultDnJoinVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultDnJoinVirtualAttributeResource{}
 )
 
 // Create a Dn Join Virtual Attribute resource
@@ -255,6 +257,15 @@ func dnJoinVirtualAttributeSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *dnJoinVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultDnJoinVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalDnJoinVirtualAttributeFields(ctx context.Context, addRequest *client.AddDnJoinVirtualAttributeRequest, plan dnJoinVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -444,6 +455,7 @@ func (r *dnJoinVirtualAttributeResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state dnJoinVirtualAttributeResourceModel
 	readDnJoinVirtualAttributeResponse(ctx, addResponse.DnJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -482,6 +494,12 @@ func (r *defaultDnJoinVirtualAttributeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DnJoinVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Dn Join Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state dnJoinVirtualAttributeResourceModel
 	readDnJoinVirtualAttributeResponse(ctx, readResponse.DnJoinVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -508,6 +526,7 @@ func (r *defaultDnJoinVirtualAttributeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readDnJoinVirtualAttributeResponse(ctx, updateResponse.DnJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -540,7 +559,12 @@ func readDnJoinVirtualAttribute(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dn Join Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Dn Join Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Dn Join Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -550,6 +574,13 @@ func readDnJoinVirtualAttribute(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.DnJoinVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Dn Join Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readDnJoinVirtualAttributeResponse(ctx, readResponse.DnJoinVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -606,6 +637,7 @@ func updateDnJoinVirtualAttribute(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readDnJoinVirtualAttributeResponse(ctx, updateResponse.DnJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -654,4 +686,5 @@ func (r *defaultDnJoinVirtualAttributeResource) ImportState(ctx context.Context,
 func importDnJoinVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &entryChecksumVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &entryChecksumVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &entryChecksumVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &entryChecksumVirtualAttributeResource{}
 )
 
 // Create a Entry Checksum Virtual Attribute resource
@@ -204,6 +205,11 @@ func (r *entryChecksumVirtualAttributeResource) Schema(ctx context.Context, req
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *entryChecksumVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a EntryChecksumVirtualAttributeResponse object into the model struct
 func readEntryChecksumVirtualAttributeResponse(ctx context.Context, r *client.EntryChecksumVirtualAttributeResponse, state *entryChecksumVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -272,6 +278,12 @@ func (r *entryChecksumVirtualAttributeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EntryChecksumVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Entry Checksum Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state entryChecksumVirtualAttributeResourceModel
 	readEntryChecksumVirtualAttributeResponse(ctx, readResponse.EntryChecksumVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -298,6 +310,7 @@ func (r *entryChecksumVirtualAttributeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readEntryChecksumVirtualAttributeResponse(ctx, updateResponse.EntryChecksumVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -322,7 +335,12 @@ func (r *entryChecksumVirtualAttributeResource) Read(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Entry Checksum Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Entry Checksum Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Entry Checksum Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -332,6 +350,13 @@ func (r *entryChecksumVirtualAttributeResource) Read(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EntryChecksumVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Entry Checksum Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readEntryChecksumVirtualAttributeResponse(ctx, readResponse.EntryChecksumVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -380,6 +405,7 @@ func (r *entryChecksumVirtualAttributeResource) Update(ctx context.Context, req
 
 		// Read the response
 		readEntryChecksumVirtualAttributeResponse(ctx, updateResponse.EntryChecksumVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -403,4 +429,5 @@ func (r *entryChecksumVirtualAttributeResource) Delete(ctx context.Context, req
 func (r *entryChecksumVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
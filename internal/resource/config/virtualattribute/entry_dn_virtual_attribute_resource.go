@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &entryDnVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &entryDnVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &entryDnVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &entryDnVirtualAttributeResource{}
 	_ resource.Resource                = &defaultEntryDnVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultEntryDnVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultEntryDnVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultEntryDnVirtualAttributeResource{}
 )
 
 // Create a Entry Dn Virtual Attribute resource
@@ -210,6 +212,15 @@ func entryDnVirtualAttributeSchema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *entryDnVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultEntryDnVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalEntryDnVirtualAttributeFields(ctx context.Context, addRequest *client.AddEntryDnVirtualAttributeRequest, plan entryDnVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -354,6 +365,7 @@ func (r *entryDnVirtualAttributeResource) Create(ctx context.Context, req resour
 	// Read the response into the state
 	var state entryDnVirtualAttributeResourceModel
 	readEntryDnVirtualAttributeResponse(ctx, addResponse.EntryDnVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -392,6 +404,12 @@ func (r *defaultEntryDnVirtualAttributeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EntryDnVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Entry Dn Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state entryDnVirtualAttributeResourceModel
 	readEntryDnVirtualAttributeResponse(ctx, readResponse.EntryDnVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -418,6 +436,7 @@ func (r *defaultEntryDnVirtualAttributeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readEntryDnVirtualAttributeResponse(ctx, updateResponse.EntryDnVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -450,7 +469,12 @@ func readEntryDnVirtualAttribute(ctx context.Context, req resource.ReadRequest,
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Entry Dn Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Entry Dn Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Entry Dn Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -460,6 +484,13 @@ func readEntryDnVirtualAttribute(ctx context.Context, req resource.ReadRequest,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EntryDnVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Entry Dn Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readEntryDnVirtualAttributeResponse(ctx, readResponse.EntryDnVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -516,6 +547,7 @@ func updateEntryDnVirtualAttribute(ctx context.Context, req resource.UpdateReque
 
 		// Read the response
 		readEntryDnVirtualAttributeResponse(ctx, updateResponse.EntryDnVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -564,4 +596,5 @@ func (r *defaultEntryDnVirtualAttributeResource) ImportState(ctx context.Context
 func importEntryDnVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &equalityJoinVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &equalityJoinVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &equalityJoinVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &equalityJoinVirtualAttributeResource{}
 	_ resource.Resource                = &defaultEqualityJoinVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultEqualityJoinVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultEqualityJoinVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultEqualityJoinVirtualAttributeResource{}
 )
 
 // Create a Equality Join Virtual Attribute resource
@@ -269,6 +271,15 @@ func equalityJoinVirtualAttributeSchema(ctx context.Context, req resource.Schema
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *equalityJoinVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultEqualityJoinVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalEqualityJoinVirtualAttributeFields(ctx context.Context, addRequest *client.AddEqualityJoinVirtualAttributeRequest, plan equalityJoinVirtualAttributeResourceModel) error {
 	if internaltypes.IsDefined(plan.JoinMatchAll) {
@@ -467,6 +478,7 @@ func (r *equalityJoinVirtualAttributeResource) Create(ctx context.Context, req r
 	// Read the response into the state
 	var state equalityJoinVirtualAttributeResourceModel
 	readEqualityJoinVirtualAttributeResponse(ctx, addResponse.EqualityJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -505,6 +517,12 @@ func (r *defaultEqualityJoinVirtualAttributeResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EqualityJoinVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Equality Join Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state equalityJoinVirtualAttributeResourceModel
 	readEqualityJoinVirtualAttributeResponse(ctx, readResponse.EqualityJoinVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -531,6 +549,7 @@ func (r *defaultEqualityJoinVirtualAttributeResource) Create(ctx context.Context
 
 		// Read the response
 		readEqualityJoinVirtualAttributeResponse(ctx, updateResponse.EqualityJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -563,7 +582,12 @@ func readEqualityJoinVirtualAttribute(ctx context.Context, req resource.ReadRequ
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Equality Join Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Equality Join Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Equality Join Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -573,6 +597,13 @@ func readEqualityJoinVirtualAttribute(ctx context.Context, req resource.ReadRequ
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.EqualityJoinVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Equality Join Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readEqualityJoinVirtualAttributeResponse(ctx, readResponse.EqualityJoinVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -629,6 +660,7 @@ func updateEqualityJoinVirtualAttribute(ctx context.Context, req resource.Update
 
 		// Read the response
 		readEqualityJoinVirtualAttributeResponse(ctx, updateResponse.EqualityJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -677,4 +709,5 @@ func (r *defaultEqualityJoinVirtualAttributeResource) ImportState(ctx context.Co
 func importEqualityJoinVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &groovyScriptedVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &groovyScriptedVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &groovyScriptedVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &groovyScriptedVirtualAttributeResource{}
 	_ resource.Resource                = &defaultGroovyScriptedVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultGroovyScriptedVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultGroovyScriptedVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultGroovyScriptedVirtualAttributeResource{}
 )
 
 // Create a Groovy Scripted Virtual Attribute resource
@@ -221,6 +223,15 @@ func groovyScriptedVirtualAttributeSchema(ctx context.Context, req resource.Sche
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *groovyScriptedVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultGroovyScriptedVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalGroovyScriptedVirtualAttributeFields(ctx context.Context, addRequest *client.AddGroovyScriptedVirtualAttributeRequest, plan groovyScriptedVirtualAttributeResourceModel) error {
 	if internaltypes.IsDefined(plan.ScriptArgument) {
@@ -371,6 +382,7 @@ func (r *groovyScriptedVirtualAttributeResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state groovyScriptedVirtualAttributeResourceModel
 	readGroovyScriptedVirtualAttributeResponse(ctx, addResponse.GroovyScriptedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -409,6 +421,12 @@ func (r *defaultGroovyScriptedVirtualAttributeResource) Create(ctx context.Conte
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Groovy Scripted Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state groovyScriptedVirtualAttributeResourceModel
 	readGroovyScriptedVirtualAttributeResponse(ctx, readResponse.GroovyScriptedVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -435,6 +453,7 @@ func (r *defaultGroovyScriptedVirtualAttributeResource) Create(ctx context.Conte
 
 		// Read the response
 		readGroovyScriptedVirtualAttributeResponse(ctx, updateResponse.GroovyScriptedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -467,7 +486,12 @@ func readGroovyScriptedVirtualAttribute(ctx context.Context, req resource.ReadRe
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Groovy Scripted Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Groovy Scripted Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -477,6 +501,13 @@ func readGroovyScriptedVirtualAttribute(ctx context.Context, req resource.ReadRe
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.GroovyScriptedVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Groovy Scripted Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readGroovyScriptedVirtualAttributeResponse(ctx, readResponse.GroovyScriptedVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -533,6 +564,7 @@ func updateGroovyScriptedVirtualAttribute(ctx context.Context, req resource.Upda
 
 		// Read the response
 		readGroovyScriptedVirtualAttributeResponse(ctx, updateResponse.GroovyScriptedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -581,4 +613,5 @@ func (r *defaultGroovyScriptedVirtualAttributeResource) ImportState(ctx context.
 func importGroovyScriptedVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
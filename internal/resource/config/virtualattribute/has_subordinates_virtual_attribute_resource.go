@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &hasSubordinatesVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &hasSubordinatesVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &hasSubordinatesVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &hasSubordinatesVirtualAttributeResource{}
 )
 
 // Create a Has Subordinates Virtual Attribute resource
@@ -185,6 +186,11 @@ func (r *hasSubordinatesVirtualAttributeResource) Schema(ctx context.Context, re
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *hasSubordinatesVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a HasSubordinatesVirtualAttributeResponse object into the model struct
 func readHasSubordinatesVirtualAttributeResponse(ctx context.Context, r *client.HasSubordinatesVirtualAttributeResponse, state *hasSubordinatesVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -249,6 +255,12 @@ func (r *hasSubordinatesVirtualAttributeResource) Create(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HasSubordinatesVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Has Subordinates Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state hasSubordinatesVirtualAttributeResourceModel
 	readHasSubordinatesVirtualAttributeResponse(ctx, readResponse.HasSubordinatesVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -275,6 +287,7 @@ func (r *hasSubordinatesVirtualAttributeResource) Create(ctx context.Context, re
 
 		// Read the response
 		readHasSubordinatesVirtualAttributeResponse(ctx, updateResponse.HasSubordinatesVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -299,7 +312,12 @@ func (r *hasSubordinatesVirtualAttributeResource) Read(ctx context.Context, req
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Has Subordinates Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Has Subordinates Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Has Subordinates Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -309,6 +327,13 @@ func (r *hasSubordinatesVirtualAttributeResource) Read(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.HasSubordinatesVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Has Subordinates Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readHasSubordinatesVirtualAttributeResponse(ctx, readResponse.HasSubordinatesVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -357,6 +382,7 @@ func (r *hasSubordinatesVirtualAttributeResource) Update(ctx context.Context, re
 
 		// Read the response
 		readHasSubordinatesVirtualAttributeResponse(ctx, updateResponse.HasSubordinatesVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -380,4 +406,5 @@ func (r *hasSubordinatesVirtualAttributeResource) Delete(ctx context.Context, re
 func (r *hasSubordinatesVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &identifyReferencesVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &identifyReferencesVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &identifyReferencesVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &identifyReferencesVirtualAttributeResource{}
 	_ resource.Resource                = &defaultIdentifyReferencesVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultIdentifyReferencesVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultIdentifyReferencesVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultIdentifyReferencesVirtualAttributeResource{}
 )
 
 // Create a Identify References Virtual Attribute resource
@@ -222,6 +224,15 @@ func identifyReferencesVirtualAttributeSchema(ctx context.Context, req resource.
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *identifyReferencesVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultIdentifyReferencesVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalIdentifyReferencesVirtualAttributeFields(ctx context.Context, addRequest *client.AddIdentifyReferencesVirtualAttributeRequest, plan identifyReferencesVirtualAttributeResourceModel) error {
 	if internaltypes.IsDefined(plan.ReferenceSearchBaseDN) {
@@ -374,6 +385,7 @@ func (r *identifyReferencesVirtualAttributeResource) Create(ctx context.Context,
 	// Read the response into the state
 	var state identifyReferencesVirtualAttributeResourceModel
 	readIdentifyReferencesVirtualAttributeResponse(ctx, addResponse.IdentifyReferencesVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -412,6 +424,12 @@ func (r *defaultIdentifyReferencesVirtualAttributeResource) Create(ctx context.C
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.IdentifyReferencesVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Identify References Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state identifyReferencesVirtualAttributeResourceModel
 	readIdentifyReferencesVirtualAttributeResponse(ctx, readResponse.IdentifyReferencesVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -438,6 +456,7 @@ func (r *defaultIdentifyReferencesVirtualAttributeResource) Create(ctx context.C
 
 		// Read the response
 		readIdentifyReferencesVirtualAttributeResponse(ctx, updateResponse.IdentifyReferencesVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -470,7 +489,12 @@ func readIdentifyReferencesVirtualAttribute(ctx context.Context, req resource.Re
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Identify References Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Identify References Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Identify References Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -480,6 +504,13 @@ func readIdentifyReferencesVirtualAttribute(ctx context.Context, req resource.Re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.IdentifyReferencesVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Identify References Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readIdentifyReferencesVirtualAttributeResponse(ctx, readResponse.IdentifyReferencesVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -536,6 +567,7 @@ func updateIdentifyReferencesVirtualAttribute(ctx context.Context, req resource.
 
 		// Read the response
 		readIdentifyReferencesVirtualAttributeResponse(ctx, updateResponse.IdentifyReferencesVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -584,4 +616,5 @@ func (r *defaultIdentifyReferencesVirtualAttributeResource) ImportState(ctx cont
 func importIdentifyReferencesVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
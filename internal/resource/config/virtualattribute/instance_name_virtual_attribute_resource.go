@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &instanceNameVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &instanceNameVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &instanceNameVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceNameVirtualAttributeResource{}
 )
 
 // Create a Instance Name Virtual Attribute resource
@@ -185,6 +186,11 @@ func (r *instanceNameVirtualAttributeResource) Schema(ctx context.Context, req r
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *instanceNameVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a InstanceNameVirtualAttributeResponse object into the model struct
 func readInstanceNameVirtualAttributeResponse(ctx context.Context, r *client.InstanceNameVirtualAttributeResponse, state *instanceNameVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -249,6 +255,12 @@ func (r *instanceNameVirtualAttributeResource) Create(ctx context.Context, req r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.InstanceNameVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Instance Name Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state instanceNameVirtualAttributeResourceModel
 	readInstanceNameVirtualAttributeResponse(ctx, readResponse.InstanceNameVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -275,6 +287,7 @@ func (r *instanceNameVirtualAttributeResource) Create(ctx context.Context, req r
 
 		// Read the response
 		readInstanceNameVirtualAttributeResponse(ctx, updateResponse.InstanceNameVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -299,7 +312,12 @@ func (r *instanceNameVirtualAttributeResource) Read(ctx context.Context, req res
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Instance Name Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Instance Name Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Instance Name Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -309,6 +327,13 @@ func (r *instanceNameVirtualAttributeResource) Read(ctx context.Context, req res
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.InstanceNameVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Instance Name Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readInstanceNameVirtualAttributeResponse(ctx, readResponse.InstanceNameVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -357,6 +382,7 @@ func (r *instanceNameVirtualAttributeResource) Update(ctx context.Context, req r
 
 		// Read the response
 		readInstanceNameVirtualAttributeResponse(ctx, updateResponse.InstanceNameVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -380,4 +406,5 @@ func (r *instanceNameVirtualAttributeResource) Delete(ctx context.Context, req r
 func (r *instanceNameVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
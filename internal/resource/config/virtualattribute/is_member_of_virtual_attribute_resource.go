@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &isMemberOfVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &isMemberOfVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &isMemberOfVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &isMemberOfVirtualAttributeResource{}
 	_ resource.Resource                = &defaultIsMemberOfVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultIsMemberOfVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultIsMemberOfVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultIsMemberOfVirtualAttributeResource{}
 )
 
 // Create a Is Member Of Virtual Attribute resource
@@ -233,6 +235,15 @@ func isMemberOfVirtualAttributeSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *isMemberOfVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultIsMemberOfVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalIsMemberOfVirtualAttributeFields(ctx context.Context, addRequest *client.AddIsMemberOfVirtualAttributeRequest, plan isMemberOfVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -401,6 +412,7 @@ func (r *isMemberOfVirtualAttributeResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state isMemberOfVirtualAttributeResourceModel
 	readIsMemberOfVirtualAttributeResponse(ctx, addResponse.IsMemberOfVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -439,6 +451,12 @@ func (r *defaultIsMemberOfVirtualAttributeResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.IsMemberOfVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Is Member Of Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state isMemberOfVirtualAttributeResourceModel
 	readIsMemberOfVirtualAttributeResponse(ctx, readResponse.IsMemberOfVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -465,6 +483,7 @@ func (r *defaultIsMemberOfVirtualAttributeResource) Create(ctx context.Context,
 
 		// Read the response
 		readIsMemberOfVirtualAttributeResponse(ctx, updateResponse.IsMemberOfVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -497,7 +516,12 @@ func readIsMemberOfVirtualAttribute(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Is Member Of Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Is Member Of Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Is Member Of Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -507,6 +531,13 @@ func readIsMemberOfVirtualAttribute(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.IsMemberOfVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Is Member Of Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readIsMemberOfVirtualAttributeResponse(ctx, readResponse.IsMemberOfVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -563,6 +594,7 @@ func updateIsMemberOfVirtualAttribute(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readIsMemberOfVirtualAttributeResponse(ctx, updateResponse.IsMemberOfVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -611,4 +643,5 @@ func (r *defaultIsMemberOfVirtualAttributeResource) ImportState(ctx context.Cont
 func importIsMemberOfVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
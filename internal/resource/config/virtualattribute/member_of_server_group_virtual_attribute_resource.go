@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &memberOfServerGroupVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &memberOfServerGroupVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &memberOfServerGroupVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &memberOfServerGroupVirtualAttributeResource{}
 )
 
 // Create a Member Of Server Group Virtual Attribute resource
@@ -185,6 +186,11 @@ func (r *memberOfServerGroupVirtualAttributeResource) Schema(ctx context.Context
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *memberOfServerGroupVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a MemberOfServerGroupVirtualAttributeResponse object into the model struct
 func readMemberOfServerGroupVirtualAttributeResponse(ctx context.Context, r *client.MemberOfServerGroupVirtualAttributeResponse, state *memberOfServerGroupVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -249,6 +255,12 @@ func (r *memberOfServerGroupVirtualAttributeResource) Create(ctx context.Context
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MemberOfServerGroupVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Member Of Server Group Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state memberOfServerGroupVirtualAttributeResourceModel
 	readMemberOfServerGroupVirtualAttributeResponse(ctx, readResponse.MemberOfServerGroupVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -275,6 +287,7 @@ func (r *memberOfServerGroupVirtualAttributeResource) Create(ctx context.Context
 
 		// Read the response
 		readMemberOfServerGroupVirtualAttributeResponse(ctx, updateResponse.MemberOfServerGroupVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -299,7 +312,12 @@ func (r *memberOfServerGroupVirtualAttributeResource) Read(ctx context.Context,
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Member Of Server Group Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Member Of Server Group Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Member Of Server Group Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -309,6 +327,13 @@ func (r *memberOfServerGroupVirtualAttributeResource) Read(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MemberOfServerGroupVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Member Of Server Group Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMemberOfServerGroupVirtualAttributeResponse(ctx, readResponse.MemberOfServerGroupVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -357,6 +382,7 @@ func (r *memberOfServerGroupVirtualAttributeResource) Update(ctx context.Context
 
 		// Read the response
 		readMemberOfServerGroupVirtualAttributeResponse(ctx, updateResponse.MemberOfServerGroupVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -380,4 +406,5 @@ func (r *memberOfServerGroupVirtualAttributeResource) Delete(ctx context.Context
 func (r *memberOfServerGroupVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &memberVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &memberVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &memberVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &memberVirtualAttributeResource{}
 	_ resource.Resource                = &defaultMemberVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultMemberVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultMemberVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultMemberVirtualAttributeResource{}
 )
 
 // Create a Member Virtual Attribute resource
@@ -215,6 +217,15 @@ func memberVirtualAttributeSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *memberVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultMemberVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalMemberVirtualAttributeFields(ctx context.Context, addRequest *client.AddMemberVirtualAttributeRequest, plan memberVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -361,6 +372,7 @@ func (r *memberVirtualAttributeResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state memberVirtualAttributeResourceModel
 	readMemberVirtualAttributeResponse(ctx, addResponse.MemberVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -399,6 +411,12 @@ func (r *defaultMemberVirtualAttributeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MemberVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Member Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state memberVirtualAttributeResourceModel
 	readMemberVirtualAttributeResponse(ctx, readResponse.MemberVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -425,6 +443,7 @@ func (r *defaultMemberVirtualAttributeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readMemberVirtualAttributeResponse(ctx, updateResponse.MemberVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -457,7 +476,12 @@ func readMemberVirtualAttribute(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Member Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Member Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Member Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -467,6 +491,13 @@ func readMemberVirtualAttribute(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MemberVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Member Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMemberVirtualAttributeResponse(ctx, readResponse.MemberVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -523,6 +554,7 @@ func updateMemberVirtualAttribute(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readMemberVirtualAttributeResponse(ctx, updateResponse.MemberVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -571,4 +603,5 @@ func (r *defaultMemberVirtualAttributeResource) ImportState(ctx context.Context,
 func importMemberVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &mirrorVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &mirrorVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &mirrorVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &mirrorVirtualAttributeResource{}
 	_ resource.Resource                = &defaultMirrorVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultMirrorVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultMirrorVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultMirrorVirtualAttributeResource{}
 )
 
 // Create a Mirror Virtual Attribute resource
@@ -230,6 +232,15 @@ func mirrorVirtualAttributeSchema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *mirrorVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultMirrorVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalMirrorVirtualAttributeFields(ctx context.Context, addRequest *client.AddMirrorVirtualAttributeRequest, plan mirrorVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -393,6 +404,7 @@ func (r *mirrorVirtualAttributeResource) Create(ctx context.Context, req resourc
 	// Read the response into the state
 	var state mirrorVirtualAttributeResourceModel
 	readMirrorVirtualAttributeResponse(ctx, addResponse.MirrorVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -431,6 +443,12 @@ func (r *defaultMirrorVirtualAttributeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MirrorVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Mirror Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state mirrorVirtualAttributeResourceModel
 	readMirrorVirtualAttributeResponse(ctx, readResponse.MirrorVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -457,6 +475,7 @@ func (r *defaultMirrorVirtualAttributeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readMirrorVirtualAttributeResponse(ctx, updateResponse.MirrorVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -489,7 +508,12 @@ func readMirrorVirtualAttribute(ctx context.Context, req resource.ReadRequest, r
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Mirror Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Mirror Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Mirror Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -499,6 +523,13 @@ func readMirrorVirtualAttribute(ctx context.Context, req resource.ReadRequest, r
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.MirrorVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Mirror Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readMirrorVirtualAttributeResponse(ctx, readResponse.MirrorVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -555,6 +586,7 @@ func updateMirrorVirtualAttribute(ctx context.Context, req resource.UpdateReques
 
 		// Read the response
 		readMirrorVirtualAttributeResponse(ctx, updateResponse.MirrorVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -603,4 +635,5 @@ func (r *defaultMirrorVirtualAttributeResource) ImportState(ctx context.Context,
 func importMirrorVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
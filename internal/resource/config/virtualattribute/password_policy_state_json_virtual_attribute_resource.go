@@ -24,9 +24,11 @@ var (
 	_ resource.Resource                = &passwordPolicyStateJsonVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &passwordPolicyStateJsonVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &passwordPolicyStateJsonVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &passwordPolicyStateJsonVirtualAttributeResource{}
 	_ resource.Resource                = &defaultPasswordPolicyStateJsonVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultPasswordPolicyStateJsonVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultPasswordPolicyStateJsonVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultPasswordPolicyStateJsonVirtualAttributeResource{}
 )
 
 // Create a Password Policy State Json Virtual Attribute resource
@@ -173,6 +175,15 @@ func passwordPolicyStateJsonVirtualAttributeSchema(ctx context.Context, req reso
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *passwordPolicyStateJsonVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultPasswordPolicyStateJsonVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalPasswordPolicyStateJsonVirtualAttributeFields(ctx context.Context, addRequest *client.AddPasswordPolicyStateJsonVirtualAttributeRequest, plan passwordPolicyStateJsonVirtualAttributeResourceModel) {
 	// Empty strings are treated as equivalent to null
@@ -277,6 +288,7 @@ func (r *passwordPolicyStateJsonVirtualAttributeResource) Create(ctx context.Con
 	// Read the response into the state
 	var state passwordPolicyStateJsonVirtualAttributeResourceModel
 	readPasswordPolicyStateJsonVirtualAttributeResponse(ctx, addResponse.PasswordPolicyStateJsonVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -315,6 +327,12 @@ func (r *defaultPasswordPolicyStateJsonVirtualAttributeResource) Create(ctx cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PasswordPolicyStateJsonVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Password Policy State Json Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state passwordPolicyStateJsonVirtualAttributeResourceModel
 	readPasswordPolicyStateJsonVirtualAttributeResponse(ctx, readResponse.PasswordPolicyStateJsonVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -341,6 +359,7 @@ func (r *defaultPasswordPolicyStateJsonVirtualAttributeResource) Create(ctx cont
 
 		// Read the response
 		readPasswordPolicyStateJsonVirtualAttributeResponse(ctx, updateResponse.PasswordPolicyStateJsonVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -373,7 +392,12 @@ func readPasswordPolicyStateJsonVirtualAttribute(ctx context.Context, req resour
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Policy State Json Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Password Policy State Json Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Password Policy State Json Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -383,6 +407,13 @@ func readPasswordPolicyStateJsonVirtualAttribute(ctx context.Context, req resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.PasswordPolicyStateJsonVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Password Policy State Json Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readPasswordPolicyStateJsonVirtualAttributeResponse(ctx, readResponse.PasswordPolicyStateJsonVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -439,6 +470,7 @@ func updatePasswordPolicyStateJsonVirtualAttribute(ctx context.Context, req reso
 
 		// Read the response
 		readPasswordPolicyStateJsonVirtualAttributeResponse(ctx, updateResponse.PasswordPolicyStateJsonVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -487,4 +519,5 @@ func (r *defaultPasswordPolicyStateJsonVirtualAttributeResource) ImportState(ctx
 func importPasswordPolicyStateJsonVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                = &replicationStateDetailVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &replicationStateDetailVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &replicationStateDetailVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &replicationStateDetailVirtualAttributeResource{}
 )
 
 // Create a Replication State Detail Virtual Attribute resource
@@ -88,6 +89,11 @@ func (r *replicationStateDetailVirtualAttributeResource) Schema(ctx context.Cont
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *replicationStateDetailVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ReplicationStateDetailVirtualAttributeResponse object into the model struct
 func readReplicationStateDetailVirtualAttributeResponse(ctx context.Context, r *client.ReplicationStateDetailVirtualAttributeResponse, state *replicationStateDetailVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -130,6 +136,12 @@ func (r *replicationStateDetailVirtualAttributeResource) Create(ctx context.Cont
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReplicationStateDetailVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Replication State Detail Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state replicationStateDetailVirtualAttributeResourceModel
 	readReplicationStateDetailVirtualAttributeResponse(ctx, readResponse.ReplicationStateDetailVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -156,6 +168,7 @@ func (r *replicationStateDetailVirtualAttributeResource) Create(ctx context.Cont
 
 		// Read the response
 		readReplicationStateDetailVirtualAttributeResponse(ctx, updateResponse.ReplicationStateDetailVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -180,7 +193,12 @@ func (r *replicationStateDetailVirtualAttributeResource) Read(ctx context.Contex
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication State Detail Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Replication State Detail Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Replication State Detail Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -190,6 +208,13 @@ func (r *replicationStateDetailVirtualAttributeResource) Read(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReplicationStateDetailVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Replication State Detail Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readReplicationStateDetailVirtualAttributeResponse(ctx, readResponse.ReplicationStateDetailVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -238,6 +263,7 @@ func (r *replicationStateDetailVirtualAttributeResource) Update(ctx context.Cont
 
 		// Read the response
 		readReplicationStateDetailVirtualAttributeResponse(ctx, updateResponse.ReplicationStateDetailVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -261,4 +287,5 @@ func (r *replicationStateDetailVirtualAttributeResource) Delete(ctx context.Cont
 func (r *replicationStateDetailVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
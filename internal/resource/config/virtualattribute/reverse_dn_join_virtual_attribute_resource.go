@@ -26,9 +26,11 @@ var (
 	_ resource.Resource                = &reverseDnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &reverseDnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &reverseDnJoinVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &reverseDnJoinVirtualAttributeResource{}
 	_ resource.Resource                = &defaultReverseDnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultReverseDnJoinVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultReverseDnJoinVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultReverseDnJoinVirtualAttributeResource{}
 )
 
 // Create a Reverse Dn Join Virtual Attribute resource
@@ -255,6 +257,15 @@ func reverseDnJoinVirtualAttributeSchema(ctx context.Context, req resource.Schem
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *reverseDnJoinVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultReverseDnJoinVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalReverseDnJoinVirtualAttributeFields(ctx context.Context, addRequest *client.AddReverseDnJoinVirtualAttributeRequest, plan reverseDnJoinVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -444,6 +455,7 @@ func (r *reverseDnJoinVirtualAttributeResource) Create(ctx context.Context, req
 	// Read the response into the state
 	var state reverseDnJoinVirtualAttributeResourceModel
 	readReverseDnJoinVirtualAttributeResponse(ctx, addResponse.ReverseDnJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -482,6 +494,12 @@ func (r *defaultReverseDnJoinVirtualAttributeResource) Create(ctx context.Contex
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReverseDnJoinVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Reverse Dn Join Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state reverseDnJoinVirtualAttributeResourceModel
 	readReverseDnJoinVirtualAttributeResponse(ctx, readResponse.ReverseDnJoinVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -508,6 +526,7 @@ func (r *defaultReverseDnJoinVirtualAttributeResource) Create(ctx context.Contex
 
 		// Read the response
 		readReverseDnJoinVirtualAttributeResponse(ctx, updateResponse.ReverseDnJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -540,7 +559,12 @@ func readReverseDnJoinVirtualAttribute(ctx context.Context, req resource.ReadReq
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Reverse Dn Join Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Reverse Dn Join Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Reverse Dn Join Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -550,6 +574,13 @@ func readReverseDnJoinVirtualAttribute(ctx context.Context, req resource.ReadReq
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ReverseDnJoinVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Reverse Dn Join Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readReverseDnJoinVirtualAttributeResponse(ctx, readResponse.ReverseDnJoinVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -606,6 +637,7 @@ func updateReverseDnJoinVirtualAttribute(ctx context.Context, req resource.Updat
 
 		// Read the response
 		readReverseDnJoinVirtualAttributeResponse(ctx, updateResponse.ReverseDnJoinVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -654,4 +686,5 @@ func (r *defaultReverseDnJoinVirtualAttributeResource) ImportState(ctx context.C
 func importReverseDnJoinVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
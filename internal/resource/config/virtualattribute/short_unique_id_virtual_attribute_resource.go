@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &shortUniqueIdVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &shortUniqueIdVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &shortUniqueIdVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &shortUniqueIdVirtualAttributeResource{}
 )
 
 // Create a Short Unique Id Virtual Attribute resource
@@ -176,6 +177,11 @@ func (r *shortUniqueIdVirtualAttributeResource) Schema(ctx context.Context, req
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *shortUniqueIdVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a ShortUniqueIdVirtualAttributeResponse object into the model struct
 func readShortUniqueIdVirtualAttributeResponse(ctx context.Context, r *client.ShortUniqueIdVirtualAttributeResponse, state *shortUniqueIdVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -237,6 +243,12 @@ func (r *shortUniqueIdVirtualAttributeResource) Create(ctx context.Context, req
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ShortUniqueIdVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Short Unique Id Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state shortUniqueIdVirtualAttributeResourceModel
 	readShortUniqueIdVirtualAttributeResponse(ctx, readResponse.ShortUniqueIdVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -263,6 +275,7 @@ func (r *shortUniqueIdVirtualAttributeResource) Create(ctx context.Context, req
 
 		// Read the response
 		readShortUniqueIdVirtualAttributeResponse(ctx, updateResponse.ShortUniqueIdVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -287,7 +300,12 @@ func (r *shortUniqueIdVirtualAttributeResource) Read(ctx context.Context, req re
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Short Unique Id Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Short Unique Id Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Short Unique Id Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -297,6 +315,13 @@ func (r *shortUniqueIdVirtualAttributeResource) Read(ctx context.Context, req re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ShortUniqueIdVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Short Unique Id Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readShortUniqueIdVirtualAttributeResponse(ctx, readResponse.ShortUniqueIdVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -345,6 +370,7 @@ func (r *shortUniqueIdVirtualAttributeResource) Update(ctx context.Context, req
 
 		// Read the response
 		readShortUniqueIdVirtualAttributeResponse(ctx, updateResponse.ShortUniqueIdVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -368,4 +394,5 @@ func (r *shortUniqueIdVirtualAttributeResource) Delete(ctx context.Context, req
 func (r *shortUniqueIdVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &subschemaSubentryVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &subschemaSubentryVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &subschemaSubentryVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &subschemaSubentryVirtualAttributeResource{}
 )
 
 // Create a Subschema Subentry Virtual Attribute resource
@@ -185,6 +186,11 @@ func (r *subschemaSubentryVirtualAttributeResource) Schema(ctx context.Context,
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *subschemaSubentryVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
 // Read a SubschemaSubentryVirtualAttributeResponse object into the model struct
 func readSubschemaSubentryVirtualAttributeResponse(ctx context.Context, r *client.SubschemaSubentryVirtualAttributeResponse, state *subschemaSubentryVirtualAttributeResourceModel, diagnostics *diag.Diagnostics) {
 	state.Id = types.StringValue(r.Id)
@@ -249,6 +255,12 @@ func (r *subschemaSubentryVirtualAttributeResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SubschemaSubentryVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Subschema Subentry Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state subschemaSubentryVirtualAttributeResourceModel
 	readSubschemaSubentryVirtualAttributeResponse(ctx, readResponse.SubschemaSubentryVirtualAttributeResponse, &state, &resp.Diagnostics)
@@ -275,6 +287,7 @@ func (r *subschemaSubentryVirtualAttributeResource) Create(ctx context.Context,
 
 		// Read the response
 		readSubschemaSubentryVirtualAttributeResponse(ctx, updateResponse.SubschemaSubentryVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -299,7 +312,12 @@ func (r *subschemaSubentryVirtualAttributeResource) Read(ctx context.Context, re
 	readResponse, httpResp, err := r.apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, r.providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Subschema Subentry Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Subschema Subentry Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Subschema Subentry Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -309,6 +327,13 @@ func (r *subschemaSubentryVirtualAttributeResource) Read(ctx context.Context, re
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.SubschemaSubentryVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Subschema Subentry Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readSubschemaSubentryVirtualAttributeResponse(ctx, readResponse.SubschemaSubentryVirtualAttributeResponse, &state, &resp.Diagnostics)
 
@@ -357,6 +382,7 @@ func (r *subschemaSubentryVirtualAttributeResource) Update(ctx context.Context,
 
 		// Read the response
 		readSubschemaSubentryVirtualAttributeResponse(ctx, updateResponse.SubschemaSubentryVirtualAttributeResponse, &state, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -380,4 +406,5 @@ func (r *subschemaSubentryVirtualAttributeResource) Delete(ctx context.Context,
 func (r *subschemaSubentryVirtualAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &thirdPartyVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &thirdPartyVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &thirdPartyVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &thirdPartyVirtualAttributeResource{}
 	_ resource.Resource                = &defaultThirdPartyVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultThirdPartyVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultThirdPartyVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultThirdPartyVirtualAttributeResource{}
 )
 
 // Create a Third Party Virtual Attribute resource
@@ -221,6 +223,15 @@ func thirdPartyVirtualAttributeSchema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *thirdPartyVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultThirdPartyVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalThirdPartyVirtualAttributeFields(ctx context.Context, addRequest *client.AddThirdPartyVirtualAttributeRequest, plan thirdPartyVirtualAttributeResourceModel) error {
 	if internaltypes.IsDefined(plan.ExtensionArgument) {
@@ -371,6 +382,7 @@ func (r *thirdPartyVirtualAttributeResource) Create(ctx context.Context, req res
 	// Read the response into the state
 	var state thirdPartyVirtualAttributeResourceModel
 	readThirdPartyVirtualAttributeResponse(ctx, addResponse.ThirdPartyVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -409,6 +421,12 @@ func (r *defaultThirdPartyVirtualAttributeResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "Third Party Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state thirdPartyVirtualAttributeResourceModel
 	readThirdPartyVirtualAttributeResponse(ctx, readResponse.ThirdPartyVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -435,6 +453,7 @@ func (r *defaultThirdPartyVirtualAttributeResource) Create(ctx context.Context,
 
 		// Read the response
 		readThirdPartyVirtualAttributeResponse(ctx, updateResponse.ThirdPartyVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -467,7 +486,12 @@ func readThirdPartyVirtualAttribute(ctx context.Context, req resource.ReadReques
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Third Party Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the Third Party Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -477,6 +501,13 @@ func readThirdPartyVirtualAttribute(ctx context.Context, req resource.ReadReques
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.ThirdPartyVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "Third Party Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readThirdPartyVirtualAttributeResponse(ctx, readResponse.ThirdPartyVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -533,6 +564,7 @@ func updateThirdPartyVirtualAttribute(ctx context.Context, req resource.UpdateRe
 
 		// Read the response
 		readThirdPartyVirtualAttributeResponse(ctx, updateResponse.ThirdPartyVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -581,4 +613,5 @@ func (r *defaultThirdPartyVirtualAttributeResource) ImportState(ctx context.Cont
 func importThirdPartyVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
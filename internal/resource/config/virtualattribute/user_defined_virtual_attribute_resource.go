@@ -25,9 +25,11 @@ var (
 	_ resource.Resource                = &userDefinedVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &userDefinedVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &userDefinedVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &userDefinedVirtualAttributeResource{}
 	_ resource.Resource                = &defaultUserDefinedVirtualAttributeResource{}
 	_ resource.ResourceWithConfigure   = &defaultUserDefinedVirtualAttributeResource{}
 	_ resource.ResourceWithImportState = &defaultUserDefinedVirtualAttributeResource{}
+	_ resource.ResourceWithModifyPlan  = &defaultUserDefinedVirtualAttributeResource{}
 )
 
 // Create a User Defined Virtual Attribute resource
@@ -212,6 +214,15 @@ func userDefinedVirtualAttributeSchema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema
 }
 
+// Plan a replacement if the config object has been replaced with one of a different type
+func (r *userDefinedVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, false)
+}
+
+func (r *defaultUserDefinedVirtualAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	config.ModifyPlanForResourceTypeMismatch(ctx, req, resp, true)
+}
+
 // Add optional fields to create request
 func addOptionalUserDefinedVirtualAttributeFields(ctx context.Context, addRequest *client.AddUserDefinedVirtualAttributeRequest, plan userDefinedVirtualAttributeResourceModel) error {
 	// Empty strings are treated as equivalent to null
@@ -357,6 +368,7 @@ func (r *userDefinedVirtualAttributeResource) Create(ctx context.Context, req re
 	// Read the response into the state
 	var state userDefinedVirtualAttributeResourceModel
 	readUserDefinedVirtualAttributeResponse(ctx, addResponse.UserDefinedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+	config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 
 	// Populate Computed attribute values
 	state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
@@ -395,6 +407,12 @@ func (r *defaultUserDefinedVirtualAttributeResource) Create(ctx context.Context,
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.UserDefinedVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchError(ctx, &resp.Diagnostics, "User Defined Virtual Attribute", plan.Id.ValueString(), httpResp)
+		return
+	}
+
 	// Read the existing configuration
 	var state userDefinedVirtualAttributeResourceModel
 	readUserDefinedVirtualAttributeResponse(ctx, readResponse.UserDefinedVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
@@ -421,6 +439,7 @@ func (r *defaultUserDefinedVirtualAttributeResource) Create(ctx context.Context,
 
 		// Read the response
 		readUserDefinedVirtualAttributeResponse(ctx, updateResponse.UserDefinedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActionsOnCreate(ctx, r.providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	}
@@ -453,7 +472,12 @@ func readUserDefinedVirtualAttribute(ctx context.Context, req resource.ReadReque
 	readResponse, httpResp, err := apiClient.VirtualAttributeApi.GetVirtualAttribute(
 		config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the User Defined Virtual Attribute", err, httpResp)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "User Defined Virtual Attribute", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the User Defined Virtual Attribute", err, httpResp)
+		}
 		return
 	}
 
@@ -463,6 +487,13 @@ func readUserDefinedVirtualAttribute(ctx context.Context, req resource.ReadReque
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// Verify the config object has the expected type
+	if readResponse.UserDefinedVirtualAttributeResponse == nil {
+		config.AddResourceTypeMismatchReadDiagnostics(ctx, resp.Private, &resp.Diagnostics, "User Defined Virtual Attribute", state.Id.ValueString(), httpResp)
+		return
+	}
+	config.SetResourceTypeMatched(ctx, resp.Private, &resp.Diagnostics)
+
 	// Read the response into the state
 	readUserDefinedVirtualAttributeResponse(ctx, readResponse.UserDefinedVirtualAttributeResponse, &state, &state, &resp.Diagnostics)
 
@@ -519,6 +550,7 @@ func updateUserDefinedVirtualAttribute(ctx context.Context, req resource.UpdateR
 
 		// Read the response
 		readUserDefinedVirtualAttributeResponse(ctx, updateResponse.UserDefinedVirtualAttributeResponse, &state, &plan, &resp.Diagnostics)
+		config.CheckRequiredActions(ctx, providerConfig, state.RequiredActions, &resp.Diagnostics)
 		// Update computed values
 		state.LastUpdated = types.StringValue(string(time.Now().Format(time.RFC850)))
 	} else {
@@ -567,4 +599,5 @@ func (r *defaultUserDefinedVirtualAttributeResource) ImportState(ctx context.Con
 func importUserDefinedVirtualAttribute(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	config.SetResourceImported(ctx, resp.Private, &resp.Diagnostics)
 }
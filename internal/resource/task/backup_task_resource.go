@@ -0,0 +1,226 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &backupTaskResource{}
+	_ resource.ResourceWithConfigure = &backupTaskResource{}
+)
+
+// Create a Backup Task resource
+func NewBackupTaskResource() resource.Resource {
+	return &backupTaskResource{}
+}
+
+// backupTaskResource is the resource implementation.
+type backupTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *backupTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type backupTaskResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	TaskState       types.String `tfsdk:"task_state"`
+	LogMessages     types.List   `tfsdk:"log_messages"`
+	WaitTimeout     types.String `tfsdk:"wait_timeout"`
+	BackupDirectory types.String `tfsdk:"backup_directory"`
+	BackendID       types.Set    `tfsdk:"backend_id"`
+	BackupAll       types.Bool   `tfsdk:"backup_all"`
+	Incremental     types.Bool   `tfsdk:"incremental"`
+	Compress        types.Bool   `tfsdk:"compress"`
+	Encrypt         types.Bool   `tfsdk:"encrypt"`
+	Hash            types.Bool   `tfsdk:"hash"`
+	SignHash        types.Bool   `tfsdk:"sign_hash"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *backupTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that backs up one or more backends, and waits for it to finish.",
+		Attributes: map[string]schema.Attribute{
+			"backup_directory": schema.StringAttribute{
+				Description: "The path to the directory in which the backup should be written. Relative paths are relative to the server root.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backend_id": schema.SetAttribute{
+				Description: "The backend IDs of the backends to back up. Exactly one of backend_id or backup_all should be set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_all": schema.BoolAttribute{
+				Description: "Indicates whether to back up all backends that support backups.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"incremental": schema.BoolAttribute{
+				Description: "Indicates whether to create an incremental backup rather than a full backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"compress": schema.BoolAttribute{
+				Description: "Indicates whether to compress the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypt": schema.BoolAttribute{
+				Description: "Indicates whether to encrypt the backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.BoolAttribute{
+				Description: "Indicates whether to generate a hash of the backup contents that can be used to verify its integrity.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sign_hash": schema.BoolAttribute{
+				Description: "Indicates whether to digitally sign the hash of the backup contents.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Build the task-specific attributes of the task entry
+func backupTaskAttributes(ctx context.Context, plan backupTaskResourceModel) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	attributes["ds-backup-directory-path"] = plan.BackupDirectory.ValueString()
+	if internaltypes.IsDefined(plan.BackendID) {
+		var values []string
+		plan.BackendID.ElementsAs(ctx, &values, false)
+		attributes["ds-task-backup-backend-id"] = values
+	}
+	if internaltypes.IsDefined(plan.BackupAll) {
+		attributes["ds-task-backup-all"] = taskBoolValue(plan.BackupAll)
+	}
+	if internaltypes.IsDefined(plan.Incremental) {
+		attributes["ds-task-backup-incremental"] = taskBoolValue(plan.Incremental)
+	}
+	if internaltypes.IsDefined(plan.Compress) {
+		attributes["ds-task-backup-compress"] = taskBoolValue(plan.Compress)
+	}
+	if internaltypes.IsDefined(plan.Encrypt) {
+		attributes["ds-task-backup-encrypt"] = taskBoolValue(plan.Encrypt)
+	}
+	if internaltypes.IsDefined(plan.Hash) {
+		attributes["ds-task-backup-hash"] = taskBoolValue(plan.Hash)
+	}
+	if internaltypes.IsDefined(plan.SignHash) {
+		attributes["ds-task-backup-sign-hash"] = taskBoolValue(plan.SignHash)
+	}
+	return attributes
+}
+
+// Create a new resource
+func (r *backupTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan backupTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-backup")
+	status := runTask(ctx, r.client, taskId, "ds-task-backup", "com.unboundid.directory.server.tasks.BackupTask",
+		backupTaskAttributes(ctx, plan), plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *backupTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state backupTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *backupTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *backupTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the Backup Task from state. The task entry is left for the server to clean up.")
+}
@@ -0,0 +1,122 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Time to wait for a task to finish when the wait_timeout attribute is not set
+const defaultTaskWaitTimeout = "1h"
+
+// Add the attributes shared by all task resources to the schema. A task runs once when the resource is created.
+// Any change to the task's own attributes or to the triggers map replaces the resource, which runs the task again.
+// Changing only wait_timeout updates the resource in place, keeping the results of the task that already ran.
+func addCommonTaskSchema(s *schema.Schema) {
+	s.Attributes["id"] = schema.StringAttribute{
+		Description: "The id of the task entry on the PingDirectory server.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	s.Attributes["triggers"] = schema.MapAttribute{
+		Description: "Arbitrary values that cause the task to run again when they change, similar to the triggers_replace attribute of the terraform_data resource.",
+		Optional:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+	s.Attributes["wait_timeout"] = schema.StringAttribute{
+		Description: "How long to wait for the task to finish, such as `30m` or `2h`. If the task has not finished in time, the apply fails and the resource is marked as tainted, but the task keeps running on the server. Changing this value does not run the task again. Defaults to `" + defaultTaskWaitTimeout + "`.",
+		Optional:    true,
+	}
+	s.Attributes["task_state"] = schema.StringAttribute{
+		Description: "The state of the task, such as completed-successfully or stopped-by-error.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	s.Attributes["log_messages"] = schema.ListAttribute{
+		Description: "Log messages written by the task while it ran.",
+		Computed:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// Parse the wait_timeout attribute of a task resource, using the default if it is not set
+func taskWaitTimeout(value types.String) (time.Duration, error) {
+	timeout := defaultTaskWaitTimeout
+	if internaltypes.IsNonEmptyString(value) {
+		timeout = value.ValueString()
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, errors.New("wait_timeout must be greater than zero")
+	}
+	return duration, nil
+}
+
+// Schedule a task and wait for it to finish, for at most the given wait timeout. An error is reported if the task
+// could not be scheduled or did not complete successfully in time. Returns the final status of the task, or nil if
+// the task could not be scheduled.
+func runTask(ctx context.Context, c *directoryRestClient, taskId, objectClass, taskClassName string, attributes map[string]interface{}, waitTimeout types.String, diagnostics *diag.Diagnostics) *taskStatus {
+	timeout, err := taskWaitTimeout(waitTimeout)
+	if err != nil {
+		diagnostics.AddError("Failed to parse wait_timeout", "Expected a duration such as '30m' or '2h': "+err.Error())
+		return nil
+	}
+	err = c.addTask(ctx, taskId, objectClass, taskClassName, attributes)
+	if err != nil {
+		diagnostics.AddError("An error occurred while scheduling the task", err.Error()+
+			". Tasks are scheduled through the Directory REST API, which must be enabled on the HTTPS connection handler.")
+		return nil
+	}
+	status, err := c.waitForTask(ctx, taskId, timeout)
+	if err != nil {
+		diagnostics.AddError("An error occurred while waiting for task "+taskId+" to finish", err.Error())
+		return status
+	}
+	if status.State != taskStateCompletedSuccessfully {
+		detail := "The task finished with state " + status.State + "."
+		for _, message := range status.LogMessages {
+			detail += "\n" + message
+		}
+		diagnostics.AddError("Task "+taskId+" did not complete successfully", detail)
+	}
+	return status
+}
+
+// Read the current status of a task. Task entries are removed by the server once their retention time has
+// passed, which does not mean the task needs to run again, so nil is returned without an error in that case.
+func readTaskStatus(ctx context.Context, c *directoryRestClient, taskId string, diagnostics *diag.Diagnostics) *taskStatus {
+	status, statusCode, err := c.readTask(ctx, taskId)
+	if err != nil {
+		if statusCode == http.StatusNotFound {
+			tflog.Info(ctx, "The entry for task "+taskId+" no longer exists, keeping the last known task state")
+			return nil
+		}
+		diagnostics.AddError("An error occurred while reading task "+taskId, err.Error())
+		return nil
+	}
+	return status
+}
@@ -0,0 +1,146 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &enterLockdownModeTaskResource{}
+	_ resource.ResourceWithConfigure = &enterLockdownModeTaskResource{}
+)
+
+// Create a Enter Lockdown Mode Task resource
+func NewEnterLockdownModeTaskResource() resource.Resource {
+	return &enterLockdownModeTaskResource{}
+}
+
+// enterLockdownModeTaskResource is the resource implementation.
+type enterLockdownModeTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *enterLockdownModeTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enter_lockdown_mode_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *enterLockdownModeTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type enterLockdownModeTaskResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	TaskState   types.String `tfsdk:"task_state"`
+	LogMessages types.List   `tfsdk:"log_messages"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+	Reason      types.String `tfsdk:"reason"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *enterLockdownModeTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that places the server in lockdown mode, in which only root users can perform operations, and waits for it to finish.",
+		Attributes: map[string]schema.Attribute{
+			"reason": schema.StringAttribute{
+				Description: "The reason for placing the server in lockdown mode.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Build the task-specific attributes of the task entry
+func enterLockdownModeTaskAttributes(_ context.Context, plan enterLockdownModeTaskResourceModel) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	if internaltypes.IsDefined(plan.Reason) {
+		attributes["ds-task-enter-lockdown-reason"] = plan.Reason.ValueString()
+	}
+	return attributes
+}
+
+// Create a new resource
+func (r *enterLockdownModeTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan enterLockdownModeTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-enter-lockdown-mode")
+	status := runTask(ctx, r.client, taskId, "ds-task-enter-lockdown-mode", "com.unboundid.directory.server.tasks.EnterLockdownModeTask",
+		enterLockdownModeTaskAttributes(ctx, plan), plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *enterLockdownModeTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state enterLockdownModeTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *enterLockdownModeTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan enterLockdownModeTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *enterLockdownModeTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the Enter Lockdown Mode Task from state. The task entry is left for the server to clean up.")
+}
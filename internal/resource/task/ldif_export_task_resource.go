@@ -0,0 +1,255 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &ldifExportTaskResource{}
+	_ resource.ResourceWithConfigure = &ldifExportTaskResource{}
+)
+
+// Create a LDIF Export Task resource
+func NewLdifExportTaskResource() resource.Resource {
+	return &ldifExportTaskResource{}
+}
+
+// ldifExportTaskResource is the resource implementation.
+type ldifExportTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *ldifExportTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldif_export_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ldifExportTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type ldifExportTaskResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	TaskState     types.String `tfsdk:"task_state"`
+	LogMessages   types.List   `tfsdk:"log_messages"`
+	WaitTimeout   types.String `tfsdk:"wait_timeout"`
+	BackendID     types.String `tfsdk:"backend_id"`
+	LdifFile      types.String `tfsdk:"ldif_file"`
+	AppendToLdif  types.Bool   `tfsdk:"append_to_ldif"`
+	IncludeBranch types.Set    `tfsdk:"include_branch"`
+	ExcludeBranch types.Set    `tfsdk:"exclude_branch"`
+	IncludeFilter types.Set    `tfsdk:"include_filter"`
+	ExcludeFilter types.Set    `tfsdk:"exclude_filter"`
+	Compress      types.Bool   `tfsdk:"compress"`
+	Encrypt       types.Bool   `tfsdk:"encrypt"`
+	Sign          types.Bool   `tfsdk:"sign"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *ldifExportTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that exports the contents of a backend to an LDIF file, and waits for it to finish.",
+		Attributes: map[string]schema.Attribute{
+			"backend_id": schema.StringAttribute{
+				Description: "The backend ID of the backend to export.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ldif_file": schema.StringAttribute{
+				Description: "The path to the LDIF file to write. Relative paths are relative to the server root.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"append_to_ldif": schema.BoolAttribute{
+				Description: "Indicates whether to append to the LDIF file if it already exists, rather than overwriting it.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_branch": schema.SetAttribute{
+				Description: "The base DNs of branches to include in the export.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclude_branch": schema.SetAttribute{
+				Description: "The base DNs of branches to exclude from the export.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_filter": schema.SetAttribute{
+				Description: "Filters that identify entries to include in the export.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclude_filter": schema.SetAttribute{
+				Description: "Filters that identify entries to exclude from the export.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"compress": schema.BoolAttribute{
+				Description: "Indicates whether to compress the LDIF file.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypt": schema.BoolAttribute{
+				Description: "Indicates whether to encrypt the LDIF file.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sign": schema.BoolAttribute{
+				Description: "Indicates whether to include a signed hash of the exported data in the LDIF file.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Build the task-specific attributes of the task entry
+func ldifExportTaskAttributes(ctx context.Context, plan ldifExportTaskResourceModel) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	attributes["ds-task-export-backend-id"] = plan.BackendID.ValueString()
+	attributes["ds-task-export-ldif-file"] = plan.LdifFile.ValueString()
+	if internaltypes.IsDefined(plan.AppendToLdif) {
+		attributes["ds-task-export-append-to-ldif"] = taskBoolValue(plan.AppendToLdif)
+	}
+	if internaltypes.IsDefined(plan.IncludeBranch) {
+		var values []string
+		plan.IncludeBranch.ElementsAs(ctx, &values, false)
+		attributes["ds-task-export-include-branch"] = values
+	}
+	if internaltypes.IsDefined(plan.ExcludeBranch) {
+		var values []string
+		plan.ExcludeBranch.ElementsAs(ctx, &values, false)
+		attributes["ds-task-export-exclude-branch"] = values
+	}
+	if internaltypes.IsDefined(plan.IncludeFilter) {
+		var values []string
+		plan.IncludeFilter.ElementsAs(ctx, &values, false)
+		attributes["ds-task-export-include-filter"] = values
+	}
+	if internaltypes.IsDefined(plan.ExcludeFilter) {
+		var values []string
+		plan.ExcludeFilter.ElementsAs(ctx, &values, false)
+		attributes["ds-task-export-exclude-filter"] = values
+	}
+	if internaltypes.IsDefined(plan.Compress) {
+		attributes["ds-task-export-compress-ldif"] = taskBoolValue(plan.Compress)
+	}
+	if internaltypes.IsDefined(plan.Encrypt) {
+		attributes["ds-task-export-encrypt-ldif"] = taskBoolValue(plan.Encrypt)
+	}
+	if internaltypes.IsDefined(plan.Sign) {
+		attributes["ds-task-export-sign-hash"] = taskBoolValue(plan.Sign)
+	}
+	return attributes
+}
+
+// Create a new resource
+func (r *ldifExportTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ldifExportTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-ldif-export")
+	status := runTask(ctx, r.client, taskId, "ds-task-export", "com.unboundid.directory.server.tasks.ExportTask",
+		ldifExportTaskAttributes(ctx, plan), plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *ldifExportTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ldifExportTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *ldifExportTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ldifExportTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *ldifExportTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the LDIF Export Task from state. The task entry is left for the server to clean up.")
+}
@@ -0,0 +1,278 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &ldifImportTaskResource{}
+	_ resource.ResourceWithConfigure = &ldifImportTaskResource{}
+)
+
+// Create a LDIF Import Task resource
+func NewLdifImportTaskResource() resource.Resource {
+	return &ldifImportTaskResource{}
+}
+
+// ldifImportTaskResource is the resource implementation.
+type ldifImportTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *ldifImportTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldif_import_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ldifImportTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type ldifImportTaskResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	TaskState            types.String `tfsdk:"task_state"`
+	LogMessages          types.List   `tfsdk:"log_messages"`
+	WaitTimeout          types.String `tfsdk:"wait_timeout"`
+	LdifFile             types.Set    `tfsdk:"ldif_file"`
+	BackendID            types.String `tfsdk:"backend_id"`
+	IncludeBranch        types.Set    `tfsdk:"include_branch"`
+	ExcludeBranch        types.Set    `tfsdk:"exclude_branch"`
+	Append               types.Bool   `tfsdk:"append"`
+	ReplaceExisting      types.Bool   `tfsdk:"replace_existing"`
+	RejectFile           types.String `tfsdk:"reject_file"`
+	OverwriteRejects     types.Bool   `tfsdk:"overwrite_rejects"`
+	SkipSchemaValidation types.Bool   `tfsdk:"skip_schema_validation"`
+	IsCompressed         types.Bool   `tfsdk:"is_compressed"`
+	IsEncrypted          types.Bool   `tfsdk:"is_encrypted"`
+	ClearBackend         types.Bool   `tfsdk:"clear_backend"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *ldifImportTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that imports the contents of one or more LDIF files into a backend, and waits for it to finish. The backend is unavailable while the import runs.",
+		Attributes: map[string]schema.Attribute{
+			"ldif_file": schema.SetAttribute{
+				Description: "The paths to the LDIF files to import. Relative paths are relative to the server root.",
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"backend_id": schema.StringAttribute{
+				Description: "The backend ID of the backend to import into. If not set, the backend is determined from include_branch.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_branch": schema.SetAttribute{
+				Description: "The base DNs of branches to include in the import.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclude_branch": schema.SetAttribute{
+				Description: "The base DNs of branches to exclude from the import.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"append": schema.BoolAttribute{
+				Description: "Indicates whether to append to the existing contents of the backend rather than replacing them.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"replace_existing": schema.BoolAttribute{
+				Description: "Indicates whether to replace existing entries when appending to the backend.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"reject_file": schema.StringAttribute{
+				Description: "The path to a file in which rejected entries should be written.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overwrite_rejects": schema.BoolAttribute{
+				Description: "Indicates whether to overwrite the reject file if it already exists, rather than appending to it.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_schema_validation": schema.BoolAttribute{
+				Description: "Indicates whether to skip schema validation of the imported entries.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_compressed": schema.BoolAttribute{
+				Description: "Indicates whether the LDIF files are compressed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_encrypted": schema.BoolAttribute{
+				Description: "Indicates whether the LDIF files are encrypted.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"clear_backend": schema.BoolAttribute{
+				Description: "Indicates whether to remove all entries from the backend before the import, including entries outside of the included branches.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Build the task-specific attributes of the task entry
+func ldifImportTaskAttributes(ctx context.Context, plan ldifImportTaskResourceModel) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	if internaltypes.IsDefined(plan.LdifFile) {
+		var values []string
+		plan.LdifFile.ElementsAs(ctx, &values, false)
+		attributes["ds-task-import-ldif-file"] = values
+	}
+	if internaltypes.IsDefined(plan.BackendID) {
+		attributes["ds-task-import-backend-id"] = plan.BackendID.ValueString()
+	}
+	if internaltypes.IsDefined(plan.IncludeBranch) {
+		var values []string
+		plan.IncludeBranch.ElementsAs(ctx, &values, false)
+		attributes["ds-task-import-include-branch"] = values
+	}
+	if internaltypes.IsDefined(plan.ExcludeBranch) {
+		var values []string
+		plan.ExcludeBranch.ElementsAs(ctx, &values, false)
+		attributes["ds-task-import-exclude-branch"] = values
+	}
+	if internaltypes.IsDefined(plan.Append) {
+		attributes["ds-task-import-append"] = taskBoolValue(plan.Append)
+	}
+	if internaltypes.IsDefined(plan.ReplaceExisting) {
+		attributes["ds-task-import-replace-existing"] = taskBoolValue(plan.ReplaceExisting)
+	}
+	if internaltypes.IsDefined(plan.RejectFile) {
+		attributes["ds-task-import-reject-file"] = plan.RejectFile.ValueString()
+	}
+	if internaltypes.IsDefined(plan.OverwriteRejects) {
+		attributes["ds-task-import-overwrite-rejects"] = taskBoolValue(plan.OverwriteRejects)
+	}
+	if internaltypes.IsDefined(plan.SkipSchemaValidation) {
+		attributes["ds-task-import-skip-schema-validation"] = taskBoolValue(plan.SkipSchemaValidation)
+	}
+	if internaltypes.IsDefined(plan.IsCompressed) {
+		attributes["ds-task-import-is-compressed"] = taskBoolValue(plan.IsCompressed)
+	}
+	if internaltypes.IsDefined(plan.IsEncrypted) {
+		attributes["ds-task-import-is-encrypted"] = taskBoolValue(plan.IsEncrypted)
+	}
+	if internaltypes.IsDefined(plan.ClearBackend) {
+		attributes["ds-task-import-clear-backend"] = taskBoolValue(plan.ClearBackend)
+	}
+	return attributes
+}
+
+// Create a new resource
+func (r *ldifImportTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ldifImportTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-ldif-import")
+	status := runTask(ctx, r.client, taskId, "ds-task-import", "com.unboundid.directory.server.tasks.ImportTask",
+		ldifImportTaskAttributes(ctx, plan), plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *ldifImportTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ldifImportTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *ldifImportTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ldifImportTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *ldifImportTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the LDIF Import Task from state. The task entry is left for the server to clean up.")
+}
@@ -0,0 +1,146 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &leaveLockdownModeTaskResource{}
+	_ resource.ResourceWithConfigure = &leaveLockdownModeTaskResource{}
+)
+
+// Create a Leave Lockdown Mode Task resource
+func NewLeaveLockdownModeTaskResource() resource.Resource {
+	return &leaveLockdownModeTaskResource{}
+}
+
+// leaveLockdownModeTaskResource is the resource implementation.
+type leaveLockdownModeTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *leaveLockdownModeTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leave_lockdown_mode_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *leaveLockdownModeTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type leaveLockdownModeTaskResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	TaskState   types.String `tfsdk:"task_state"`
+	LogMessages types.List   `tfsdk:"log_messages"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+	Reason      types.String `tfsdk:"reason"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *leaveLockdownModeTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that takes the server out of lockdown mode, and waits for it to finish.",
+		Attributes: map[string]schema.Attribute{
+			"reason": schema.StringAttribute{
+				Description: "The reason for taking the server out of lockdown mode.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Build the task-specific attributes of the task entry
+func leaveLockdownModeTaskAttributes(_ context.Context, plan leaveLockdownModeTaskResourceModel) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	if internaltypes.IsDefined(plan.Reason) {
+		attributes["ds-task-leave-lockdown-reason"] = plan.Reason.ValueString()
+	}
+	return attributes
+}
+
+// Create a new resource
+func (r *leaveLockdownModeTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan leaveLockdownModeTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-leave-lockdown-mode")
+	status := runTask(ctx, r.client, taskId, "ds-task-leave-lockdown-mode", "com.unboundid.directory.server.tasks.LeaveLockdownModeTask",
+		leaveLockdownModeTaskAttributes(ctx, plan), plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *leaveLockdownModeTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state leaveLockdownModeTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *leaveLockdownModeTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan leaveLockdownModeTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *leaveLockdownModeTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the Leave Lockdown Mode Task from state. The task entry is left for the server to clean up.")
+}
@@ -0,0 +1,172 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &rebuildIndexTaskResource{}
+	_ resource.ResourceWithConfigure = &rebuildIndexTaskResource{}
+)
+
+// Create a Rebuild Index Task resource
+func NewRebuildIndexTaskResource() resource.Resource {
+	return &rebuildIndexTaskResource{}
+}
+
+// rebuildIndexTaskResource is the resource implementation.
+type rebuildIndexTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *rebuildIndexTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rebuild_index_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rebuildIndexTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type rebuildIndexTaskResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	TaskState   types.String `tfsdk:"task_state"`
+	LogMessages types.List   `tfsdk:"log_messages"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+	BaseDN      types.String `tfsdk:"base_dn"`
+	Index       types.Set    `tfsdk:"index"`
+	MaxThreads  types.Int64  `tfsdk:"max_threads"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *rebuildIndexTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that rebuilds indexes in a local DB backend, and waits for it to finish. " +
+			"Changes to a Local DB Index, Local DB Composite Index, or Local DB VLV Index often return a required action " +
+			"to rebuild the index, and the index can't be used until it has been rebuilt.",
+		Attributes: map[string]schema.Attribute{
+			"base_dn": schema.StringAttribute{
+				Description: "The base DN of the backend containing the indexes to rebuild.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index": schema.SetAttribute{
+				Description: "The names of the indexes to rebuild. Use the attribute name for a Local DB Index, and \"vlv.\" followed by the index name for a Local DB VLV Index.",
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_threads": schema.Int64Attribute{
+				Description: "The maximum number of concurrent threads to use when rebuilding the indexes.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Build the task-specific attributes of the task entry
+func rebuildIndexTaskAttributes(ctx context.Context, plan rebuildIndexTaskResourceModel) map[string]interface{} {
+	var indexes []string
+	plan.Index.ElementsAs(ctx, &indexes, false)
+	attributes := map[string]interface{}{
+		"ds-task-rebuild-base-dn": plan.BaseDN.ValueString(),
+		"ds-task-rebuild-index":   indexes,
+	}
+	if internaltypes.IsDefined(plan.MaxThreads) {
+		attributes["ds-task-rebuild-max-threads"] = internaltypes.Int64ToString(plan.MaxThreads)
+	}
+	return attributes
+}
+
+// Create a new resource
+func (r *rebuildIndexTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan rebuildIndexTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-rebuild-index")
+	status := runTask(ctx, r.client, taskId, "ds-task-rebuild", "com.unboundid.directory.server.tasks.RebuildTask",
+		rebuildIndexTaskAttributes(ctx, plan), plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *rebuildIndexTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state rebuildIndexTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *rebuildIndexTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rebuildIndexTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *rebuildIndexTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the Rebuild Index Task from state. The task entry is left for the server to clean up.")
+}
@@ -0,0 +1,126 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &reloadHttpConnectionHandlerCertificatesTaskResource{}
+	_ resource.ResourceWithConfigure = &reloadHttpConnectionHandlerCertificatesTaskResource{}
+)
+
+// Create a Reload HTTP Connection Handler Certificates Task resource
+func NewReloadHttpConnectionHandlerCertificatesTaskResource() resource.Resource {
+	return &reloadHttpConnectionHandlerCertificatesTaskResource{}
+}
+
+// reloadHttpConnectionHandlerCertificatesTaskResource is the resource implementation.
+type reloadHttpConnectionHandlerCertificatesTaskResource struct {
+	client *directoryRestClient
+}
+
+// Metadata returns the resource type name.
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reload_http_connection_handler_certificates_task"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.client = newDirectoryRestClient(providerCfg)
+}
+
+type reloadHttpConnectionHandlerCertificatesTaskResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	TaskState   types.String `tfsdk:"task_state"`
+	LogMessages types.List   `tfsdk:"log_messages"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Runs a task that makes the HTTP connection handlers reload their certificate key and trust stores, and waits for it to finish. Use it after replacing a certificate or trusted CA certificate in the key or trust store files.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+	addCommonTaskSchema(&schema)
+	resp.Schema = schema
+}
+
+// Create a new resource
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan reloadHttpConnectionHandlerCertificatesTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := newTaskId("terraform-reload-http-connection-handler-certificates")
+	status := runTask(ctx, r.client, taskId, "ds-task-reload-http-connection-handler-certificates", "com.unboundid.directory.server.tasks.ReloadHTTPConnectionHandlerCertificatesTask",
+		nil, plan.WaitTimeout, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	// Save the task to state even if it failed, so that it is replaced on the next apply
+	plan.Id = types.StringValue(taskId)
+	plan.TaskState = types.StringValue(status.State)
+	plan.LogMessages = internaltypes.GetStringList(status.LogMessages)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state reloadHttpConnectionHandlerCertificatesTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := readTaskStatus(ctx, r.client, state.Id.ValueString(), &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+	state.TaskState = types.StringValue(status.State)
+	state.LogMessages = internaltypes.GetStringList(status.LogMessages)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update a resource. Only wait_timeout can change without replacing the resource, and it only applies when the
+// task runs, so there is nothing to send to the server.
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan reloadHttpConnectionHandlerCertificatesTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// A task that has finished can't be undone, so this only removes the resource from state.
+func (r *reloadHttpConnectionHandlerCertificatesTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the Reload HTTP Connection Handler Certificates Task from state. The task entry is left for the server to clean up.")
+}
@@ -0,0 +1,235 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingdirectory/internal/types"
+)
+
+// Tasks are scheduled by adding entries below this DN in the task backend
+const scheduledTasksBaseDn = "cn=Scheduled Tasks,cn=tasks"
+
+// Interval between reads of a task entry while waiting for the task to complete
+const taskPollInterval = 2 * time.Second
+
+// States of a task that has finished running, successfully or not. Any other state means the task is
+// still waiting to run or is running.
+const (
+	taskStateCompletedSuccessfully = "completed-successfully"
+	taskStateCompletedWithErrors   = "completed-with-errors"
+	taskStateStoppedByError        = "stopped-by-error"
+	taskStateStoppedByShutdown     = "stopped-by-shutdown"
+	taskStateStoppedByAdmin        = "stopped-by-administrator"
+	taskStateCanceledBeforeStart   = "canceled-before-starting"
+)
+
+// Client for reading and writing task entries through the Directory REST API of the PingDirectory server.
+// The Configuration API can only schedule recurring tasks, so one-shot tasks are added as entries in the
+// task backend, the same way the manage-tasks tool and the task-specific command line tools do.
+type directoryRestClient struct {
+	httpClient     *http.Client
+	providerConfig internaltypes.ProviderConfiguration
+}
+
+// The current status of a task, read from its task entry
+type taskStatus struct {
+	State       string
+	LogMessages []string
+}
+
+// Build a Directory REST API client that uses the same HTTP client and credentials as the Configuration API client
+func newDirectoryRestClient(resourceConfig internaltypes.ResourceConfiguration) *directoryRestClient {
+	return &directoryRestClient{
+		httpClient:     resourceConfig.ApiClientV9200.GetConfig().HTTPClient,
+		providerConfig: resourceConfig.ProviderConfig,
+	}
+}
+
+// Get the DN of the entry for the task with the given id
+func taskEntryDn(taskId string) string {
+	return "ds-task-id=" + taskId + "," + scheduledTasksBaseDn
+}
+
+// Build a unique task id, prefixed with the given description of the task type
+func newTaskId(prefix string) string {
+	return prefix + "-" + strconv.FormatInt(time.Now().UnixNano(), 10)
+}
+
+// Build a Directory REST API request for the entry with the given DN
+func (c *directoryRestClient) newRequest(ctx context.Context, method, dn string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.providerConfig.HttpsHost+"/directory/v1/"+url.PathEscape(dn), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	// When an access token is used, the HTTP client adds it to each request
+	if c.providerConfig.Username != "" {
+		req.SetBasicAuth(c.providerConfig.Username, c.providerConfig.Password)
+	}
+	return req, nil
+}
+
+// Send a request and decode the JSON entry in the response body. Returns the HTTP status code along with any error.
+func (c *directoryRestClient) do(ctx context.Context, req *http.Request) (map[string]interface{}, int, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	tflog.Debug(ctx, "Directory REST API response body: "+string(body))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, resp.StatusCode, directoryRestError(resp.Status, body)
+	}
+	entry := map[string]interface{}{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &entry)
+		if err != nil {
+			return nil, resp.StatusCode, err
+		}
+	}
+	return entry, resp.StatusCode, nil
+}
+
+// Build an error describing a failed Directory REST API request
+func directoryRestError(status string, body []byte) error {
+	var response struct {
+		ErrorMessage string `json:"errorMessage"`
+	}
+	if json.Unmarshal(body, &response) == nil && response.ErrorMessage != "" {
+		return errors.New(status + " - Detail: " + response.ErrorMessage)
+	}
+	return errors.New(status)
+}
+
+// Schedule a task by adding a task entry with the given object class, task class, and task-specific attributes.
+// The task is scheduled to start immediately.
+func (c *directoryRestClient) addTask(ctx context.Context, taskId, objectClass, taskClassName string, attributes map[string]interface{}) error {
+	entry := map[string]interface{}{
+		"_dn":                taskEntryDn(taskId),
+		"objectClass":        []string{"top", "ds-task", objectClass},
+		"ds-task-id":         taskId,
+		"ds-task-class-name": taskClassName,
+	}
+	for name, value := range attributes {
+		entry[name] = value
+	}
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, scheduledTasksBaseDn, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "Scheduling task "+taskId+" with class "+taskClassName)
+	_, _, err = c.do(ctx, req)
+	return err
+}
+
+// Read the current status of a task. Returns the HTTP status code along with any error, so that callers can
+// detect a task entry that no longer exists.
+func (c *directoryRestClient) readTask(ctx context.Context, taskId string) (*taskStatus, int, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, taskEntryDn(taskId), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	entry, statusCode, err := c.do(ctx, req)
+	if err != nil {
+		return nil, statusCode, err
+	}
+	return &taskStatus{
+		State:       strings.Join(entryAttributeValues(entry, "ds-task-state"), ""),
+		LogMessages: entryAttributeValues(entry, "ds-task-log-message"),
+	}, statusCode, nil
+}
+
+// Poll a task until it has finished running, until the timeout has passed, or until the context is done
+func (c *directoryRestClient) waitForTask(ctx context.Context, taskId string, timeout time.Duration) (*taskStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	// Report the last state read from the server when giving up
+	stoppedWaitingError := func(status *taskStatus) error {
+		return errors.New("stopped waiting for task " + taskId + " to finish after " + timeout.String() +
+			", last state: " + status.State + ". The task may still be running on the server")
+	}
+	var status *taskStatus
+	for {
+		currentStatus, _, err := c.readTask(ctx, taskId)
+		if err != nil {
+			if ctx.Err() != nil && status != nil {
+				return status, stoppedWaitingError(status)
+			}
+			return nil, err
+		}
+		status = currentStatus
+		if isTaskDone(status.State) {
+			tflog.Info(ctx, "Task "+taskId+" finished with state "+status.State)
+			return status, nil
+		}
+		tflog.Debug(ctx, "Task "+taskId+" has state "+status.State+", waiting for it to finish")
+
+		timer := time.NewTimer(taskPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, stoppedWaitingError(status)
+		case <-timer.C:
+		}
+	}
+}
+
+// Check whether a task state indicates that the task has finished running
+func isTaskDone(state string) bool {
+	switch state {
+	case taskStateCompletedSuccessfully, taskStateCompletedWithErrors, taskStateStoppedByError,
+		taskStateStoppedByShutdown, taskStateStoppedByAdmin, taskStateCanceledBeforeStart:
+		return true
+	default:
+		return false
+	}
+}
+
+// Get the values of an attribute in an entry returned by the Directory REST API. Single-valued attributes are
+// returned as JSON strings, and multi-valued attributes as arrays.
+func entryAttributeValues(entry map[string]interface{}, attribute string) []string {
+	switch value := entry[attribute].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var values []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// Get the LDAP boolean syntax value for a bool attribute
+func taskBoolValue(value types.Bool) string {
+	if value.ValueBool() {
+		return "TRUE"
+	}
+	return "FALSE"
+}
@@ -22,6 +22,16 @@ func GetStringSet(values []string) types.Set {
 	return set
 }
 
+// Get a types.List from a slice of strings
+func GetStringList(values []string) types.List {
+	listValues := make([]attr.Value, len(values))
+	for i := 0; i < len(values); i++ {
+		listValues[i] = types.StringValue(values[i])
+	}
+	list, _ := types.ListValue(types.StringType, listValues)
+	return list
+}
+
 // Get a types.Set from a slice of int32
 func GetInt64Set(values []int32) types.Set {
 	setValues := make([]attr.Value, len(values))
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"sync"
+
 	client9200 "github.com/pingidentity/pingdirectory-go-client/v9200/configurationapi"
 )
 
@@ -10,10 +12,52 @@ type ProviderConfiguration struct {
 	Username       string
 	Password       string
 	ProductVersion string
+	// One of RequiredActionBehaviorIgnore, RequiredActionBehaviorWarn, or RequiredActionBehaviorFail
+	RequiredActionBehavior string
+	// Recurring tasks planned during the current Terraform operation
+	PlannedRecurringTasks *PlannedIds
 }
 
+// How required actions returned by the Configuration API are reported
+const (
+	RequiredActionBehaviorIgnore = "ignore"
+	RequiredActionBehaviorWarn   = "warn"
+	RequiredActionBehaviorFail   = "fail"
+)
+
 // Configuration passed to resources
 type ResourceConfiguration struct {
 	ProviderConfig ProviderConfiguration
 	ApiClientV9200 *client9200.APIClient
 }
+
+// Ids of config objects planned by resources during a single Terraform operation. The provider creates a new set
+// each time it is configured, so ids planned in an earlier operation are not included.
+type PlannedIds struct {
+	mutex sync.Mutex
+	ids   map[string]bool
+}
+
+func NewPlannedIds() *PlannedIds {
+	return &PlannedIds{ids: map[string]bool{}}
+}
+
+// Record a planned id. Does nothing if the set is nil, as it is for resources that haven't been configured.
+func (p *PlannedIds) Add(id string) {
+	if p == nil {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.ids[id] = true
+}
+
+// Check if an id has been planned
+func (p *PlannedIds) Contains(id string) bool {
+	if p == nil {
+		return false
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.ids[id]
+}
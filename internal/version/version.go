@@ -2,6 +2,7 @@ package version
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,8 +14,34 @@ const (
 	PingDirectory9200 = "9.2.0.0"
 )
 
+// All supported PingDirectory versions, in ascending order. When adding support for a new version,
+// add a constant above and append it to this list.
+var supportedVersions = []string{
+	PingDirectory9100,
+	PingDirectory9200,
+}
+
 func IsValid(versionString string) bool {
-	return versionString == PingDirectory9100 || versionString == PingDirectory9200
+	for _, supportedVersion := range supportedVersions {
+		if versionString == supportedVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// Split a four-digit version string into its numeric parts
+func versionDigits(versionString string) ([]int, error) {
+	parts := strings.Split(versionString, ".")
+	digits := make([]int, len(parts))
+	for i, part := range parts {
+		digit, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, errors.New("Invalid version: " + versionString)
+		}
+		digits[i] = digit
+	}
+	return digits, nil
 }
 
 // Compare two PingDirectory versions. Returns a negative number if the first argument is less than the second,
@@ -27,17 +54,25 @@ func Compare(version1, version2 string) (int, error) {
 		return 0, errors.New("Invalid version: " + version2)
 	}
 
-	if version1 == version2 {
-		return 0, nil
+	digits1, err := versionDigits(version1)
+	if err != nil {
+		return 0, err
 	}
-
-	if version1 == PingDirectory9100 {
-		return -1, nil
+	digits2, err := versionDigits(version2)
+	if err != nil {
+		return 0, err
 	}
-
-	return 1, nil
+	for i := range digits1 {
+		if digits1[i] != digits2[i] {
+			return digits1[i] - digits2[i], nil
+		}
+	}
+	return 0, nil
 }
 
+// Parse a PingDirectory version string. Versions with two digits (e.g. "9.1") are expanded to four digits
+// (e.g. "9.1.0.0"). Patch versions (e.g. "9.2.0.1") are mapped to the supported version with the same
+// major and minor version (e.g. "9.2.0.0").
 func Parse(versionString string) (string, error) {
 	if len(versionString) == 0 {
 		return versionString, errors.New("failed to parse PingDirectory version: empty version string")
@@ -50,11 +85,16 @@ func Parse(versionString string) (string, error) {
 	if len(versionDigits) != 2 && len(versionDigits) != 4 {
 		return versionString, errors.New("failed to parse PingDirectory version '" + versionString + "', Expected either two digits (e.g. '9.1') or four digits (e.g. '9.1.0.0')")
 	}
-	if len(versionDigits) == 2 {
-		versionString += ".0.0"
+	for _, digit := range versionDigits {
+		_, err = strconv.Atoi(digit)
+		if err != nil {
+			return versionString, errors.New("failed to parse PingDirectory version '" + versionString + "', each part of the version must be a number")
+		}
 	}
+	// Map any patch version to the corresponding supported minor version
+	versionString = versionDigits[0] + "." + versionDigits[1] + ".0.0"
 	if !IsValid(versionString) {
-		err = errors.New("unsupported PingDirectory version: " + versionString)
+		err = errors.New("unsupported PingDirectory version: " + versionString + ". Supported versions are: " + strings.Join(supportedVersions, ", "))
 	}
 	return versionString, err
 }
@@ -0,0 +1,139 @@
+package version
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		version1 string
+		version2 string
+		expected int
+	}{
+		{PingDirectory9100, PingDirectory9100, 0},
+		{PingDirectory9200, PingDirectory9200, 0},
+		{PingDirectory9100, PingDirectory9200, -1},
+		{PingDirectory9200, PingDirectory9100, 1},
+	}
+	for _, testCase := range testCases {
+		compare, err := Compare(testCase.version1, testCase.version2)
+		if err != nil {
+			t.Errorf("Unexpected error comparing %s and %s: %s", testCase.version1, testCase.version2, err.Error())
+			continue
+		}
+		if sign(compare) != testCase.expected {
+			t.Errorf("Expected Compare(%s, %s) to have sign %d, found %d", testCase.version1, testCase.version2, testCase.expected, compare)
+		}
+	}
+}
+
+func TestCompareOrdersSupportedVersions(t *testing.T) {
+	for i := 1; i < len(supportedVersions); i++ {
+		compare, err := Compare(supportedVersions[i-1], supportedVersions[i])
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if compare >= 0 {
+			t.Errorf("Expected %s to be less than %s", supportedVersions[i-1], supportedVersions[i])
+		}
+	}
+}
+
+func TestCompareInvalidVersions(t *testing.T) {
+	invalidVersions := []string{"", "9.2", "9.2.0.1", "8.3.0.0", "abc", "9.x.0.0"}
+	for _, invalidVersion := range invalidVersions {
+		if _, err := Compare(invalidVersion, PingDirectory9200); err == nil {
+			t.Errorf("Expected an error comparing invalid version '%s'", invalidVersion)
+		}
+		if _, err := Compare(PingDirectory9200, invalidVersion); err == nil {
+			t.Errorf("Expected an error comparing against invalid version '%s'", invalidVersion)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	testCases := map[string]string{
+		// Two digits are expanded to four
+		"9.1": PingDirectory9100,
+		"9.2": PingDirectory9200,
+		// Four digits
+		"9.1.0.0": PingDirectory9100,
+		"9.2.0.0": PingDirectory9200,
+		// Patch versions map to the supported minor version
+		"9.1.0.3": PingDirectory9100,
+		"9.2.0.1": PingDirectory9200,
+		"9.2.1.0": PingDirectory9200,
+	}
+	for versionString, expected := range testCases {
+		parsed, err := Parse(versionString)
+		if err != nil {
+			t.Errorf("Unexpected error parsing '%s': %s", versionString, err.Error())
+			continue
+		}
+		if parsed != expected {
+			t.Errorf("Expected '%s' to parse as %s, found %s", versionString, expected, parsed)
+		}
+	}
+}
+
+func TestParseInvalidVersions(t *testing.T) {
+	testCases := map[string]string{
+		"":          "empty version string",
+		"9":         "Expected either two digits",
+		"9.2.0":     "Expected either two digits",
+		"9.2.0.0.0": "Expected either two digits",
+		"9.x":       "each part of the version must be a number",
+		"9.2.0.a":   "each part of the version must be a number",
+	}
+	for versionString, expectedError := range testCases {
+		_, err := Parse(versionString)
+		if err == nil {
+			t.Errorf("Expected an error parsing '%s'", versionString)
+			continue
+		}
+		if !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("Expected error parsing '%s' to contain '%s', found '%s'", versionString, expectedError, err.Error())
+		}
+	}
+}
+
+func TestParseUnsupportedVersions(t *testing.T) {
+	unsupportedVersions := []string{"8.3", "9.0.0.0", "9.3", "9.3.0.0", "10.0.0.0"}
+	for _, versionString := range unsupportedVersions {
+		_, err := Parse(versionString)
+		if err == nil {
+			t.Errorf("Expected an error parsing unsupported version '%s'", versionString)
+			continue
+		}
+		if !strings.Contains(err.Error(), "unsupported PingDirectory version") {
+			t.Errorf("Unexpected error parsing '%s': %s", versionString, err.Error())
+		}
+	}
+}
+
+func TestCheckResourceSupported(t *testing.T) {
+	var diagnostics diag.Diagnostics
+	CheckResourceSupported(&diagnostics, PingDirectory9200, PingDirectory9200, "Test resource")
+	if diagnostics.HasError() {
+		t.Errorf("Unexpected error for a supported version: %v", diagnostics)
+	}
+
+	diagnostics = diag.Diagnostics{}
+	CheckResourceSupported(&diagnostics, PingDirectory9200, PingDirectory9100, "Test resource")
+	if !diagnostics.HasError() {
+		t.Error("Expected an error for a version older than the minimum")
+	}
+}
+
+func sign(value int) int {
+	if value < 0 {
+		return -1
+	}
+	if value > 0 {
+		return 1
+	}
+	return 0
+}